@@ -3,6 +3,20 @@
 // ../migrations/20081216203005-INIT.sql
 // ../migrations/20190302133837-azure_database_link.sql
 // ../migrations/20190429093117-No_check_validation_rule.sql
+// ../migrations/20190506101500-Lookup_validation_rule.sql
+// ../migrations/20190510083000-Delivery_check.sql
+// ../migrations/20190514091500-Delivery_profile.sql
+// ../migrations/20190520140000-Alert.sql
+// ../migrations/20190527101000-Event_outbox.sql
+// ../migrations/20190603091000-Trigger_kind.sql
+// ../migrations/20190610093000-Delta_delivery.sql
+// ../migrations/20190617094500-Scd2.sql
+// ../migrations/20190624090000-Lineage.sql
+// ../migrations/20190701100000-Consumer_access.sql
+// ../migrations/20190708090000-Data_asof.sql
+// ../migrations/20190715090000-Group_sync.sql
+// ../migrations/20190722090000-Service_account.sql
+// ../migrations/20190729090000-Api_audit.sql
 
 package main
 
@@ -1603,6 +1617,2050 @@ func bindataMigrations20190429093117Nocheckvalidationrulesql() (*asset, error) {
 	return a, nil
 }
 
+var _bindataMigrations20190506101500Lookupvalidationrulesql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x73\xe2\x46\xb3\xf0\xe7\x97\x5f\xd1\x5f\x52\x40\x0c\xc4\x76" +
+	"\x2e\xef\xa9\xe4\x21\x65\x2d\xc8\x59\x4e\x30\x38\x92\xd8\x64\xcb\x45\x51\x63\x34\x18\x95\x85\x44\x74\xb1\xe3\x53" +
+	"\xf9\xf1\xa7\x7a\x34\x57\x49\x5c\xbc\xc9\xee\xe6\x39\x0f\x90\x54\x2d\xd2\x4c\x4f\x4f\xdf\xbb\x67\x7a\xb7\xd1\xed" +
+	"\xc2\xd9\x26\x78\x48\x48\x46\x61\xb6\x6d\x0c\x1c\xdb\xf2\x6c\xf0\xac\x37\x63\xfb\x6e\x43\x33\x32\xef\xdd\x91\x87" +
+	"\x84\xd2\x0d\x8d\xb2\x45\x18\xc7\x8f\xf9\x76\xde\x68\x35\x00\x00\xee\x02\x7f\x0e\x77\xf7\xc1\x43\x10\x65\x73\x18" +
+	"\x0d\xed\x89\x37\xf2\xde\xb7\x2e\x3a\x17\x6d\x98\x4c\x3d\x98\xcc\xc6\xe3\x4e\xa3\x18\xaa\x60\x18\x93\xca\xc3\x92" +
+	"\x3c\xa4\xc5\x88\xda\xd7\xcb\x38\xcc\x37\xd1\x22\x22\x1b\x3a\x87\xbb\xe8\x89\x24\xcb\x35\x49\xe6\xd0\xba\xb8\xfc" +
+	"\xaf\xea\x9a\x05\xb6\x8b\x23\x97\xe6\xa3\x5f\xb7\x84\x4f\xc3\xe0\x89\x26\x2f\x0b\x9f\x64\xa5\xf1\x97\xdf\xb6\x8d" +
+	"\xa1\xcb\x84\x92\x8c\xfa\xd9\x66\x0e\x77\x38\x3a\x0b\x36\x54\x61\x01\x83\xe9\xc4\xf5\x1c\x6b\x34\xf1\xee\x86\xd7" +
+	"\x1a\xce\x02\x2d\x35\x7b\x68\x5f\x5b\xb3\xb1\xd7\x7a\xa0\x19\xc2\x69\xb5\xdb\x9d\x86\x3e\xfd\xf6\xe7\xca\xf4\x39" +
+	"\xdc\x3a\xa3\x1b\xcb\x79\x0f\x3f\xdb\xef\x61\x30\x9e\xb9\x9e\xed\xd8\xc3\x82\x8f\x8c\x8d\x96\x3b\x68\xb4\x1b\x6d" +
+	"\x98\x4e\xee\xf8\xd0\x79\xe3\x87\x86\x35\xf6\x6c\xe7\x80\x30\xc0\xaf\x23\xef\x2d\x0c\xde\xda\x83\x9f\xc1\x1a\x0e" +
+	"\x75\x4c\xae\xab\x98\xa8\x07\x73\xb8\x9e\x3a\xf6\xe8\xa7\x09\xe2\xd4\x32\x05\xa4\xdd\x70\xec\x6b\xdb\xb1\x27\x03" +
+	"\xdb\xad\x2c\x3c\x47\xa4\xd9\xb7\x85\xa8\xb7\x1b\xd3\x09\x0c\xed\xb1\xed\xd9\x30\xb0\xdc\x81\x35\xb4\x3f\x0e\xe6" +
+	"\x07\x36\x50\x7e\xfd\xfa\x7d\xfc\x20\xb4\xef\x7a\x36\x19\x78\xa3\xe9\x04\xc4\x9c\x07\x9a\x2d\x12\xba\x8d\x17\x52" +
+	"\xde\x90\x67\xdd\xee\x9f\x8d\x6e\xf7\x4f\xe8\x7f\xb4\x0f\x03\x3f\xa4\xe9\x32\x09\xb6\x59\x10\x47\xdf\x83\x43\xb3" +
+	"\x3c\x89\x20\x5b\x53\x08\x49\x46\xd3\x0c\x04\x4a\x10\xaf\x80\x44\x20\xb7\x07\xdb\xfc\x3e\x0c\xd2\x35\xf5\x21\x8b" +
+	"\x01\xb1\x87\xe7\x20\x5b\x03\x81\x34\x23\x59\x9e\x32\xd8\x82\x02\xec\x8b\xd2\x0c\xf9\x16\xf2\x28\x0b\x42\xb8\x92" +
+	"\x7b\xc5\xe7\xbd\xd1\xaa\xf4\x04\x82\x94\xe9\x97\x8e\x8b\x5c\xb2\x06\x38\x9f\x8b\xd3\x12\xb6\x09\xea\xf7\xd8\x7c" +
+	"\xed\x01\x04\x2b\x88\xe2\x6c\x1d\x44\x0f\xb0\x26\x29\xdc\x53\x1a\x29\xa0\x3d\x06\xd5\x4a\x1e\x72\xe4\x6f\xfa\x3d" +
+	"\x37\x83\x57\x72\xcb\x8b\xc0\x07\x78\x33\xfa\x69\x34\xf1\x3a\x7c\x59\x9c\x31\x1a\x32\xda\x48\xc2\x64\x31\xac\x82" +
+	"\xc8\x67\x88\x4b\xe0\x0a\xc1\x55\x9c\x14\x70\xcd\xed\x4e\xde\x59\xce\xe0\xad\xe5\x30\xc3\xc2\xe0\x0e\xf1\x71\x9a" +
+	"\x25\x88\x6d\xeb\xfd\xfb\xf7\xef\xbb\x37\x37\xdd\xe1\xb0\x8d\xf4\xde\x06\x05\x93\x24\xd4\x27\x9a\xa4\x41\x1c\x41" +
+	"\x16\x37\x50\x2a\xbd\x99\x33\x71\x39\xae\x0d\xcb\x05\xb6\xb5\xee\x47\xfb\x34\xde\xd8\x3f\x8d\x26\x6c\x5b\x43\x7b" +
+	"\x30\xb6\x1c\x5b\xdb\x5e\xe0\x0b\x44\xd8\x00\xd7\x1e\xdb\x03\xcf\x7c\xdf\x87\x1b\xeb\xb7\x96\xdf\x0b\xfc\x36\xd7" +
+	"\x9a\x6b\x67\x7a\x03\xa8\x1e\x3d\x31\x0e\xfc\x8e\xd4\x28\x80\xe2\x1d\xc9\xfd\x20\x03\x52\x3c\xff\xf5\xad\xed\xd8" +
+	"\x80\x40\xc4\x18\x00\xe8\x83\x82\xb0\x08\x7c\x01\xc1\x9a\x0c\x81\xf4\xd2\x8c\x3c\xa0\x3f\xe2\x23\xbf\xd6\xdf\xfa" +
+	"\x3d\x83\xef\x7d\x53\x0e\xf4\x91\x2d\xb5\x15\x14\x65\x18\xb9\x85\xe0\x4e\x1d\xf0\x71\x89\x2c\x4f\x0b\x16\xff\xab" +
+	"\x8f\x36\xe8\x9d\xed\x78\xad\xa1\xe5\xd9\xde\xe8\xc6\xee\x68\x74\xc0\x31\x1d\xb8\xb8\x3c\x6f\xb7\x0b\x9f\x52\xb0" +
+	"\xd1\xa0\x54\xc3\x9e\x0c\x3f\xb6\x51\x10\x76\xaa\xf1\x6e\x64\xff\x5a\x31\x6b\x0b\xe6\xc3\x9f\x3e\x8f\x79\xb2\xc2" +
+	"\x10\x9e\x48\x18\xf8\x04\x7f\x03\xa2\x92\x96\x0d\x53\x07\x82\x1e\xed\x31\xed\x58\xe6\x69\x16\x6f\x82\xff\xa1\x3e" +
+	"\x1f\x4a\xc2\x38\x7a\x60\x76\xaa\x6a\x41\x70\x42\x61\xe4\xf9\xe0\x2c\x21\x51\x8a\x56\xd0\x87\x20\xca\x62\x70\x7f" +
+	"\x19\x03\x79\x20\x41\x94\x66\xb5\x46\x09\x5a\x71\x02\xdb\x20\x8a\xa8\xdf\xae\x82\x67\x16\x52\x70\x12\x71\xd6\xd6" +
+	"\x93\xb8\x43\x17\xb8\x62\xb3\xe1\x19\xb9\x0f\x29\xb4\xb6\x24\xc9\x02\xdc\x70\x1b\x82\x0c\x9e\x49\x8d\x6d\x55\x48" +
+	"\x64\x71\xef\xd7\x20\x5b\xc7\x79\x06\x44\xc3\x4d\xae\x1c\xc5\x48\xc1\x9c\xa2\xa1\x5c\xc5\x79\xc4\xcd\x9e\xa0\xf9" +
+	"\xdf\xff\x69\x58\x6e\x83\xeb\xbc\xdc\xe7\x22\x50\xca\xcc\x63\x42\xf9\x7b\x60\xb9\x5e\x8b\x3d\xcc\xe8\x1f\x19\x58" +
+	"\xae\x32\x8d\x37\xd6\x6f\xed\x36\x3e\x91\xaf\x1b\xba\xa5\x50\xe0\xf1\x7d\x03\x66\x13\x74\xb2\xd6\x78\x2c\xd6\x0f" +
+	"\x7b\xb5\x18\x84\xbd\x5a\x1c\x7e\x99\x4d\x3d\x7b\x62\xdd\xd8\xad\xb0\xa7\x85\x8d\x6d\x38\x83\xa6\xae\xe4\x4d\x31" +
+	"\x0b\x00\xce\x60\xff\xac\x09\xb4\x38\x2e\x6c\x89\x66\x69\x02\x0f\x32\x2a\xf3\x74\x22\x7c\x73\x7e\x7e\xde\x6e\x9b" +
+	"\x8b\x0e\xa6\xd6\xd8\x76\x07\x76\xab\x59\x90\xe3\x0e\x01\x27\x3d\x26\x3f\x8b\x74\xb9\xa6\x1b\x82\x70\xe6\x3d\xe3" +
+	"\x05\xc6\xd9\xec\xb1\x0e\x4c\x7e\x70\x97\xdc\xa4\x3e\xeb\x81\x09\xf4\x01\x81\x30\xfc\x7d\xdd\xb6\xea\xac\x6a\x77" +
+	"\x1a\x26\x34\xfe\x15\x20\x2f\xa0\x0f\xe7\xcd\x76\xc3\x58\xae\xdd\xfc\x30\x6e\x73\x2d\x0a\x05\xb4\x81\x33\x75\x5d" +
+	"\xb0\x6e\x6f\xc7\xef\xc5\xa3\x6e\xf7\x0c\x6e\xeb\x14\xf5\xa0\x4a\x0a\x08\x82\x6f\x6c\xed\xba\x80\x4d\xb1\x4f\x61" +
+	"\x16\xf8\x1d\x08\x15\x8d\xd0\xc2\xb3\x1d\x69\xd3\xda\x20\x9d\xd2\x74\x86\xe1\x78\x05\x6f\x47\x99\x02\xc3\xe5\x3f" +
+	"\x93\x54\x53\xf0\x2c\x2e\x63\xea\x4d\x6f\xe1\x02\xb2\xde\x5d\x21\x00\x73\x5c\x58\x97\x88\x0e\x64\x3d\x26\x02\xf2" +
+	"\x39\xfe\x12\x50\x0c\x1f\x5c\xf8\xd9\xbc\xc2\x54\xf6\xf2\x8e\x01\x9d\x43\xa6\xde\x16\x4c\xce\x0d\xe9\xe8\x83\x21" +
+	"\x2d\x3a\x28\xf4\xa2\xb9\xe1\x8d\x35\x67\x2c\x06\x64\x86\x63\xef\x43\xce\xa5\x58\x07\x35\x75\x86\xb6\x03\x6f\xde" +
+	"\x43\x8e\x83\x87\xb6\x3b\x68\x43\xf2\xc9\xfc\xe5\xad\x33\x1d\xd8\xc3\x99\xb3\x33\x25\x59\x10\xff\x33\xc5\xf5\x96" +
+	"\xef\x03\x11\xb2\x5d\xf2\xa0\x18\x4f\x12\x48\xb7\x74\x19\xac\x82\xa5\x92\xfc\x9e\xb7\xa6\x85\xaf\x48\xb9\x72\x54" +
+	"\xfd\x4e\x61\xa9\x60\x93\xa7\x19\xd0\x3f\x82\x34\x43\xff\x45\xc4\xe3\x78\x55\xef\x28\xcb\xbe\xb0\x0a\x97\x60\x98" +
+	"\x4e\x13\x85\x0c\xb4\xfc\x60\x43\x23\x8c\x71\xbf\x4a\xe8\x8a\x26\x34\x5a\x52\xf0\x49\x46\xda\x3d\x4f\xa9\xad\x04" +
+	"\xba\x24\x11\xdc\xd7\x20\x5c\xf8\x67\xdc\xb2\x86\x99\x9c\x25\xb3\x13\x8d\x1e\xa8\xb4\xc7\xa5\x06\xc5\xd7\x4c\x10" +
+	"\xb4\x0c\xc1\xb4\x5a\x8c\x32\xe8\x77\x20\x5d\xc7\x79\xe8\xc3\x3d\x85\x30\x88\x1e\x95\x2a\x5f\x71\xaf\x24\x20\xb1" +
+	"\xaf\x0e\x9a\x43\xb7\x24\x48\x89\x33\xce\x84\xc0\x87\xd6\x2a\x4e\x20\x8c\x1f\x90\x2b\x34\x49\xe2\xa4\x50\xf4\xc2" +
+	"\xee\x5e\x69\x6e\x46\xc0\x53\xd6\x17\x4b\x2e\x1d\x06\x7f\x20\x99\xa9\x21\x1f\x0b\x29\xa2\x05\xac\x1a\xd3\x77\x34" +
+	"\x25\xd6\x71\xe8\x63\x92\xc3\x28\x62\xf0\xd6\x80\x6d\xa0\xbb\x17\x4f\x53\x40\x60\x19\x47\x19\x09\x22\x5c\x82\x21" +
+	"\x0d\xcb\xd8\xa7\x69\x5d\x12\x56\xa2\x01\x4b\xc5\xf6\xe7\x62\x28\x4f\x15\xdc\x75\xd3\xb5\xf7\x83\xa0\xa5\xf0\x75" +
+	"\x8b\xb4\xa1\xff\x63\x45\x61\x1a\xed\xcf\x94\xc3\x6d\xd2\x07\x45\x0d\x16\x78\x98\xef\x99\x34\xe9\x04\xab\x8c\x58" +
+	"\xc6\x79\x94\x01\xc8\xec\x0f\x77\x3c\x8e\xe3\x47\x2c\x04\x64\x6b\x0a\x41\x14\x64\xdc\xb9\xad\x92\x78\x03\x95\x24" +
+	"\x8b\x3b\x33\xbe\x54\x1f\x9a\x2c\x84\xd9\x11\xd9\xec\x88\x6b\xea\x82\x86\xc2\xdb\x14\x13\x9e\xf4\x04\x52\xc7\x60" +
+	"\x5f\xda\x27\xdd\x55\x1f\xce\x8b\x7c\x6d\x74\x2d\x28\xc2\xa3\x43\xf6\x54\x91\x15\x85\xd9\xb1\x46\xae\xed\x38\x53" +
+	"\x07\x5a\x4d\xa5\xb8\x77\x5f\x8c\xbe\xfb\xc6\x9f\x83\x1f\xd3\x14\x2b\x14\x85\x2d\x6d\x76\xe0\xe2\xa2\x03\x17\x1d" +
+	"\x13\x09\x15\x35\xf1\x04\xf1\x92\x3d\xc0\xcc\x50\x92\x78\xb0\xa6\xcb\x47\x66\x62\xb8\x29\x66\x00\x53\x91\x5d\x48" +
+	"\x70\x06\x89\x0b\x5e\x61\x92\x3a\x9b\x78\xad\x2f\xdb\x15\xea\x71\x0d\xdc\x90\xed\x36\x88\x1e\x3e\x8c\x6c\x06\xe7" +
+	"\xfa\xd0\xcc\xe8\x66\x2b\xe3\x4f\x1c\x60\xa8\x79\x5f\x0b\x8f\x75\x7b\xc5\x33\xe4\xd1\xb5\xc2\xfa\xfc\x00\xb5\xb9" +
+	"\x79\xb8\xfb\x22\x2d\xd3\x19\xa9\x42\xca\xbc\xd0\x88\xaf\xad\x7b\x88\x15\x5f\xef\x63\x05\xf7\x51\xb5\x1c\xd1\xf2" +
+	"\xbd\x7d\x81\xe8\x47\x61\x15\xb7\xaf\xfa\xe3\x7d\x1c\x43\x54\x8f\xe4\x18\x87\xfc\x31\x19\x87\xd8\xa0\x4f\x29\x93" +
+	"\xab\x86\x8f\x55\x6c\x3a\xb5\x9b\xaf\x70\xf5\x9b\x7d\x5c\xe5\x11\x85\x34\xe3\xd2\x27\x8e\xae\x39\x2b\x50\x00\x98" +
+	"\x7f\x29\x55\x8a\x8a\x62\x0f\xfc\xeb\xc7\x83\x24\x18\xea\xc0\x0b\x4a\x04\x85\xa1\x20\xdc\xa5\xb1\x17\xba\x67\xd2" +
+	"\xf6\x6d\x2c\x5a\xd9\xdc\xb7\xd5\xcd\x39\x79\x48\x61\x34\x4c\x81\x24\x14\xd2\x35\x49\xa8\xcf\x8a\x26\xf5\x45\x15" +
+	"\x2e\xc1\x5a\x7c\xf1\x3a\x51\x55\xa4\x47\x78\x1f\x62\x53\x44\xa0\xd4\x97\x31\x53\x59\xca\x0e\x93\x98\xed\xf9\xee" +
+	"\x0b\x7f\x0e\x24\x4c\x28\xf1\x5f\x84\x82\x92\xb4\x12\x30\xc7\xfb\xed\x05\xc7\xe1\x90\xad\xf8\xce\x24\xbc\x6b\x7b" +
+	"\x85\xc3\xed\x43\x13\x3d\x64\xbe\x85\x3b\x99\x68\x0b\x98\x46\x8e\xcd\x7c\x5c\x9f\x0d\xd2\x6d\x23\x7b\x8c\xa5\x06" +
+	"\x6d\x7a\x8d\x94\x57\x41\x15\xd5\x81\xba\x70\x0b\xdf\xe2\xf8\xe9\x35\x07\x2a\xea\x0d\x65\x81\x6e\x16\xa1\x4b\xb3" +
+	"\xad\xf9\x5f\xfb\x37\x7b\x20\x4a\xb9\xf7\xf9\x03\x5c\x5d\x61\xa2\x34\x1a\x76\xd8\x76\x95\xd4\x0d\x69\x46\x93\x4d" +
+	"\x10\x51\xc8\xb7\x08\x0d\xe2\x04\x82\x28\xa5\x49\xc6\x95\xfb\x43\xc5\x4a\x9b\xfd\xb7\x0b\x96\x30\x5f\xf8\x1d\x4d" +
+	"\x5c\xdb\xf1\x30\xd6\x99\xee\x43\x41\x7d\x5a\xfa\xba\x1d\xb1\x5a\x47\x37\xa8\x1d\xa8\x61\x5d\x07\xaa\x3c\xea\xc0" +
+	"\x0e\x35\x7f\x67\x8d\x67\xb6\x0b\x2d\x63\x93\x86\x94\x1e\x34\x88\xea\xa9\x39\xb4\x66\x45\x7b\xec\xda\x72\xe9\xd9" +
+	"\x2d\xd6\xb9\x0f\xd3\x02\x45\x5f\x83\x2c\x1e\x23\xd9\xf5\x05\xf5\x29\xf8\xad\xc1\x74\x87\x3b\xdb\x35\xd5\x58\x54" +
+	"\x4d\xdd\xb7\xa8\xb1\x67\xfe\xac\x5f\x22\x85\x9a\x73\xbc\xb8\x1d\x12\xb9\x6e\x17\xfe\x14\xc7\x73\x6e\xbe\x5c\xd2" +
+	"\x34\x3d\xa0\x5d\xcd\xe1\x74\x62\x37\xb5\x93\x84\x4f\x73\x78\xc0\x0e\x95\xab\xb5\x10\x49\x20\x91\x37\x7e\x9e\x42" +
+	"\xc8\x3b\xbe\x3a\x73\x59\x2c\x3b\x0c\x63\xe2\x8b\x12\xbf\x78\x78\x4f\x52\x9e\x8f\xac\x82\x90\x02\x89\x7c\xd8\xc4" +
+	"\x4f\x14\xcb\x06\x18\xf6\x63\xc2\x47\x12\x4a\xaa\xe5\x05\x4f\x0f\xae\x71\xf8\xf3\x3a\x58\xae\xcd\xba\xdd\x3d\xc5" +
+	"\x53\x88\x54\x54\x18\xf0\xa4\x61\x4d\x01\xe3\xe0\x4b\x04\x0e\xdb\x24\x5e\x52\x3f\x4f\x6a\xaa\x17\x98\xce\x6f\xe2" +
+	"\x27\x91\x70\x22\xaa\x02\x27\x20\xab\x8c\x26\xba\xab\xca\x53\x1c\xf7\x40\x23\x9a\xf0\x92\x40\xda\xf3\xd6\x41\x0a" +
+	"\x24\x0c\xe3\xe7\x9a\x43\x04\xcd\xb3\x6b\x60\x24\x3a\x29\x2e\x75\x4f\x21\xd8\x6c\x43\xb6\x3f\xea\x33\x84\xf8\xc6" +
+	"\x02\x8a\x07\xad\xbf\xe7\x01\x26\xc9\x55\xe0\x6c\xb7\x24\x84\x0c\x6f\x3a\xe0\x6c\x60\xa7\x95\x5b\x51\x24\x4a\x33" +
+	"\x12\xf9\x24\x29\x82\x0a\x48\x5f\xd2\x8c\x6e\x7a\x6e\xbe\x5c\x2b\x7a\xa4\xac\xce\x54\x05\x2d\x11\x62\x84\xe4\xf4" +
+	"\x89\x57\xac\x82\x50\x70\x11\x89\x2b\x09\xc5\x23\xd9\x2e\xfa\x97\x25\x09\x43\xce\x9d\x15\xc9\xc3\x1a\xe0\x9c\x7e" +
+	"\x0b\xc9\x9f\x56\x5b\x61\x24\x63\x9f\xc8\x2f\x97\x88\xc4\x7c\xf6\x15\xf5\x22\x34\xd4\xd2\xe9\xb6\x2e\xbf\x3d\xe7" +
+	"\x47\xbd\x13\x7c\x1e\xaf\x0a\x69\xcb\x62\xd8\x90\x6c\xb9\x36\x0e\x9b\x94\x50\x6d\x49\x96\xd1\x24\xfa\xe7\xd6\x04" +
+	"\xa4\x20\x9b\xf5\xa4\xf3\x5d\xb5\x01\xfe\xc5\x12\x81\xf1\xba\xa6\x96\x64\x0e\x10\x1a\x85\xe6\x12\x6a\x47\x88\x0a" +
+	"\x71\xed\x4b\x56\xd4\x96\xf5\x35\x3e\x42\x46\x23\x6f\x48\x4a\x7d\x94\x51\xc6\x33\x4e\x74\xe1\x78\x4b\x2c\x61\x32" +
+	"\x66\x7a\x39\x2d\x10\xde\x63\xa1\x79\x9c\x47\x96\x59\xf0\x54\x01\x28\xb6\x57\x14\xe9\xc7\xa3\x9f\x35\x47\xd2\xe3" +
+	"\x08\x95\x23\x2c\x39\x60\xc1\xae\x20\x5c\xe1\xd4\x0e\x5c\x96\x42\x51\x98\xce\xbc\x8e\xce\xa9\xe9\xcc\x93\x71\x8d" +
+	"\x31\xf0\xb8\x42\x86\xcd\x42\x7f\x24\x0d\x5a\x9c\x52\x16\x96\xea\xa1\x31\xe2\x53\x09\x84\x6b\xea\x17\xb7\x49\xbc" +
+	"\x89\x33\xcd\x68\x72\xe5\x6d\x8d\x86\x70\xd9\x2e\x6d\x5a\x0c\xc2\xf2\xba\x89\x7f\xb1\x75\x5c\xb4\xc3\xf6\xd1\x81" +
+	"\xf3\x0e\x34\xdf\x59\xe3\x11\x06\x26\x4d\x3d\x84\x91\x54\x91\x62\xc1\x89\x24\x44\x68\x3a\x93\xd2\x71\x06\xc5\x8e" +
+	"\x83\x95\xc8\xbe\x34\x28\x3c\x31\x13\x77\x4e\x24\x5d\xf5\x31\x47\x90\x55\x4b\xfa\x58\xbe\x87\x96\x56\xf8\x1e\x96" +
+	"\xf8\x3d\x91\x20\x44\xdc\x0e\x51\xb7\x92\xbc\x9e\xc1\x4f\x34\x93\x3e\x87\x39\x37\xdd\x2e\xe2\x6f\x26\xbc\x80\x74" +
+	"\x4b\x4b\x0a\x45\x37\x5b\x51\x0a\x90\xaa\xfd\x6d\x9d\x09\x90\x91\x9c\x6e\x01\x4a\xe3\x70\x59\x01\x6d\x0f\x38\x36" +
+	"\xac\x1e\x9c\xdc\x92\xc7\x2c\x7c\x25\xf9\x54\x98\xf4\x79\x1d\xa3\x12\xd4\x19\x7b\x12\xa3\x8a\x9f\x7b\x72\x8a\xbf" +
+	"\x5c\x3e\x2c\x57\x55\x10\x8b\xa6\xd8\x0f\x9c\x81\x8b\x2c\xa9\x66\xd3\x3a\x2d\x76\x6f\x49\x27\xec\xe7\xdb\x12\x62" +
+	"\xa1\x6f\xa9\xa8\x99\x04\x2b\x5d\xbc\xe0\x99\x26\xb4\xb8\x88\x20\x35\x45\x31\xed\x28\x3d\x51\xac\x67\x8a\xc7\x60" +
+	"\xe9\x81\xc9\xcb\x51\x46\x48\x95\x41\x04\x1a\x8a\xd4\x47\xa1\xa1\x18\xf6\x57\xd0\xf8\x6e\x57\xa9\x29\x58\x29\x40" +
+	"\x78\x77\x4d\x54\x29\xd8\x1d\x36\x1e\xc3\xc6\xe2\xec\x41\xa9\xce\xef\xa1\xa1\x34\x78\xa6\xce\xe5\xc9\x2b\xde\xf6" +
+	"\xa1\x29\x64\x2b\x5e\x94\x93\x6a\xe3\x6e\x03\xbf\x13\xa0\xdd\x30\x30\xe4\x4c\xab\x20\x28\xca\xe9\x55\xf8\x57\xdc" +
+	"\x2b\x30\xcc\xb2\x5e\xa7\x50\x96\x3f\xdd\x2e\xe8\x1f\x74\x99\x67\x14\x77\x81\x5b\xe9\xc0\xa4\x29\xf7\x30\x9a\x78" +
+	"\x68\xcf\x9b\x1d\x7d\x5b\x3c\xe2\x60\x96\xfc\xff\x29\x33\x7e\x06\xf7\x09\x25\x06\x89\xb9\x21\xdf\x26\x34\x45\x7f" +
+	"\x1c\x44\x90\x9a\xfa\x38\xba\xd6\x8a\x20\x0c\x6c\x07\xce\xdb\xf0\x23\x9c\x43\x8d\x98\x28\x5f\x15\x6f\x69\xc2\xc2" +
+	"\x73\xee\xac\xb8\xb3\xe9\xc0\xd7\x1d\x23\x75\xab\x63\xf5\xb6\x70\x8a\x7e\xb3\x4e\xfc\x4c\x6f\x51\x91\x10\x31\x17" +
+	"\xa3\xe0\x3b\xdc\xcb\x1c\x5a\xac\xda\x95\xc4\xcf\xa9\x5e\x2c\xe4\xa9\x3d\xdb\x52\x45\x3a\xff\xbf\xa1\x24\x52\x42" +
+	"\xc7\x31\x31\x52\x06\x0c\xe1\x53\x68\x05\xd1\x32\xcc\xd9\xc9\x1e\x8f\x9a\xd8\xf3\x36\xd2\x99\x44\x2f\x86\x59\x2b" +
+	"\x0b\xde\x1e\x33\x95\xe4\xaf\xb2\x4f\xc7\x55\x02\xa5\xc2\x88\xf4\x5b\x44\x8b\xd5\x97\x78\x11\xa5\x56\xa5\xaa\x43" +
+	"\xa5\x28\x56\xdf\xd3\x24\x59\xf0\xd3\x33\x05\xea\xe2\x82\xbb\x3e\xa9\xa1\x6a\x98\x38\xf9\x32\xdc\x95\xa6\x73\xca" +
+	"\x68\x9e\x41\x73\xc1\x8a\xb1\xe9\x9c\x5b\x5f\x69\x81\x87\x49\xbc\x2d\x17\x6b\x91\x1d\x01\x2f\xa8\xa7\x72\xf8\xe8" +
+	"\x1a\xa6\x6f\xfe\xdb\x1e\x78\x8b\xd1\xb0\xa5\xb0\x6d\xb3\x8b\x4f\xfc\x6a\xbd\x1c\x6c\xd2\xb2\x6c\x5e\x86\xce\xf4" +
+	"\xb6\xb8\xaa\xcd\x2e\x0e\x29\x60\xc6\x8c\xdd\x51\x32\xc2\xa9\x0e\xad\xea\xbf\x1c\x23\xe4\x53\xca\xa8\x92\xd3\x78" +
+	"\x0b\xf1\x13\x4d\x78\xe5\x5a\x9e\x97\xa3\xb7\x30\x42\xfa\x0a\xc3\x92\x3c\x84\xc1\xcc\x71\xa7\x0e\x5c\x4f\x1d\xf9" +
+	"\x9a\xcb\x2f\x97\x9a\x8e\x71\x55\x09\xe0\x38\x21\x7e\x95\x20\xe3\x7f\xd3\x5b\x7b\x82\x2b\xe9\xcc\x3d\x83\xdb\x84" +
+	"\x6e\xb1\x5e\xdf\xf2\xc9\x2a\x83\x1b\x17\xef\x2b\xb6\x21\x8c\x63\x55\x6a\xbb\xb6\xbd\xc1\x5b\x98\xd8\xbf\x79\x85" +
+	"\x66\xe1\xa6\x58\xb5\x52\x2b\x06\xaa\x1d\x34\x6a\x98\xc9\xac\xb3\x70\x18\x5f\x16\xa5\x4e\x93\xa9\xcc\xbe\x33\xe8" +
+	"\xad\x9a\xeb\x5c\x26\x4c\x4d\x49\xe4\x21\x2a\xfe\xf7\xeb\xdb\xd1\xd8\x86\xab\x2b\x86\xef\xc2\xf5\x2c\x6f\xe6\xca" +
+	"\x83\xa2\x3d\x02\x67\xc0\xd3\xa1\x9f\xc1\x45\x75\x34\xaf\xb5\x03\x38\xb3\xb1\x7d\x54\xad\xfd\x7b\xee\xf6\x24\x8d" +
+	"\x6a\xfc\xdb\x31\xd5\x6e\xf1\x41\x99\xb4\xb6\x5b\x1a\x19\xd6\xd3\xfd\x65\xbc\x4b\x97\x2a\xaa\x20\xbf\x67\x25\xd6" +
+	"\x74\x9a\xb0\x87\xfa\x75\x53\xe5\xb6\x01\x6a\xa6\x8a\x51\x4d\x79\x21\x0f\xa5\xa5\x09\xf0\xea\x65\x76\xb8\xf6\x9a" +
+	"\x65\xf4\x91\xfb\x64\xa9\x6e\x19\x33\x4e\x39\xc6\x66\xce\xd9\x66\x5e\xbd\x4c\xa1\xb9\x93\xa9\xd7\xaa\x8a\x46\xdd" +
+	"\x85\x46\x53\x02\x5e\xa7\x91\x62\x96\xf0\x68\x15\x05\x29\xcb\x8a\x42\x53\xde\x85\xdd\x4b\x49\x19\x77\x0a\xe9\x74" +
+	"\x69\x06\x7e\xbe\xd9\xbc\xe8\x02\x8a\x77\xda\x99\x59\x42\xd7\x11\xc5\xdc\x98\x92\xed\x36\x0c\xa8\xaf\x7b\x90\x92" +
+	"\x82\xd7\x60\x27\xe4\x95\x11\xf3\x1c\x31\x1b\x4d\xbc\xe2\x8a\x24\xae\xba\xc0\x53\xc7\x45\x14\x2f\x30\x4a\xe1\x46" +
+	"\xf2\xa2\x7f\xae\x34\x6e\xd7\x7e\xdb\x40\xf6\xd2\x9e\x55\xd3\xed\xc2\x7f\x30\x87\xb8\x0a\x22\x62\xdc\x2d\xc7\xdb" +
+	"\xde\x6a\xa3\xf7\x79\x10\xf2\xb2\x0c\xee\x29\x6d\x1c\x56\xf7\x26\x46\x45\xda\x21\x69\x71\x83\xbc\x79\xc4\x4c\x8d" +
+	"\x6c\xc7\x8c\x36\x5c\xdf\x01\xd7\x28\xff\x80\xcc\xbd\xa5\xc9\x2a\x4e\x36\x3c\x18\x58\x62\x9a\x81\xc1\x5a\x51\x48" +
+	"\x46\x1c\x52\x58\x93\x27\x5a\xb4\xc9\x94\xd9\xdb\xed\x6a\x09\x1d\x63\x0f\x11\x89\x9c\xa8\x8a\xa2\xd6\xe9\x91\x46" +
+	"\x1d\xd7\x84\x00\xd4\x25\x1f\xcc\x8b\xd4\x78\x17\x9e\x3d\xbc\x2e\x75\x28\x4b\xfa\x5f\xa2\xe7\xeb\x52\x0d\x01\xcc" +
+	"\x08\x42\x61\x8f\x33\xe3\xb7\xd9\x42\xba\x2c\xea\x34\x79\xf4\x18\xc5\xcf\x51\x71\x6f\x8d\xd5\x89\x49\x10\x96\xe3" +
+	"\x6a\x19\xc4\x14\x14\xdf\xd0\x34\x25\x0f\x66\x8c\x25\xa3\xcf\x02\x90\xf8\xd4\x54\x73\x6b\x66\x68\x27\x65\x6a\x06" +
+	"\xde\x12\x34\x26\x30\x85\x2c\xc0\xf7\xa1\xd9\x6c\xd4\x42\x0b\x1f\xb7\x75\xc1\x54\x5d\x40\xa5\xad\x6b\x8c\xab\x0f" +
+	"\xab\xea\x8e\x59\x8f\x09\xad\xc4\x58\x19\x5d\x85\x8f\x5b\xf3\xe9\x91\x11\x56\x9d\x4d\xc7\xdd\x96\x6d\xba\xbe\x2d" +
+	"\x63\xf2\xc1\xd0\xa7\x5e\x62\x24\xe9\x8b\xa0\xc6\x88\xce\xf7\x6b\x5d\x31\x41\xe5\xb2\xc5\x93\x33\x68\x36\x3b\xfc" +
+	"\xff\x26\x3b\xcd\x47\x9e\x96\xcb\x01\xf5\xa5\x01\x71\xff\x7c\x38\x72\xbd\xd1\x84\x5f\x44\xbf\x3c\xaf\xeb\x78\xb8" +
+	"\xda\xdb\xe9\x70\x81\x8d\x0e\x60\xb9\xc7\xad\x2d\x3e\x35\x56\xe3\xb8\x99\x1f\x64\x56\x4c\xe9\xdf\x09\xbb\x7c\xb6" +
+	"\xab\xe0\x8a\x67\x3a\x4c\x9c\xda\x86\x65\x75\xc7\x47\x5a\xad\xe3\xac\x57\xf5\x20\x47\x99\x31\x7c\xd7\x2f\x42\x64" +
+	"\xc3\x84\x89\x2f\x9a\x32\x7c\x59\x97\x0d\xee\x34\x07\xfc\x0f\x48\xf3\xe2\xb6\xcd\x61\x2a\xcc\xa5\xed\x3b\x24\x38" +
+	"\x45\xa0\xc7\x65\x57\x4f\x7c\xc1\x6c\xa5\xa0\x24\x3b\xa4\xc0\x1f\xa2\xc4\x62\x5e\x39\xff\xc4\xef\x60\x3c\x75\x6d" +
+	"\x66\x52\xc4\x13\xfc\x0e\x6d\x6b\x3c\x9e\x0e\xf0\x82\x44\xc5\xda\xe8\xf9\x09\x3f\xb1\xc6\xc0\x6b\x45\x82\x90\xfa" +
+	"\xfc\xbe\x8f\xb8\xc2\x5c\x95\x8d\xe3\x6a\x4c\x08\xdf\x00\xa0\x15\x93\xb4\x35\x99\x37\xe1\xad\x62\xc0\x2a\x46\xdd" +
+	"\x22\x54\x60\x85\xa6\xde\xdd\x17\xa9\x28\x36\x7c\x91\x6a\x65\x24\x5e\x12\xd3\x23\x6f\xf1\x4b\x56\x98\x7c\x9a\x9a" +
+	"\xea\xc3\xab\x4c\x97\xbb\xe2\xd1\x33\x98\x46\xe1\x0b\xf8\x58\xc5\xc0\x32\x72\x92\x87\x2f\x40\x37\xdb\xec\xa5\xb1" +
+	"\xdf\xc8\x1d\x13\x5a\x34\xaa\x84\xfc\x1c\xa1\x41\xff\x50\x68\x50\xae\xe2\xb4\x18\x01\xda\xa5\x10\xeb\x1f\x53\x84" +
+	"\x91\x2c\xc4\xa0\x86\x39\x2e\x76\x1f\x3f\x89\xc3\x10\xab\x91\xd8\x63\x89\xc7\x9f\x71\xc4\x07\x9d\xc1\x35\x9e\xa6" +
+	"\xae\xf1\x58\xe0\x99\x42\x46\x1e\x69\x51\xb2\x61\x52\x06\x6b\x12\xf9\x2c\xf4\x09\x22\x88\x13\x9f\x26\x58\xd0\xc4" +
+	"\x0e\x87\x42\x37\x00\x8f\x45\x37\xdb\x2c\x55\x35\x3e\xf0\x1c\x6b\xe2\x5a\xac\xe3\xbe\x61\x3c\x96\x5d\x56\xac\x88" +
+	"\x29\x0e\xc5\xd2\x2c\xc6\xfb\x8e\xea\x8c\x9f\xdd\x11\xd0\x6e\x5e\xe0\xb5\x82\x16\xae\x02\xdd\xee\x8f\xac\x2e\xdc" +
+	"\x6e\x1c\x26\x60\x53\x44\x0f\x98\x58\x30\x68\xc5\x2d\x6a\x7e\x37\x01\x99\x27\x6e\x28\x28\xbb\x8f\x78\x39\x74\x1b" +
+	"\x92\x25\x55\x07\x7e\xb0\x25\x09\xd9\xd0\x8c\x26\xec\xee\xe5\xef\x79\x9c\xa9\x5b\x98\xfe\x4b\x44\x36\xc1\x12\x7e" +
+	"\xcf\xb1\x7a\xdc\x92\x47\xbc\x0a\x45\x84\x69\x9c\x46\xca\x37\x4c\x5e\xd4\xa1\x70\x1f\x1c\xfb\x76\x6c\x61\x50\x20" +
+	"\x1f\x76\xa0\xa9\x4f\x6d\x76\xb8\xfd\xd6\x9e\x19\x36\xfc\x18\xca\x28\xe8\x8d\x7d\xc2\xa5\xe1\xc0\x34\x0c\x1f\x8a" +
+	"\x75\x58\x70\xaf\x34\x4d\x4b\x08\x0d\x2d\xdb\x83\x03\x06\xfd\x72\xdc\xe8\x1a\xc6\xf6\xa4\x85\x00\xda\x18\x7c\xe9" +
+	"\x75\x76\x3c\x6d\xea\xfe\xe8\x1a\x17\x75\x98\x29\x54\x7f\xcb\x00\x53\x48\x0c\x0e\x35\x7b\x58\xc3\x08\xf9\x87\x6e" +
+	"\xd7\xcc\x45\x51\x42\xf8\xc5\x47\xd6\x78\x8c\xa4\x51\xb9\xe8\x5e\x22\xa5\xbf\xef\xa8\x0a\x2e\x43\x4a\xa2\xbc\xb8" +
+	"\x6d\x83\x02\x27\xc1\xb5\x3c\x67\x36\x41\x2f\x74\x0c\xab\x9a\x5e\x92\x47\x4b\x76\x7d\x4a\x4a\x6d\xb3\x51\x67\x6f" +
+	"\x04\x54\x6e\x73\x5e\x53\x86\xd9\x93\xa5\x97\x77\x61\x5a\xb0\x9d\x04\x11\x86\x48\xea\x3c\xa3\x38\xf2\x53\xda\x13" +
+	"\xcd\x30\x0c\x2c\x6f\xf0\x56\xc3\x01\xeb\xc6\xac\x83\x8a\xff\x6d\x03\x91\x0f\x68\xbd\xee\xc9\xf2\xb1\x62\xbf\x84" +
+	"\x2d\xbf\xc2\x17\x2a\xd7\x73\xa6\xe3\xf1\x1b\x6b\xf0\xb3\x61\x8b\xaa\xe4\x3e\xce\x71\x1b\xc1\xd6\x1e\x5e\x09\x27" +
+	"\x8e\x26\x8b\xab\xa7\x88\x20\xe4\x74\x24\x04\xbf\x54\xc8\xab\x00\xb1\x4f\x1b\x25\x7f\x7c\x71\x2e\x09\xa8\x68\x23" +
+	"\xe9\xe8\xce\x06\x03\xdb\x75\x4d\x4a\x1e\x75\x2b\x51\x40\x18\x4c\x6f\x6e\x46\xde\x3e\xb7\xc0\x47\xd4\x51\x4f\xdb" +
+	"\x41\xaa\x5d\x8b\xe4\x37\x1e\xc5\x08\xf4\x42\x9f\xee\xf6\x23\xec\xe9\x04\x65\xe1\xe3\x67\xee\x03\xad\xbf\xe8\xb7" +
+	"\xaf\x17\xf4\x98\xfe\xc7\xbf\xa5\xf1\x51\x66\x02\x1f\xd8\xf2\xb8\xbf\xe3\x51\x55\x67\xa5\xe7\x28\x12\x1f\xdc\x9e" +
+	"\x16\xf4\xa2\x05\xe6\x97\x1d\x99\x1d\x16\xf7\x43\x8b\x14\x71\x19\x92\x3c\xa5\xff\x5e\x4d\x79\xa7\xe6\xbb\x4f\xd3" +
+	"\x7c\xa7\x67\x6e\x47\x27\xb9\xbc\x87\xa3\xee\x58\xe9\x98\xe3\x24\x75\x49\x24\x5b\xd3\x8a\x46\xeb\xb7\x48\x19\xc5" +
+	"\xf1\xae\x2b\xb6\x5d\x0a\xe3\xfa\xd5\x97\x3a\xd2\x9c\xe5\x58\xb3\xb9\x80\x0b\x2d\x51\x2a\xd7\x5f\x4d\x94\x77\x04" +
+	"\xd6\x75\x2e\x59\xe4\x9d\xba\x43\xae\xf3\xbc\x3a\x56\x9a\x6e\xd6\xd1\x8a\xab\xba\xa2\x35\xe3\xf2\x62\x32\xbb\x79" +
+	"\x63\x3b\xad\x76\x95\xe2\x05\x5b\x8a\x51\x37\xb6\xeb\x5a\x3f\xd9\x2d\xbd\x67\xe5\x08\xd2\x97\xe4\x40\x76\xfe\x15" +
+	"\xbb\xf8\xf2\xab\x86\xa9\x95\x32\x21\x3c\xb2\xcd\xca\x28\xed\xf2\x3b\xd2\x52\x10\x0d\xe5\x2c\x27\xb7\x7b\xf4\x4e" +
+	"\xaf\x8f\xbe\x4e\xe1\x76\xb5\x25\x18\x35\x6d\xc5\xca\x5a\x95\xdb\xd7\x62\xa5\x6d\xf7\x6f\x6a\xaf\xaa\x69\xda\xdb" +
+	"\xd3\x61\x24\x5b\xcf\xfe\x51\x6d\x6b\x7a\xe1\x77\x4f\x77\x91\x42\xe0\x70\x6f\x91\x54\x9c\xd7\x74\x08\x95\x26\x1d" +
+	"\xd1\xe4\x53\x46\x09\x95\x59\x42\x81\x7e\xd9\x72\xbc\x92\x7a\xff\x67\x9a\x65\x8c\xbf\x9f\x73\x18\x3f\x47\xa7\x00" +
+	"\xf2\x14\x40\x9e\x02\xc8\x53\x00\x79\x0a\x20\x4f\x01\x24\x0b\x20\x8f\x8d\x5b\x6a\x02\xcd\x53\x38\x73\x0a\x67\x3e" +
+	"\x6d\x38\x73\xea\xfd\x3d\xf5\xfe\x9e\x7a\x7f\x4f\xbd\xbf\xa7\xde\xdf\x53\xef\xef\xa9\xf7\xf7\xd4\xfb\x7b\xea\xfd" +
+	"\x3d\xf5\xfe\x9e\x7a\x7f\x4f\xbd\xbf\xa7\xde\xdf\xff\xb0\xde\xdf\xbf\xde\xe2\x7b\xb4\x11\x3a\xee\x1c\x4a\x6a\x85" +
+	"\xc8\xb1\x45\x48\x78\x6a\xf0\x3d\x35\xf8\x7e\xa4\x06\x5f\x35\xe4\xd4\xde\x7b\x6a\xef\x3d\xb5\xf7\x9e\xda\x7b\x4f" +
+	"\xed\xbd\xa7\xf6\xde\x53\x7b\xef\x7f\x7a\x7b\xef\xab\x33\x82\x6a\x0b\x98\x01\x4f\xcb\x04\x3e\xa8\x71\xeb\xf8\xb6" +
+	"\x2d\x45\xe0\x53\xbb\xd6\xa9\x5d\xeb\xd4\xae\x75\x6a\xd7\x3a\xb5\x6b\x9d\xda\xb5\x4e\xed\x5a\xa7\x76\xad\x7f\xd3" +
+	"\x76\x2d\xe6\x67\xe5\x7d\x15\xa8\xde\xb6\x2d\xce\x7c\xf1\x74\x71\x2e\x86\xe3\x3f\x8b\x0b\x3b\xff\x5d\x5c\x31\xea" +
+	"\xb8\x7f\xe4\x5b\x8c\xe6\x6a\x57\x01\x1a\xc6\xf1\x63\xbe\x9d\x37\x7e\x68\xfc\xef\x00\x07\xd0\xb7\xfb\xca\x7f\x00" +
+	"\x00")
+
+func bindataMigrations20190506101500LookupvalidationrulesqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190506101500Lookupvalidationrulesql,
+		"../migrations/20190506101500-Lookup_validation_rule.sql",
+	)
+}
+
+
+
+func bindataMigrations20190506101500Lookupvalidationrulesql() (*asset, error) {
+	bytes, err := bindataMigrations20190506101500LookupvalidationrulesqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190506101500-Lookup_validation_rule.sql",
+		size: 32714,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792403075, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190510083000Deliverychecksql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x1b\x7f\x6f\x9b\x4a\xf2\x7f\x7f\x8a\xf9\xe7\xc9\xf0\x4a\xfc\x12\xb7" +
+	"\x3d\x9d\xde\xab\xa3\x50\x9b\xb4\x56\x1d\x9c\x03\xfc\x9a\x2a\xb2\x2c\x62\xd6\x36\x0a\x06\x3f\xc0\x49\x2a\xbd\x0f" +
+	"\x7f\x9a\xfd\xc5\x2e\x60\x27\xbd\x6b\x74\x17\x2a\x25\xb0\xb3\x33\xb3\xf3\x6b\x67\x66\xb7\x9d\x93\x13\x78\xb3\x8d" +
+	"\xd7\x79\x58\x12\x98\xed\x3a\x43\xcf\xb1\x03\x07\x02\xfb\xe3\xc4\xb9\xdd\x92\x32\x9c\xf7\x6e\xc3\x75\x4e\xc8\x96" +
+	"\xa4\xe5\x62\xb9\x21\xcb\xfb\x79\xc7\xe8\x00\x00\xdc\xc6\xd1\x1c\x6e\xef\xe2\x75\x9c\x96\x73\x18\x8f\x1c\x37\x18" +
+	"\x07\xdf\x8c\x33\xeb\xcc\x04\x77\x1a\x80\x3b\x9b\x4c\xac\x0e\x03\xad\x50\x68\x93\xea\x60\x14\x3f\x03\x39\x32\x5e" +
+	"\x7e\xdf\x91\x39\xdc\xa6\x0f\x61\xbe\xdc\x84\xf9\x1c\x8c\xfe\x69\x93\xe4\x32\x4b\xf6\xdb\x74\x91\x86\xdb\x1a\xf0" +
+	"\x59\xff\x9f\xa6\x06\x59\x66\x09\xc9\xc3\x74\x89\x70\xab\x24\x0b\xcb\xb9\x36\xbc\x8d\xd3\xc5\x43\x98\xec\x0f\x0d" +
+	"\x87\x4f\xc7\x86\x37\x71\x51\x66\xf9\xf7\xfa\x8a\x60\x38\x75\xfd\xc0\xb3\xc7\x6e\x70\x3b\xba\x5c\xd4\x44\xbc\x90" +
+	"\xb3\x46\xce\xa5\x3d\x9b\x04\x86\xf1\xde\x34\x05\xca\xa2\x0c\xcb\x7d\xd1\x2e\xc9\x67\xf0\x2a\x53\x25\xe6\x7e\x85" +
+	"\x79\x99\x93\xb0\x24\x51\xb9\x9d\xc3\x6d\x14\x96\xa4\x8c\xb7\xe4\xc5\xb8\x95\xc9\x02\xf7\x9a\x94\x88\xc6\x40\x0a" +
+	"\xea\xec\xeb\x2f\xf5\xd9\x73\xb8\xf6\xc6\x57\xb6\xf7\x0d\xbe\x38\xdf\x60\x38\x99\xf9\x81\xe3\x39\x23\x66\x6a\xd4" +
+	"\xd2\x6c\x7f\xd8\x31\x3b\x26\x4c\xdd\x5b\x0e\x3a\xef\xfc\xd1\xb1\x27\x81\xe3\x1d\x37\x57\xf8\x3a\x0e\x3e\xc3\xf0" +
+	"\xb3\x33\xfc\x02\xf6\x68\xa4\xf2\x71\xd9\xe0\xa3\x7a\x9f\xc3\xe5\xd4\x73\xc6\x9f\x5c\xe4\xc8\xd0\x2d\xd8\xec\x78" +
+	"\xce\xa5\xe3\x39\xee\xd0\xf1\x1b\x64\xe7\xc8\x32\x7d\x0c\x64\xdc\xec\x4c\x5d\x18\x39\x13\x27\x70\x60\x68\xfb\x43" +
+	"\x7b\xe4\xbc\x06\xdf\x4c\xb3\x35\xa6\x2b\x75\xb7\x71\xcc\xa7\xd4\xd9\xfd\x6f\x99\x1b\x36\x99\x53\xfc\xb6\xc3\x16" +
+	"\x64\x68\xbe\x3c\x76\xc1\xe8\x7a\xd3\xaf\x8b\xe1\x74\xe6\x06\x5d\x0b\xba\x68\x6f\x0b\xcf\x0e\x1c\x7c\xb9\x72\x6c" +
+	"\x97\xfe\x1e\xb3\x5f\xf6\x0d\xfe\x1a\x8d\xfd\x60\xec\x0e\x03\x3e\xc9\x44\xce\xdb\xc2\x57\x44\x92\xf8\x81\xe4\xdf" +
+	"\x17\xb8\xde\xff\x24\x78\x49\x04\xad\x1e\x27\x7d\xe7\xe7\xc5\xa6\x1a\xf4\x91\xe8\xf2\x43\x0e\xab\x09\xe2\x07\xdd" +
+	"\x55\x9b\xfb\x13\x9d\xb5\x86\xf7\xa8\xc9\x6b\xb0\x92\xa3\x9a\xc9\x4b\xa0\x03\x46\x2f\xa7\xbd\xc4\x4b\x99\x39\x75" +
+	"\xae\xbd\xe9\xd0\x19\xcd\xbc\x43\xde\xb0\x08\xa3\x68\x0e\x27\x27\x7f\x77\x4e\x4e\xfe\x86\xc1\xab\xfd\x50\xf4\x23" +
+	"\x52\x2c\xf3\x78\x57\xc6\x59\xfa\x3b\xd8\x51\x04\x21\x88\x35\x41\x42\x1e\x48\x02\xd4\xb3\xa0\xcc\x20\x84\x62\x47" +
+	"\x96\xf1\x2a\x5e\x82\xe4\xb8\x17\x6c\x08\x6c\x49\x99\xc7\x4b\xc8\x56\x50\x6e\x08\x83\xa7\xb8\x85\x48\xe8\x13\x17" +
+	"\xb0\xcc\xb6\xbb\x7d\x49\x22\x58\x65\x39\x10\x4a\x42\xd2\x8a\x53\x28\xca\x70\x0d\x61\x1a\x51\xb8\x30\x27\x11\x12" +
+	"\x5d\xc5\x4f\x24\x82\xbb\x6c\x9f\x46\x05\x0e\xfe\x96\xe5\x4d\xdc\x48\xb6\xcc\xc3\x38\x89\xd3\x35\x84\x0f\x24\x0f" +
+	"\xd7\x44\xb0\xb3\xcb\xc9\x43\x9c\xed\x8b\xe4\x3b\xec\xf6\x77\x49\x5c\x6c\x48\x24\x96\x18\x93\xa2\xd7\xc4\x36\xc4" +
+	"\x05\x00\x86\x97\x02\x0c\xc5\xb7\x20\x27\x7f\xed\xe3\x9c\xf3\x1f\x26\x09\xdc\xed\x4b\x90\x71\xc6\xfc\xbd\x89\x0a" +
+	"\xaa\x61\x7c\x01\x38\x81\x74\xbf\xbd\x23\x39\x72\x97\x67\x8f\x05\xc4\xa9\x94\x77\xdb\x74\x19\xb8\xc4\xf4\x1d\xc9" +
+	"\x97\x24\x2d\xf9\x02\x71\x18\xa8\x43\x53\x4c\x8c\xd9\x36\x3c\x18\xf3\x2c\xb8\x1a\xbb\x16\x5c\xd9\x37\x8c\x0d\x82" +
+	"\x4a\xc3\x28\x16\x17\x25\x53\xdf\xe1\xf9\x7a\x74\xd4\x96\x11\xe1\xf4\x74\x59\xb6\xf3\x61\xe7\xeb\x3d\x9a\x76\xf1" +
+	"\x3b\x0f\x95\x17\x95\xb5\xc7\x11\x7c\x1c\x7f\x1a\xbb\x81\x25\xc8\xe0\x8c\xf1\x08\x59\x41\xd7\xe8\x49\xd0\xca\xb0" +
+	"\xa0\xd8\x64\xfb\x24\x82\x3b\x02\x49\x9c\xde\x53\x23\x61\x78\x45\xb6\x87\x2f\xa0\x22\xe5\x78\x6d\x89\x4c\x9a\x31" +
+	"\x9d\x02\x71\xa4\x22\x40\xbd\x03\x80\xfb\xa7\xed\x0d\x3f\xdb\x1e\x06\x5c\x8b\x21\x08\x70\x24\x5b\x71\x3e\x8c\x82" +
+	"\x10\x08\xef\xb2\x07\x62\xf2\xe9\x8a\xa5\x54\xd3\x31\x04\x5b\x74\xfa\x90\x8e\xa3\x4d\x73\x3f\x10\x7e\x83\xd6\x64" +
+	"\x50\x4d\xe2\x5f\x95\x3d\x31\xb4\x32\x9d\xc4\xb7\xcb\xc9\xd4\x56\x16\x86\x68\xed\x24\xc9\x1e\xa9\x49\x3f\xc4\x21" +
+	"\xba\x31\xac\xf2\x6c\xdb\x74\x87\x38\x15\xb6\x23\x43\xd5\xc1\x07\xf1\x1a\x8a\xa5\xed\xb2\x38\x2d\x0b\xea\xb6\xd2" +
+	"\x20\x4d\x38\xa1\x7b\x07\x0c\xce\x21\xcd\x20\xca\xe3\x55\xc9\x7d\x1f\x71\x5c\xc8\x3c\xf7\x00\xdf\x93\xec\x91\xe4" +
+	"\xcc\xb1\xb9\xbe\x51\x16\x1a\xce\xa4\x02\xa1\x3c\x5f\xc8\xe4\xf8\x00\xce\xd9\x6e\xf7\x1c\xce\x7d\x05\xc2\x70\xf2" +
+	"\xdc\x18\x0e\x99\x8d\x2b\x0d\x5d\x8a\xb4\x8a\x1e\xa8\x4d\x21\xe0\x8a\xcc\x7b\x86\x59\xa6\x4b\xf8\xc6\x0c\x5d\x20" +
+	"\xa6\x98\x7d\x3a\x0e\x59\x0a\x77\x39\x09\x97\x1b\xe8\x83\xf1\xd5\xf6\x5c\x13\xb2\x1c\xde\x82\xe1\x78\xde\xd4\x53" +
+	"\xc5\xdc\xef\x98\x1d\xdb\x87\x0e\x4e\x3e\x79\xb5\x9f\xce\x47\xe7\xd3\xd8\xa5\x4b\x18\x39\xc3\x89\xed\x39\x70\xb1" +
+	"\x2d\xd6\x95\x49\xbf\x3b\x3d\x3d\x35\xf5\xf1\x65\xb6\x4f\x4b\x2a\x3e\x96\x49\xf8\x4e\xa0\xf9\xd3\x00\x66\xd7\xd7" +
+	"\x8e\x67\x28\xdf\xcc\x0a\x50\x51\xc1\x00\x86\x53\x7b\xe2\xf8\x43\xc7\x10\x9a\xb1\xe0\xbd\x02\xab\x08\x55\x85\x95" +
+	"\x9f\x2d\xe8\x9b\x8c\x07\x94\x12\x0f\xe5\x1b\x52\xed\x58\x40\x9e\xe2\xa2\x2c\x38\xc6\x89\x33\x0c\x04\xfb\x88\x6f" +
+	"\xe6\x06\xc6\xaf\x26\xf7\x90\x4b\x6f\x7a\x55\x0b\x44\x6c\xe4\xeb\x67\xc7\x73\x20\x8e\x60\xa0\x87\x33\x46\x78\x7c" +
+	"\x59\x61\x3c\xa5\x5f\x2a\x89\xe2\xe3\xd9\x63\x9f\xea\x16\x8c\x6e\x15\x94\x6e\x7f\x19\xff\xe3\x5d\x34\x87\x28\x23" +
+	"\x05\xa4\x19\xe7\xb3\x6b\xc1\xd9\x99\x05\x67\x96\x4e\x48\x70\x08\xe0\x39\xc1\xcc\x73\xa1\x4f\x3f\x38\xee\xa8\xb1" +
+	"\x76\x0c\x5a\x6c\x53\xc5\xf0\x53\x31\x58\x29\x07\xf3\xbb\x9f\x92\x32\x3f\xb3\xd6\x59\x7a\x9f\x66\x8f\x29\x8f\x9f" +
+	"\xd4\x2e\x6e\x7f\x29\xe6\xca\x12\xeb\xd6\xa1\x2c\xf0\xad\xbe\xc0\xda\x12\x06\xa0\x30\xcf\xad\x4f\x09\xc7\x03\xea" +
+	"\x9a\x0c\xc3\xc4\x77\x5a\xf8\x7c\xc6\x12\x74\x6b\xe0\x39\xc1\x36\xdc\xed\xe2\x74\xbd\x78\xa8\x80\x98\x61\xa8\x9a" +
+	"\x6a\x98\x88\x00\x05\x00\xdb\x1d\x41\x19\xde\x25\x64\x51\x2c\x37\x64\x1b\xc2\x00\xba\x98\x04\x75\x15\xaa\x14\x48" +
+	"\x4d\x42\x60\x00\xff\x9a\x4d\x03\xc7\xb5\xaf\x1c\x43\x5d\x25\x37\xfb\x76\x0b\x6c\xae\xb8\xae\x1d\xbe\x3d\xa1\x46" +
+	"\x6a\x36\x28\x73\xb3\x6c\x05\x61\xdd\x5e\x55\xed\x55\xbc\x1c\x34\x57\x45\xa3\xef\xe4\x47\x34\xdb\x86\x76\x2b\x47" +
+	"\x17\xf6\xd9\xb7\xe0\xad\x09\x53\xaf\x0a\x18\x1f\xe0\xec\x19\x9b\x63\x4e\xb0\xdd\x17\x25\x6c\xc2\x07\x82\x39\x26" +
+	"\xc6\xdc\xf6\x48\x8b\x6e\x12\xc2\x2e\x2b\xe2\x32\x7e\x20\xc0\xa9\x88\x15\x9a\x9d\xda\x02\xde\xeb\x4c\x53\xab\xc3" +
+	"\x40\x39\x00\x4e\xf6\xb6\x0b\x6f\xb0\x48\x0f\x44\xdc\x8b\x23\xb0\x7d\x19\x48\x4d\x78\x03\xdd\xf9\x80\x42\xa9\xc6" +
+	"\x8c\x5f\xf9\x5c\x19\xdf\x34\xd9\x76\xbb\x6c\x2a\xb3\x13\xe7\xc6\x19\x32\xbb\x8c\xc8\xdd\x7e\x0d\x17\x17\x58\x60" +
+	"\x8c\x47\x16\x0d\xdb\x55\x3c\x18\x91\x92\xe4\xdb\x38\x25\xb0\xdf\x61\x5d\x86\x6b\x8f\xd3\x82\xe4\xea\xb6\xfd\xe3" +
+	"\x11\x71\x51\x4d\xfe\x01\xf3\xa7\x56\x2d\x64\x32\x10\xbe\x7c\x38\x82\xe2\x33\x76\x7d\xc7\x43\x53\x08\xa6\x47\x78" +
+	"\xa8\x1e\x43\x25\x6c\x49\x72\xe2\x2f\x0c\x1c\x16\x68\x82\x95\xb9\x96\x05\x32\x7d\xb1\x40\x66\x1d\x96\x30\x09\x8b" +
+	"\xdb\x91\x66\xda\x7f\xda\x93\x99\xe3\x83\xa1\xad\x57\x06\x35\xe5\x4f\x46\xb8\xe6\x2e\x0a\xe9\x2a\x75\xb2\x94\x94" +
+	"\xc7\x92\x66\x6f\x29\xce\x61\xea\x41\x0d\xff\xcd\xae\x47\x58\x2f\x3c\x27\x21\x34\xd7\x8a\x1f\xa8\x94\x80\xaf\x96" +
+	"\x0a\x89\x8f\xc2\x2c\x0c\x74\xde\xeb\xa0\x72\x25\x74\x3b\xaf\xf2\xd7\x06\xa0\x5c\x26\x03\xac\x56\xdd\x00\x0c\x9f" +
+	"\x34\xc0\xf0\xe9\x00\x20\x17\x10\xfd\x7b\x50\xc9\xab\x0e\x26\xa5\xc7\xc0\xe4\xeb\x7f\x13\xc7\x0f\x1b\xf3\xc9\x09" +
+	"\xfc\x0d\x1e\x29\xf7\x79\x0a\xfe\x7e\xb9\x24\x45\xf1\x8c\xdf\x76\x47\x53\xd7\x61\xce\xcd\x42\x4d\x07\x83\xcc\x2b" +
+	"\x37\x03\x0e\xb7\x27\x44\x7d\x2a\x7a\x75\xff\x8b\xce\xc4\x35\xc9\x57\x59\xbe\xa5\x75\x60\x5b\x7f\xa2\x10\xe5\xbe" +
+	"\xd4\x11\xe6\xd5\x61\xa3\xbf\xd0\x52\xee\x57\x1d\x8c\x02\xc2\x1c\xb7\x88\x0c\x6b\xfc\x38\x15\xca\xe1\xab\x47\x33" +
+	"\xd1\xdb\x13\x8f\x71\xb9\xd1\x7a\x0f\x4d\xe4\x3f\xd6\x8c\x10\x60\x45\xb8\x55\x56\xd2\xc2\xb3\x83\x1c\x89\xb2\x21" +
+	"\x2e\x20\xc9\xd6\x6b\xc6\x33\x4e\xcf\x76\x24\x67\xa5\x20\x2d\x59\x24\x56\xda\x5f\xd9\x47\x71\x29\x39\x6f\x62\xe6" +
+	"\x1b\x24\x9f\x42\x35\x0e\x27\x10\xaf\x20\x4c\xbf\xf3\xcc\x8d\x91\x25\x05\xc3\x42\xf3\x07\x0b\xfa\x10\x17\x90\x53" +
+	"\x2b\x27\x51\xaf\x56\xfa\x0b\xe4\xf4\x11\x7d\x00\x0c\x7c\x55\x51\xd1\x7f\x7f\x6a\xf2\x82\x0b\xbf\x67\x2b\x58\xc5" +
+	"\x09\xc1\xfa\x6a\x1b\x96\xcb\x0d\x84\xeb\x30\x4e\x8b\xb2\xa6\xe3\x5d\x58\x96\x24\x4f\xff\x6f\xeb\xa2\xe2\xaf\x44" +
+	"\x2c\x5b\xed\x29\x5c\xd9\x37\x35\xc0\x92\x6c\x77\x7d\x34\x50\x1d\xf0\xec\x60\xa9\xc5\x1f\xac\xb8\xb4\x61\x29\x1b" +
+	"\x1a\xde\x58\xd5\xa9\x43\x48\x83\x8e\x23\x59\x97\xd6\x70\xa0\x8d\x88\x4e\x4a\x2b\x04\x72\x2a\x92\x56\x85\xdd\xf7" +
+	"\x8d\xf5\x23\x1c\x55\x74\x7d\x59\x4a\x91\xf6\x31\x2c\x48\x84\xee\x4a\x01\xb9\x4a\x2d\x48\xb2\xec\x7e\xbf\xab\x29" +
+	"\x9c\xf6\x36\xf4\xad\x8d\xa5\xd0\xcf\x85\xd4\x09\xc3\x16\x2e\x69\x6a\x57\x43\x28\x44\xd2\xa3\x1c\x4c\xc6\x5f\x94" +
+	"\xd8\xdf\xe3\x0c\xd5\x93\x2d\x09\xb0\x58\xc5\x69\xc4\xcc\xd9\x82\x7e\x2d\xf3\x85\xe9\x2c\xb0\x54\xf5\x4e\x67\x81" +
+	"\x4c\x71\x34\xc0\xb1\x5f\x15\x29\x87\xf3\x59\x27\xcf\xb3\x9c\x8a\x06\x7b\x3b\xfb\x9d\x22\x9a\x5a\x2d\x85\xfc\x34" +
+	"\x52\xd6\x96\x32\x91\x0b\x46\x0b\xac\x18\xe5\xe2\xb2\x50\x22\x86\x96\x1c\x0a\x38\x5c\xdf\x00\xfb\x89\x46\xd4\x8b" +
+	"\x23\x53\xdb\x67\x35\x23\x62\x40\x61\x2f\x8e\x9a\x89\xa4\x24\x1a\x69\xf3\xe9\x18\xc5\x01\xa1\x9a\x5c\x22\x25\x01" +
+	"\x43\x31\x2b\x11\xba\xda\x95\x71\x47\x0e\x7b\xc8\x3e\x11\x76\x3c\x80\xbe\x3a\x1a\xf5\x5e\xb2\xbb\x33\x48\x69\xc0" +
+	"\x3c\xaf\xc0\xf7\x2a\x51\x55\xc8\xbf\x44\x89\x46\x77\xc4\x27\x50\x8d\xd1\x52\x3f\x7c\x08\xe3\x04\xad\x58\x6c\x53" +
+	"\xcf\xe9\xf1\x5d\x5d\x8f\x6f\xc0\x47\x5d\x55\xae\x20\x74\xa5\x7a\xe0\x80\x57\x9b\x8d\xb4\x4d\xf3\x67\x01\xc5\x5e" +
+	"\x1b\xfa\xaa\xc4\x84\x93\xc8\x82\x01\x3f\xa8\x3a\x7a\xa9\x64\xdb\x6b\x5f\x29\xd8\x8a\xf5\x17\x89\xb5\x12\x00\x95" +
+	"\xe9\x0a\x5b\x7c\xb4\x65\x29\x14\xf4\x22\x0f\xf9\x47\xab\x87\xec\x20\x7b\x20\xb9\x48\x35\x64\xff\x18\xb1\x6b\xf1" +
+	"\x49\x8f\x7e\x32\x27\x6c\x0b\xd4\x6a\xee\x2d\x03\x63\xbf\x19\xed\xab\xbc\xbb\x0a\x9f\xfd\x7f\xd6\xc0\xd4\x9c\x9b" +
+	"\xf6\x45\xf5\x61\x35\xd3\x6e\x1b\x0e\x9f\x8e\x0d\xab\x59\x75\xdb\x4e\x20\x73\xe9\xb6\x9d\x42\xe0\x95\x1d\x5b\x7d" +
+	"\x58\xe4\x47\x07\x86\x79\x86\xa3\x6f\x8a\xfd\xc6\x36\x83\x15\x1a\x4f\x5c\x5a\xb7\x2b\x7e\x6c\x4c\xbb\x7c\x7a\x8f" +
+	"\x52\x9d\x39\x80\xb3\x7a\xf3\xb2\x2a\x42\x35\x84\xcb\xcd\x3d\x0c\x67\x9e\x3f\xf5\xf0\xb4\x90\x4f\xa2\xfe\xf6\x3a" +
+	"\x45\xe6\x11\x27\x54\xea\xba\x97\x3a\xdf\xd4\x1b\x39\x1e\x7c\xfc\x06\x7a\x9d\x32\xbd\x76\x5c\x58\x6e\xee\xab\x90" +
+	"\x72\x9d\x13\x4c\x74\xc1\x88\xc2\x55\x09\x57\x3e\xf8\xff\x9a\x98\x90\x64\xd9\x8e\x82\x5c\x3a\xc1\xf0\x33\xb8\xce" +
+	"\x4d\xc0\x38\x43\xa9\xd0\xe2\xfc\x75\x4b\x5e\xc6\xdf\xd7\xcf\xe3\x89\x03\x17\x17\x94\x89\x85\x1f\xd8\xc1\xcc\x97" +
+	"\xdd\x02\x3d\x46\xb4\xe8\x53\x7b\x7d\x03\x67\x55\xeb\x0c\x1d\x7e\xc8\xcf\x67\xca\xc6\xd9\xa6\x08\x25\x3a\x6e\xcc" +
+	"\xf2\x06\xd0\x15\x21\x37\xe3\xce\x34\x00\xad\x91\xc7\xfe\xd1\x86\x90\xe6\xfe\x4d\x18\x59\x83\xba\x5a\x5b\x13\x3f" +
+	"\x42\xf0\xd9\x71\xa1\x2b\xda\x32\xdd\xe3\x93\xab\x1e\x2e\x2f\x75\xe8\xd7\xb3\xd3\xd3\xde\x29\xfc\x0a\xfe\xec\xca" +
+	"\xa0\xcc\x30\x4a\xf0\xe6\x50\x63\x11\x9b\x4d\x22\x06\x33\x06\xce\x68\x1f\x15\x4e\x31\x50\x9a\xf0\x1b\x8d\xce\xe3" +
+	"\x4b\x43\xb0\x65\xc1\xe9\x73\xac\xe1\x81\x24\xe7\x4a\x61\xcd\xfe\xf3\x13\xb2\x14\x18\xcf\x71\x63\xfb\x2c\x52\x99" +
+	"\xcf\xd2\x19\x6b\x64\x38\x9d\xab\xb1\xfb\xb3\xe9\xd8\x37\x6d\x74\xec\x9b\x9f\x4c\x47\x3f\x88\xed\x72\x3a\x4c\xf0" +
+	"\x62\xec\x39\x5d\xb6\xd3\x10\x5d\x58\xed\x07\x79\x63\xde\x4d\x1b\x92\x5a\xa2\x80\x2d\xc8\x9e\xf2\x19\x57\xa3\xf4" +
+	"\x25\xb5\x07\xf1\xf0\x04\xee\xb1\xba\xfd\x41\x23\x54\xd5\x24\x55\xbf\xab\x7d\x52\x89\xee\x70\x7e\x7f\x51\xfc\x95" +
+	"\x48\x30\xea\xf0\xc2\x07\x65\xe2\x20\x11\x14\xbb\x05\x79\x22\xcb\x7d\x49\xd0\x71\x71\xa6\x05\x6e\x57\xba\x2d\xb5" +
+	"\x2b\xcc\xdb\xbb\x96\xea\xcb\x7c\x27\xc3\xfc\x5d\xa2\xc3\x60\xe1\x97\x59\xae\x85\x0a\x4c\x0a\x92\xb0\x24\x79\xed" +
+	"\x9c\x90\xb7\x0d\x68\xd5\x2c\x31\xf0\x3b\x22\x55\x64\x97\x32\xc0\x5d\x49\x82\x09\xd9\x29\x02\x1a\x68\x99\x67\x7b" +
+	"\x3b\xaa\xa5\xb3\xd7\x00\xac\x6c\x43\x3b\x50\x53\x6c\xc6\x82\x6e\xd7\xec\x1c\x6e\xc3\x1e\x60\x99\x37\x61\x15\x26" +
+	"\x8f\x6c\x8a\x54\xb8\x2d\xbd\x54\x6d\xb6\xb2\x0c\xeb\x08\xab\x16\xd7\x95\xc2\x33\x2a\x2a\xa8\x9f\x88\xbf\xac\x27" +
+	"\x23\x71\x88\xf0\x2e\xa6\x0f\x00\xa3\xd5\xa6\xc7\x68\x69\xf9\x34\xbf\x0f\x80\xb9\x03\x1c\x39\x4c\x32\x38\xca\x60" +
+	"\x7a\x0d\xf2\xb8\xd3\x84\x82\xa1\xac\x23\xd4\x12\x00\x29\x17\x34\x13\x28\x1a\xd4\xc5\xa3\x01\x43\xd4\x69\x80\x30" +
+	"\xc3\x2a\x2a\x25\x62\x26\x37\xa0\x65\x56\x1b\x4e\x34\x99\xa2\x57\xe9\x01\x8e\x98\x57\x6d\x4e\xa5\x25\xfd\xe8\xb6" +
+	"\xae\xbe\x43\x28\x5e\x56\xb1\xa9\x3f\x6c\x96\x5a\x2f\x7e\x38\x3f\xe8\x35\xe2\xc1\x49\xce\xcd\xd8\x0f\x7c\xa9\x9f" +
+	"\x33\x35\xf3\x62\x25\x29\x77\x48\x45\x11\x94\x25\x4a\xad\x56\x7c\x0e\xe0\x6d\xcb\x9a\x64\x12\x16\xf5\x78\x66\x43" +
+	"\xcf\x64\x46\x8e\x3f\xb4\xa8\xf4\xe9\x9f\x26\x6c\x74\x23\x76\xd0\x34\x10\xb0\xba\x11\xc5\xae\x5e\x48\x28\x1a\xfc" +
+	"\x78\xde\x5c\x8b\x7e\x58\xad\xe2\x7c\x59\x50\xd1\xc3\x45\x45\x7b\xf0\xe1\x5c\x4d\x1a\xe4\xc4\x26\xde\x6e\x9a\xb1" +
+	"\xfb\x3e\x15\x10\xcd\x08\x2a\x12\x1f\x94\xa4\xee\x18\xa2\x3b\x92\x64\x8f\x78\xdc\x12\x6f\xf7\x5b\x76\xec\x35\x09" +
+	"\xbc\xf1\x95\xe1\x07\x9e\xa1\xe6\x85\xfd\xf7\x16\xbc\x33\xd5\x93\xaf\x16\xaa\xe7\x4a\xfa\x78\x8c\x2a\xbd\xb8\x83" +
+	"\x49\x77\x3b\xd5\xf0\xe9\x85\x54\x65\x0e\x4b\x45\x2a\x2e\x4a\x32\xb1\xd2\xe4\xf2\x1c\x4e\x1b\x52\x1e\x68\x99\x19" +
+	"\x8e\xda\x1f\x7d\xa3\xf2\x08\x4e\xfa\x14\xef\x7e\x88\x70\x63\xc2\xb9\x42\xed\xd8\xd2\xd8\x45\x20\x52\xc0\x96\x6d" +
+	"\x4c\x61\xda\x58\x9f\xc4\xa3\xaf\x4f\xde\xf3\xc1\x26\x9b\x08\x73\xf5\xb9\xfc\xfb\xeb\x48\x46\xb7\x3f\x29\x1a\xae" +
+	"\x5c\x5d\x1a\x28\xb3\xea\xfd\x57\x95\xe2\x6f\x40\x73\xdc\xd7\x91\xd2\x2f\xff\x91\x7c\x24\x2f\xe3\x4b\xc9\x86\x22" +
+	"\x18\x39\xac\x97\x2e\x92\xf1\x1f\x3f\x57\x86\xda\xb1\xb2\x86\x53\x3e\xca\x19\x73\x97\xa7\x78\x4a\x3c\x66\xbc\x1f" +
+	"\x8c\xca\x58\xcd\x74\x59\x18\xe0\x3c\x09\x5c\x8a\x3c\x74\x3f\xe2\x17\x4b\xf8\x01\x36\xe3\x91\x49\x43\x23\x70\x24" +
+	"\xd1\xc3\x33\xed\x76\x50\x79\xec\x81\x17\x68\xab\x06\xa4\x5a\x3b\x5a\xc7\x30\x8d\x2f\xd5\x76\xc6\xb9\xd6\x1c\xa8" +
+	"\x37\x0b\x5a\x8f\x0d\x65\xd7\x48\x14\xd0\x1e\xd9\x91\xb0\x3c\x54\x3f\xff\x2f\x6b\x68\x35\xe1\x1f\x4e\xa6\xbe\x43" +
+	"\x2b\x7f\x7c\x1b\x39\xf6\x64\x32\x1d\xe2\x11\xb2\xd2\x0c\xc0\x2e\xd8\x9a\xa6\x4b\xd8\x08\xc3\xbb\xaf\xd9\xbe\x5c" +
+	"\x66\x5b\x72\xe0\xcc\x49\x36\xf1\xd4\x42\x5b\x7a\xbc\x26\xc8\xb3\xce\x0f\xa8\xf2\x4c\xd5\x60\xd5\x49\xe5\x8d\xb9" +
+	"\x5d\x58\x14\x24\xe2\xce\x86\xdb\xe5\x65\x18\x27\x24\x12\xc3\x98\x7d\x8b\x23\x2a\xbc\xc2\x9a\x95\x90\x87\x31\x1e" +
+	"\x3e\x9c\x40\x91\xd1\x65\xc8\xb3\x2d\x7e\xa2\xc6\xf6\x09\x9c\x78\x4f\x76\x25\x18\x21\xbf\x36\x88\xc8\xc3\x84\xe4" +
+	"\x78\x9b\x39\x4b\x4d\xbc\xcd\x9c\xe2\x29\x19\xe2\x58\x86\x49\x42\x72\xc8\xb3\x24\x29\xe0\x2e\x5c\xde\xe3\xd9\x06" +
+	"\xc1\xc6\x7d\x21\xc5\xa2\x48\xe0\x7c\x00\x6f\x5b\xba\x16\x87\x5d\xa0\xb1\xec\x15\x5b\xe5\x89\xb8\x59\xa5\xa9\x23" +
+	"\xc9\xd6\xdd\x7a\x93\xb3\xe5\x18\xc0\x9f\x0d\x87\x8e\xef\xc3\x26\x4c\x23\xbc\xe4\xf8\xff\x79\x44\xad\xfd\xff\xb2" +
+	"\x51\xf6\x98\x76\x46\xde\xf4\x1a\xe4\x91\x35\x1c\x38\xb3\xee\xfc\x71\x08\xb0\xed\xee\xbd\x80\x0e\xec\x8f\x93\x16" +
+	"\x94\xa8\xb7\x03\x30\x35\x6c\xf3\xce\x1f\x9d\x7f\x0f\x00\x3a\x26\x34\x9d\x13\x37\x00\x00")
+
+func bindataMigrations20190510083000DeliverychecksqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190510083000Deliverychecksql,
+		"../migrations/20190510083000-Delivery_check.sql",
+	)
+}
+
+
+
+func bindataMigrations20190510083000Deliverychecksql() (*asset, error) {
+	bytes, err := bindataMigrations20190510083000DeliverychecksqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190510083000-Delivery_check.sql",
+		size: 14099,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792402539, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190514091500Deliveryprofilesql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xff\x73\x9b\x48\xb2\xff\x9d\xbf\xa2\x7f\x79\x05\x3c\x63\xaf\xe4" +
+	"\x4d\xf6\xed\x6e\x56\x29\x63\x34\xb6\x79\xc1\xa0\x05\xb4\x71\xce\xa5\x52\x8d\x61\x2c\x53\x41\xa0\xc0\xc8\x49\xaa" +
+	"\xf6\x8f\xbf\xea\xe1\x3b\x48\xb2\xbd\x97\x5c\xed\x55\x9d\x94\x4a\x59\x43\x4f\x77\x4f\xf7\xa7\x7b\x7a\x7a\x90\x8e" +
+	"\x8f\xe1\x68\x1d\xad\x32\xca\x19\xcc\x37\x92\xe1\x12\xdd\x27\xe0\xeb\xe7\x16\xb9\x5d\x33\x4e\x17\x27\xb7\x21\x8b" +
+	"\xa3\x47\x96\x7d\x5d\x6e\xb2\xf4\x3e\x8a\xd9\x42\x52\x24\x00\x80\xdb\x28\x5c\xc0\xed\x5d\xb4\x8a\x12\xbe\x00\x73" +
+	"\x4a\x6c\xdf\xf4\x3f\x28\x63\x6d\xac\x82\xed\xf8\x60\xcf\x2d\x4b\x93\x0a\xd2\x9a\x47\x67\x4e\x9f\x2a\x48\xe3\xed" +
+	"\x3a\x59\x26\x74\xcd\x16\x70\x9b\x3c\xd2\x2c\x78\xa0\xd9\x02\x94\xf1\xe9\xcf\x43\x9e\x69\x16\x46\x09\x8d\x97\x9b" +
+	"\x34\x8f\x78\x94\x26\x0b\xb8\xdd\xc9\x35\xa4\x9c\x2e\xf9\xd7\xcd\x4e\x9e\x2d\xba\x2c\xfd\xbc\x0c\xd2\x6d\xc2\xdb" +
+	"\x1a\xb6\x9e\x27\xdb\x38\x3e\x48\x10\x46\x39\x8f\x92\x80\x1f\x24\x5a\x47\xc9\xf2\x91\xc6\xdb\x9e\x36\xaf\x46\xa3" +
+	"\x51\x57\x9d\x35\xfd\xf2\x4c\xc2\x28\x59\xc6\x2c\x59\xf1\x87\xda\x02\x3d\x36\xfb\x9f\xd2\xc7\x55\x33\xf7\x3e\x4e" +
+	"\x69\xef\x79\x90\x31\xca\x59\xc8\xd7\x0b\xb8\x0d\x29\x67\x3c\x5a\xb3\xc6\xc2\x60\x38\xb6\xe7\xbb\xba\x69\xfb\xb7" +
+	"\xd3\x8b\x65\x1f\x28\xcb\xd6\xec\x29\xb9\xd0\xe7\x96\xaf\xac\x18\x47\x3e\x8a\xaa\x6a\x52\x7b\xfa\xec\xdd\x60\xfa" +
+	"\x02\x66\xae\x79\xad\xbb\x1f\xe0\x1d\xf9\x00\x86\x35\xf7\x7c\xe2\x92\x69\x81\x3e\x01\x3e\xdd\x33\x24\x55\x52\xc1" +
+	"\xb1\x6f\x4b\xd2\x85\xf4\x46\xd2\x2d\x9f\xb8\x4f\x40\x18\xde\x9b\xfe\x15\x18\x57\xc4\x78\x07\xfa\x74\xda\xd6\xe4" +
+	"\x62\xa8\x49\x3d\xb0\x80\x0b\xc7\x25\xe6\xa5\x8d\x2a\x29\x1d\x54\xab\x92\x4b\x2e\x88\x4b\x6c\x83\x78\x7d\xb1\x0b" +
+	"\xd4\x58\x7c\x15\xd4\x5b\x95\x1c\x1b\xa6\xc4\x22\x3e\x01\x43\xf7\x0c\x7d\x4a\xa4\x37\xcf\x0a\xbd\x12\x10\x7f\x93" +
+	"\x00\xac\x94\x1a\xc6\xd6\xe9\x68\x48\xfd\x2c\x2c\x0b\xa2\x61\xfc\xd4\xac\x9e\x80\x4c\x15\x30\xdf\x0d\x38\x95\x80" +
+	"\x97\xc1\x47\x4c\xfa\xb7\x80\xc8\xb4\x3d\xe2\xfa\x60\xda\xbe\x03\x15\x03\xca\x79\x16\xdd\x6d\x39\x5b\x80\x82\x9e" +
+	"\xd5\x20\x64\x79\x90\x45\x1b\x4c\x9a\xf8\xe3\x9e\x6e\x63\x5e\xac\x4c\x83\x54\x0c\xe7\xaa\xe4\x11\x8b\x18\x3e\xc8" +
+	"\x33\xd7\xb9\x30\x2d\xb2\xf4\x9d\xd9\xd2\x96\x35\x90\xed\xed\xfa\x8e\x65\x90\xde\xc3\x3a\xcd\x39\xdc\x67\xec\xd3" +
+	"\x96\x25\x1c\x04\x83\x1c\x68\x12\xc2\x86\x72\xce\xb2\x24\x87\x8f\x6c\xc3\x61\xc3\x32\x28\x52\x3b\x7c\x7e\x60\x09" +
+	"\x14\x66\x89\x92\x15\x50\xa8\x56\x07\x51\x02\x39\xa7\x2b\x38\x86\x11\x84\x51\x4e\xef\x62\x96\x37\x94\x28\x77\x3c" +
+	"\xc2\xff\x47\xda\x6b\x6d\x3c\xd2\x4e\x47\xda\xeb\x91\x5c\x87\x8d\x34\x73\x1d\x83\x4c\xe7\xee\x5e\xcf\x2d\xe0\xf8" +
+	"\xf8\x4f\xe9\xf8\xf8\x4f\x98\x7c\xb7\x8f\x60\x3f\x6d\x8c\xfb\x2b\xcc\xc4\x02\x58\x7b\xa1\x71\x4a\x43\x16\x42\x94" +
+	"\xf0\xb4\x58\xf1\xdd\x57\x08\xd2\xf5\x66\xcb\xd1\x22\x2d\x5b\xe5\x9c\x72\xdc\x51\x82\x5c\xf0\xad\xbc\x2f\xbe\x0a" +
+	"\x6e\x46\x20\xe2\x44\x83\x6a\xdf\xa9\x7e\xaf\xa3\xe4\x87\x35\xfd\xa2\x41\x91\xd9\x55\xa0\x71\x9a\xac\xe0\x73\xc4" +
+	"\x1f\x80\x3f\x30\xe0\xe9\xe6\xd8\x2e\xdd\xa5\x0d\x79\x17\xb3\x04\x57\x81\x9b\x28\x4d\xda\x4e\x85\x87\x28\xe7\xe9" +
+	"\x2a\xa3\x6b\x50\x62\x86\x7e\xce\x61\xf2\x16\x74\xd4\x63\x15\x71\xf1\xe3\x17\xf5\x64\xc8\xd7\x7f\x60\x90\xb1\x7c" +
+	"\x1b\xf3\x1c\x68\xc6\x20\xe7\x69\x26\x0c\x01\xe8\xb2\x93\xbe\xc3\x50\xe6\x90\xc9\x4e\xd2\x02\xbb\x90\xb1\x4d\x4c" +
+	"\x03\xb4\x22\x4d\xbe\xc2\x26\x63\x8f\x51\xba\xad\x40\xc4\x10\xb0\xb8\xfa\x6a\x72\xa1\xa1\x9e\xad\xb6\x6b\x96\xf0" +
+	"\xfc\xd7\x4a\x82\xf8\x96\x19\xf6\x0c\xe3\x05\xec\x3f\x74\xd7\xb8\xd2\x5d\xe5\xf4\xf5\x48\x05\xc4\x11\xd8\x38\x9e" +
+	"\xde\x83\x50\x94\xa7\xb0\xa6\x3c\x78\x00\xba\xa2\x51\x92\x73\x61\x64\xba\xca\x18\x43\xce\x95\xdd\x24\x55\xd2\x3d" +
+	"\x10\x42\x8f\xbf\xdb\x47\x3a\x27\x97\xa6\x2d\x74\x9f\x12\xc3\xd2\x5d\x02\x67\xeb\x7c\xd5\x2c\x41\x94\x0f\xdd\xe7" +
+	"\xf9\xa7\xb8\x5a\x36\x40\x43\x79\xad\xdf\xf4\x08\x39\x5b\x6f\x4e\x05\x64\x3b\x84\xe3\x21\xcb\x7a\xf1\xcb\x28\x04" +
+	"\x38\x37\x2f\x4d\xdb\xef\x52\xd4\x2e\x44\x82\x9d\x14\x74\x1b\x46\xc5\x7c\xd8\x43\x81\xaa\x2c\xf3\xe0\x81\xad\x69" +
+	"\x5b\x9f\xd7\x83\x05\x22\x9d\xf0\x64\x5f\xef\x1e\x21\x4f\x37\xcb\xa4\x32\x04\x0c\xe4\x89\xc7\xcb\x00\xa0\xcb\x07" +
+	"\xe5\x09\x42\x74\xed\x39\xcd\x59\x08\x69\x02\x42\x5e\xe9\x7a\x0d\xe2\x34\xfd\xb8\xdd\xf4\x80\x71\x9f\xa5\xeb\x02" +
+	"\xfb\xcd\x18\xc7\xc4\x27\xb8\x91\x1b\x62\x54\x70\xbf\xdb\xae\xe0\xec\x0c\x73\x9c\x39\xd5\x40\xb6\x0a\x6e\x34\xe0" +
+	"\xd1\xe3\x80\x61\x8d\x6f\xa1\x81\x65\xbe\x23\x0d\xc5\x49\xa9\x90\xdc\x93\x50\x13\x2c\xef\xa3\x24\x2c\x60\xaf\xc1" +
+	"\xa9\xd6\xf3\xa4\x33\xf7\xb5\x36\x0c\x9c\x79\xe1\x33\xf3\xa2\x47\x68\x7a\x62\xbf\x16\x0f\x1b\x44\xe2\xd7\xd5\x4d" +
+	"\x8f\xb8\xae\xe3\x82\x22\x93\x2c\x4b\x33\x61\x1a\x8c\xd9\xed\xa6\xb5\x92\xdb\xff\xc9\x17\xb2\x06\xe3\xb1\x06\x63" +
+	"\xad\xd0\x47\x6d\x78\x10\x7f\xee\xda\x70\x2a\x06\x88\x3d\x6d\xac\x7f\xc9\x8a\xe0\xeb\xec\x59\x50\x6f\x80\xa0\x8c" +
+	"\x30\x3b\x35\xfb\x4f\xb9\xd1\x84\x05\xef\x72\xc7\xab\xfd\x3c\x29\x72\x64\x29\xf7\xc2\x75\xae\xfb\xd6\xaa\x39\x2f" +
+	"\x1f\x0b\xaa\xf7\x57\xc4\x6d\xd9\x1b\xe1\x3b\xe9\xda\xa6\x5a\x85\x6e\x4f\x1b\xc5\xc4\x91\x07\x26\xfd\xcd\xb6\x5a" +
+	"\x18\x1c\x81\xc7\x78\xb5\x53\xc3\x78\x54\xaa\x5b\xe9\x0a\x93\x6a\x4c\xd0\x3a\x8f\x2c\xcb\xa2\x90\x15\x19\x9f\x06" +
+	"\x7c\x4b\xe3\x62\x29\x10\xdd\xe3\x1f\x51\x08\xc9\x76\xcd\xb2\x28\xa8\xdc\x27\xd6\x15\x3c\xb0\xe0\xe3\xb2\x7c\xa2" +
+	"\x54\x66\xd0\x60\x7c\xaa\xc1\x48\x85\x09\x8c\xba\x32\x0d\xdd\xf3\x6b\x32\xd0\x3d\x8c\x18\xb5\x06\x84\x18\x87\xdf" +
+	"\x26\x30\xda\x01\x83\x03\xe0\x9e\x0d\xbc\x23\xd7\xd3\x0a\xcf\x0f\xfd\x5e\x06\x44\x3b\xbd\xe3\xf6\x01\xb8\x1d\x61" +
+	"\x96\x00\x91\x4c\x3a\x4e\xae\xe8\x0a\x17\x5d\xeb\x37\x4a\x78\x12\x85\xaa\x56\xcb\x02\xe8\xe6\xa0\x82\x88\x22\xd1" +
+	"\x00\x11\xb5\xd0\xb0\x33\x5f\x3c\x13\x3c\x80\xb6\xf1\x81\x92\x2a\x1a\xc1\xb9\xb5\xa5\xf5\x10\x72\x82\xea\xb3\x2a" +
+	"\x0d\x4e\xe0\xb4\xfd\x34\x3c\x79\x2e\xd2\xc2\x93\x3a\xff\xe1\xbf\x49\x11\x52\x52\xed\xac\x96\xf8\xe7\x04\xaf\x22" +
+	"\x4f\xcb\x09\x22\x52\x21\x49\x39\xd0\x47\x1a\xc5\x98\xbd\xaa\x52\xee\xa9\xf8\x7d\xd5\xf7\xe3\x11\x78\xe8\xab\x26" +
+	"\x05\x56\xbe\x6a\x27\xf0\x49\xf1\x5c\xfc\xec\x3a\xab\xbd\x1d\x54\x54\xc5\xee\x30\xf0\x57\x63\x26\x9c\xc4\x96\x05" +
+	"\xf1\x5f\x8a\xe1\xb6\x1c\x8c\x60\x64\x58\x06\xae\x79\xd1\x56\xfd\x59\x66\x6d\x0c\x20\x6c\x7a\x9f\x6e\x93\x10\xee" +
+	"\xd3\xac\x81\xf5\x73\x32\xe3\x4f\xc3\x08\x11\x12\x21\x48\x13\x9e\xa5\x71\xcc\x42\xe0\x19\x4d\x72\xdc\x41\xd2\xa4" +
+	"\x51\x09\x7c\x57\xb7\x3d\xdd\xf0\x4d\xc7\x96\x3a\xc3\x1f\x6a\x19\xc8\xcd\x65\xeb\xf4\x91\x3d\x59\x62\xd5\x73\xca" +
+	"\x73\xca\x30\x60\xea\x62\x0f\xda\xe1\x51\x3d\x14\xa1\xd9\xc6\xe6\xf3\x19\x96\x25\xe1\x93\x0c\x6b\x8e\x65\x1a\xd9" +
+	"\x40\xfa\xc8\x32\xb1\x8a\xa2\x0c\xcf\xab\x45\xe5\x5d\x6c\x76\x2a\x83\xd6\xb9\xb9\x57\x1c\xe0\xc1\x79\x48\x5f\xb7" +
+	"\xa4\x00\x9e\x45\xdf\x6f\x75\xd5\xb5\x49\x87\x2a\x48\xdb\x85\x5c\x9b\xeb\x8f\xa3\xd1\x0e\xae\xfc\x0b\xdf\x43\xff" +
+	"\x6a\x27\xbd\x28\x2f\x5e\x40\x5f\x5a\x45\x9c\x4a\x3a\x15\x55\xbd\x7b\x75\x28\xaa\x9d\xa2\x7e\x2a\xe4\x4d\x40\x2e" +
+	"\x02\xf7\x56\x86\xa3\x6e\x90\x1f\x81\xbc\x38\x69\x0d\x63\x4a\x10\x83\x15\x8e\x3e\x37\x47\x71\x01\x25\x24\x2d\x36" +
+	"\xad\xf6\xb8\xee\xd5\x0b\x51\xa5\xc1\x2a\x32\x16\x80\x31\x77\x3d\xc7\xc5\x63\x7b\x4b\x41\x91\x9a\xbc\xf9\xb9\xe7" +
+	"\xbb\xa6\x7d\xa9\x94\x4b\xa9\x2b\x27\x8b\xd8\xed\x31\x15\x8e\xe1\x54\xd5\xa0\xf6\xbc\x06\x7d\xa7\xd6\xbc\xdb\x99" +
+	"\xaa\x64\xb1\xa6\x9b\x4d\x94\xac\xaa\x14\xf5\xb2\x34\x75\x38\x55\xf5\x88\x5a\x3a\xc3\x6f\x6f\x41\xbe\xed\x5a\x71" +
+	"\xd1\x9a\xe0\xb8\x53\xe2\xc2\xf9\x87\xe1\x4a\x6a\x1a\x67\x46\x6c\xc8\x58\xd0\x8c\x60\x9a\x9f\x65\x6c\x83\x27\x40" +
+	"\x25\xa4\xf7\x1c\xae\x3d\xf0\x7e\xb7\x54\x88\xd3\x74\x53\x93\x5d\x10\xdf\xb8\x02\x9b\xdc\xf8\x85\x31\xd0\x0d\xa2" +
+	"\xab\x71\xd6\x52\x50\x6b\x45\x92\x06\x67\xfb\xd5\x78\x7f\x65\x5a\x04\xce\xce\x04\xd7\xa5\xe7\xeb\xfe\xdc\xeb\xe0" +
+	"\xad\x9b\x90\xf7\xe1\xb3\xfb\xfb\x08\xc6\x9d\x19\xb8\xb2\x73\xd3\x87\x80\x26\x98\xbb\xef\xb0\x32\x5f\x65\x6c\x85" +
+	"\x0d\xd4\xa2\x14\xbb\x36\xed\x1f\xae\xf5\x9b\x9d\x72\x8a\x7a\x8a\x20\x74\xed\x76\x82\x98\x80\x7c\x17\x71\x19\x7c" +
+	"\x1c\x97\x05\x7a\x11\xc6\xbf\xcf\x1d\x9f\xd8\xfa\x35\x51\x2a\xa5\x0a\x94\x1d\x81\x5c\x55\x62\x32\x10\xcb\x23\x7b" +
+	"\x29\xb1\x70\x1e\x28\x82\x29\x61\xf2\x7c\x31\xed\x04\x30\x52\xd5\x0e\x98\x8a\x95\xe1\xe1\x13\x43\xb8\x2a\xc9\xcb" +
+	"\x30\x6e\x31\x43\x5e\x8b\x72\xb8\x59\x36\x0e\x76\xd9\xed\x2f\x19\xf1\x84\xdb\xf8\xba\x4a\xe6\xc6\xa0\x8b\x32\x50" +
+	"\x0e\x4f\xbe\x13\x90\xdb\x4d\xb3\x9d\x9b\x09\x74\x35\xa9\x3f\x47\x20\x2b\xad\xd8\xd0\xa0\x83\xcd\x3e\x1c\x3b\xc1" +
+	"\x5f\x5f\x36\x68\xd0\xdc\x2b\x34\xad\x9c\xea\x77\x7d\x5b\xa0\x41\x7d\x1f\x50\x8c\x16\x5d\x9a\x62\xb8\xfa\xbb\xe9" +
+	"\xe6\xab\x07\x54\xae\xaa\xaa\x8e\xe6\x6d\xf7\xee\x08\xa5\x6e\xa4\x19\xce\xdc\xf6\x95\xff\x55\xb5\x43\x52\xe6\xd7" +
+	"\x4a\x03\xe8\xca\xe9\xe8\xec\xaa\x12\x2a\x20\x3d\x2e\x50\x3a\xc2\x72\xe5\x20\xc3\x42\xe8\xd4\xf4\x7c\xd3\x36\xfc" +
+	"\x0e\xc7\xc3\xf3\x30\x64\xae\x4d\x5b\xe9\xcc\xd8\x81\xde\xa7\x99\xe8\x37\xff\x12\x13\x54\x02\xb7\x05\xc1\x03\x23" +
+	"\x0d\x79\x1c\x9e\xa1\xdf\xbc\x70\x86\xfe\xc7\x25\x9a\xdd\x1f\x4e\x43\x65\x2f\x2c\x47\xf7\xfb\x71\x5a\x7f\x8e\x8a" +
+	"\x2d\xf7\xb9\x51\x97\x7f\x8a\x87\xa4\xf9\x66\xc9\xbe\xb0\x60\xcb\x19\x06\xd7\x59\xfe\x29\xd6\xc0\x96\x3b\xfb\x6d" +
+	"\xd1\xca\xe9\x42\xae\x31\x23\x16\x4c\x3b\x00\x88\xd1\xd9\x06\x61\x77\x82\xdc\x39\x09\x94\xe7\x81\xfd\x55\x5f\x4f" +
+	"\xf6\xe4\x29\xf4\xc3\x64\x38\xd6\x51\x66\xd2\xfa\x31\xcc\x44\xbe\xe8\xb7\xee\x6a\x96\xff\xf5\x94\x54\xd6\xb7\x07" +
+	"\xd2\x4f\x45\x88\x3a\x69\x50\x66\x8e\xd6\xfd\xca\x33\x52\x84\xef\xcc\xa0\x3c\xe0\xab\x07\x0c\xa8\x81\x2c\xfb\xce" +
+	"\x4c\xc6\xf6\x7c\x0b\x71\x4d\x9e\x78\x01\xde\xea\x2f\x26\x8a\x4b\xd7\x99\xcf\xb0\xc0\xa8\xd8\xee\x27\xad\x6b\x91" +
+	"\x4a\x26\x4c\x89\x67\xc8\xdf\x03\xcb\xc2\x1e\x15\x1e\x1b\x9b\x94\x2d\xca\x43\xb8\xde\x09\xd3\xaa\x9b\x52\xd8\x59" +
+	"\xfb\xcb\xb8\x1d\xe2\xce\x1a\x36\xf2\xff\xe6\x80\xdb\xbf\x58\xc4\x98\x45\xec\x4b\xff\x0a\x61\xb6\x3f\xc3\x55\xf6" +
+	"\x56\xbf\x21\xfa\x06\x82\xbe\x0b\xac\xbe\x01\x94\xbe\x19\x72\x66\x2f\xb8\xea\xd1\x20\xe5\x0f\x78\x6b\xf5\x40\x33" +
+	"\x1a\x88\xeb\x20\xbc\xf5\x53\x87\x50\x2b\x8b\x4b\xd1\x63\xb0\x74\x9f\x28\xf3\xd9\x8c\xb8\x8a\x45\x2e\xfc\x8e\x7d" +
+	"\x35\x78\x5d\xec\xa4\xb2\x7e\x6e\x4c\xc9\xc5\xe5\x95\xf9\xff\xef\xac\x6b\xdb\x99\xfd\xee\x7a\xfe\xfc\x8f\xf7\x37" +
+	"\x1f\xfe\x31\x1a\x9f\xfe\xf8\xea\xf5\x4f\xff\xf7\xf3\x2f\x88\x07\x59\xd6\xf7\x7e\x7e\xa9\x3f\x72\xdf\x73\xff\xd1" +
+	"\x19\x77\xa6\xfb\x3e\x71\xed\xff\x66\xdd\xbf\x55\xd6\x3d\x02\x97\x6d\x18\xe5\x87\xce\xb6\xdf\xec\x7c\x5b\x31\x6b" +
+	"\x1f\xe5\x0c\xcb\xf1\x88\x38\x72\x57\x23\x53\xa2\x5b\x96\x63\xe0\xcb\x25\x9d\x93\x78\xfb\x7c\x56\xb7\x73\x4b\x0c" +
+	"\x87\xc5\x81\x4c\xe4\xd9\x4a\x23\x81\xe1\x4e\x8e\x45\x0c\x2c\xca\x18\xc8\x65\x69\xe8\xf1\x74\xc3\x32\x8a\xaa\x2e" +
+	"\x69\x18\x36\xed\xf4\xe2\x52\xa7\xc1\x01\x9e\xdf\xca\x75\xd4\xad\x46\xdc\xc0\x44\x53\x14\x1e\x68\x12\xe2\x3b\x00" +
+	"\xad\x7e\xa4\xa1\xfb\xc6\x55\x2d\x0f\x49\xad\x74\x85\x7d\xe7\xb2\xd9\x9e\xc3\x67\x9a\x25\x78\x87\xa0\x34\x97\x3d" +
+	"\x09\xc3\xce\xde\x5d\x9c\x06\x1f\xf3\xd6\x85\xbc\x2a\x6e\x0a\xb0\x2b\x7a\x47\x83\x8f\x35\x53\x6c\xde\x9e\x61\x93" +
+	"\xb4\x58\xf6\x5b\x18\x81\xeb\x58\xd6\xb9\x6e\xbc\xeb\x74\x49\x5b\xf4\x45\x9b\xa8\x6c\x2f\x28\xf2\x2a\x4e\xef\x68" +
+	"\x8c\xe1\x99\xb1\x40\x56\xe1\xed\x04\x8e\xc7\x7d\x6f\xbc\xc0\x66\xa7\x6d\x9b\xd5\xad\xe4\xc3\x21\xd6\xbe\x4d\xa9" +
+	"\x5c\x7c\x4f\xa3\xce\xa5\x0a\x9a\xcf\x65\x7c\x9b\x25\xc0\xc4\x8d\x5c\x90\x86\xac\xdf\x52\x2e\xef\x97\xd0\x43\x85" +
+	"\xf1\x6b\x27\x79\x73\xc3\x20\x9e\xd7\x75\xd3\x01\x8d\xa6\x8e\x4d\xba\xc2\x0d\xe7\xfa\xda\xf4\x0f\x35\xa7\x4b\x8a" +
+	"\x5d\x76\x6f\x69\x9f\x6f\x83\x80\xe5\xb9\xd4\xa8\x2d\x61\x64\x7c\xe7\x77\x3e\xde\x48\x9d\x97\x28\xa7\xe9\xe7\x44" +
+	"\x9a\xba\xce\x0c\xea\x57\x52\xea\x37\x71\xea\xa4\x52\x46\x19\xbe\x74\xd4\xee\x64\x57\x74\x3b\x2e\x18\x17\x52\xd5" +
+	"\xf0\xab\xef\x0c\xa3\x10\x4c\x1b\x94\x72\xe7\x88\xc2\x1e\x8f\x7a\x66\x39\x71\xf7\x15\xa3\xba\x4f\x85\x81\xe0\xdd" +
+	"\xf3\x71\x3a\x2e\x56\xbc\x35\xb5\x77\xa1\xe5\x6b\x53\xcf\x23\x5e\x48\x6f\xa4\x7f\x0e\x00\x38\x9c\x5e\x13\x98\x2a" +
+	"\x00\x00")
+
+func bindataMigrations20190514091500DeliveryprofilesqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190514091500Deliveryprofilesql,
+		"../migrations/20190514091500-Delivery_profile.sql",
+	)
+}
+
+
+
+func bindataMigrations20190514091500Deliveryprofilesql() (*asset, error) {
+	bytes, err := bindataMigrations20190514091500DeliveryprofilesqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190514091500-Delivery_profile.sql",
+		size: 10904,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792396277, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190520140000Alertsql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5f\x6f\xa3\xba\x13\x7d\xe7\x53\x9c\x37\x40\x85\xfc\xda\xfd\xbd" +
+	"\xb5\xca\x4a\x6c\x70\x52\xee\x52\xa8\x08\xe9\xde\x2a\x42\x11\x1b\x9c\xd4\x6a\x02\x29\x38\xd9\x46\xda\x0f\x7f\x65" +
+	"\xf3\x3f\x69\xef\x5d\x55\x2a\x4f\xc1\x9e\x39\x73\x66\xe6\x8c\x4d\x14\xd3\xc4\xc5\x96\xad\xf3\x98\x53\xcc\x76\xca" +
+	"\x28\x20\x56\x48\x10\x5a\xdf\x5c\x32\xdf\x52\x1e\x47\x83\x79\xbc\xa1\x39\x8f\x14\x4d\x01\x80\x39\x4b\x22\xcc\x7f" +
+	"\xb2\x35\x4b\x79\x04\xc7\x26\x5e\xe8\x84\x8f\xda\x95\x71\xa5\xc3\xf3\x43\x78\x33\xd7\x35\x94\xd2\x54\x3a\x2e\x9e" +
+	"\xe9\x31\xc2\x3c\x3d\xc4\xf9\xf2\x29\xce\x23\x68\x5f\x2e\x2f\xdf\xb3\xe5\xc7\x1d\x3d\x35\x7e\xc3\x76\x9d\x53\xba" +
+	"\xa5\x29\x5f\xf4\xc8\x74\x4d\x12\xba\x61\x07\x9a\x1f\xdf\xb7\xd8\xd2\xa2\x88\xd7\x27\xd1\xae\x2e\x25\xb7\x8e\xd9" +
+	"\x32\xa7\x31\xa7\x09\xdf\x46\x98\x27\x31\xa7\x9c\x6d\x69\xd4\x30\xc2\xc8\xf7\xa6\x61\x60\x39\x5e\x38\xb7\xc7\x8b" +
+	"\x32\x89\x8e\x8b\x4d\xc6\xd6\xcc\x0d\xb5\x35\xe5\xc2\x59\xd3\xf5\x1a\x37\xa7\x45\xb6\x39\x9c\x03\xcb\xd0\x5d\xd8" +
+	"\xfb\xef\x25\x6c\x84\xfb\xc0\xb9\xb3\x82\x47\x7c\x27\x8f\x18\xb9\xb3\x69\x48\x02\x62\x97\x6d\x91\x5d\xb1\xa6\x23" +
+	"\x45\x57\x74\xf8\xde\xbc\x32\x8d\x94\x9b\xaa\xa3\xca\x83\x43\x7e\xf4\x1a\xba\x58\x66\x69\xc2\x38\xcb\xd2\xc5\x21" +
+	"\x82\x69\xfe\x56\x4c\xf3\x37\x86\x9f\xf6\x48\x78\x9b\x16\xcb\x9c\xed\x44\xd4\x6b\x8c\xf6\x79\x4e\x53\x0e\x49\x07" +
+	"\x0d\x9d\x02\x3b\x9a\xa3\xe9\x31\xe8\x21\xde\xec\x45\x0f\xf0\xf3\x08\xfe\x44\x91\xc4\x74\x9b\xa5\xd8\x66\x29\xe3" +
+	"\x59\x7e\x2d\x81\x4f\x1e\x57\x88\x58\xfe\x82\x89\x34\x43\x2d\x07\xfc\x62\xfc\x89\xa5\x12\x86\xbe\xee\xe8\x52\xc0" +
+	"\xae\x72\xfa\xb2\xa7\xe9\xf2\x08\x2d\x89\x8f\x85\xfe\x16\xe0\x83\xe5\x3a\xb6\x15\x3a\xbe\x07\x13\x9b\x98\xd3\x82" +
+	"\xb7\xa0\xab\x98\x6d\x68\x82\x43\xbc\x61\x49\x2c\x72\x43\x96\xb7\xbb\xcb\x27\xba\x7c\x2e\xde\x02\x0d\x03\x67\x32" +
+	"\x21\x81\xf8\x69\x22\x06\xcf\xd9\x7a\x4d\xf3\x1a\x2e\x2b\x69\x9e\x04\x3b\xc7\x09\x9f\x28\x9a\x49\x03\x4b\x68\xca" +
+	"\xd9\x8a\xd1\x42\x7a\x37\x55\xc5\x4a\x72\x32\x93\xfd\x6e\xc3\x96\x15\xcb\x15\xd2\x4c\x18\x97\xef\xc5\x67\x2b\xc0" +
+	"\x9a\x2a\x53\xe2\x92\x51\x08\x55\x34\xe8\x5a\xc5\x05\x46\xd6\x34\xd4\xe2\x01\x4b\x60\x4d\xe1\x3d\x58\xc1\xe8\xd6" +
+	"\x0a\x74\xf1\xd2\xe4\x64\x28\x55\xa6\xd2\x4b\x6d\xf7\xc4\x69\xd1\x6c\xd6\x18\x8d\x70\x16\x2c\xe9\x6c\xa6\xf1\x96" +
+	"\xf6\xb7\xc5\x4a\x63\x20\x69\xc8\x89\xb6\xa6\xf8\xe6\x4c\x1c\x2f\x94\x24\xea\xb2\x77\xc1\x54\xbb\x5a\x94\x35\x6d" +
+	"\x00\x31\x17\xf9\x54\x91\x2e\xa0\x46\x60\x85\x94\x0a\xcc\x56\x6b\x54\x80\x61\xde\xc9\xbc\x55\x5f\xb7\x00\x75\x2c" +
+	"\x89\x23\x54\x69\xd4\x42\x28\x78\xcc\xf7\x05\xc4\x71\x52\xe1\xf8\x96\x4b\xa6\x23\xa2\x8d\x7c\xef\x81\x04\xa1\x56" +
+	"\x83\x68\x57\x97\xba\x81\x3b\xeb\x6f\x2d\x19\x94\x5e\x0b\xe1\xa5\x1b\xb8\xfa\x22\x76\xd4\x34\x4b\xa9\xaa\xcb\x18" +
+	"\xb2\xaa\xd5\x91\xa8\x00\xe3\xc0\xbf\x83\x38\x2d\x06\x6d\x7a\x71\xcd\xc9\x25\xe3\x10\xfe\x2c\x24\x01\xfe\xf2\x1d" +
+	"\xaf\x5e\x96\xe6\x75\xbd\x90\xc0\xf7\xa0\x25\xad\xff\x82\x25\x18\xca\x2e\xe9\x0a\x7e\xdc\x92\x80\xa0\x9b\xfc\x57" +
+	"\x5c\x2a\x98\x04\xfe\xec\x1e\xdf\x1e\xa5\x99\x51\xd5\xd2\xe8\xda\x89\x97\xe6\x7c\x55\x6e\xad\x07\xc7\x9b\xc0\xb6" +
+	"\x42\x62\x3b\xe3\xb1\x18\x5f\xa3\xad\xc7\x5b\x99\x77\xbc\x75\x03\x13\x12\x0a\x5f\x4d\xd7\xf1\xb5\x1b\x45\xc1\xcc" +
+	"\x13\xa3\x6e\xb9\x6e\xad\xd8\x91\x35\x25\x82\xb6\x87\x4c\xb2\xc2\x10\x6a\x2f\xe3\x45\x35\xbe\x2a\x42\x61\xa5\x56" +
+	"\xc3\x7d\xad\x82\xb8\x53\x02\xb5\x3d\x41\xc4\x92\x67\xd7\x0a\x38\x29\xd1\x7f\x4f\xc1\x47\x99\x9c\x13\x29\x79\xbc" +
+	"\x3d\x4e\x7d\x5a\x7f\x3e\x48\x49\x35\x86\xff\x3e\x38\x52\xb8\x49\x67\x54\xaa\x33\x8f\xa5\xa5\xa6\xab\xcc\xc4\xd6" +
+	"\x35\x7a\x22\xcf\x06\x49\x7b\x87\x18\x50\x55\xfd\x0f\x94\xdb\x30\xe8\xd5\x09\x2d\x33\xb9\x1e\xef\x13\xc6\xb1\xef" +
+	"\x2f\x66\x3b\x9a\x57\xa7\x65\x2b\x5b\x29\xe5\x7e\x85\x84\x93\xe5\xd9\x65\xfe\x43\xec\xdb\x6e\xb4\x7b\xfb\x72\x2f" +
+	"\x2b\x23\x55\x1b\xa6\x79\x01\xf7\xe4\x42\xc9\x56\xf2\xf0\x6e\x33\xc8\xd2\xcd\xf1\x24\x82\x56\x09\x53\xa8\xfc\x55" +
+	"\x4c\x55\x27\xf5\x06\xe8\xb5\xa2\xfc\xda\xe3\x7a\x46\x5e\xaf\x89\xdc\x65\x05\x47\x4e\x97\x22\xa6\x68\x09\x4b\xd7" +
+	"\x68\x2b\x20\xee\xe5\x67\x96\x26\xd0\xda\xab\xee\x7f\xf2\x7a\x13\x17\x5e\xa5\x3a\xbd\xe6\x99\x9d\xf3\xdc\x0d\xaa" +
+	"\x58\xfd\xa7\x25\xde\x89\x65\x28\x7d\x23\x9c\xb7\xea\xe5\xcc\xa4\xcc\x76\xd7\x14\x18\x43\xbc\x0c\xca\x3a\x9f\x3c" +
+	"\xa2\x92\x2f\xdd\x26\xc9\xa2\xbc\x6b\xaa\xed\xea\x59\xab\xa4\xe9\x07\xcd\x9a\xe3\x41\x3b\x99\xc0\xaa\x3e\x54\x35" +
+	"\x4e\x67\x53\x96\x4b\x88\x56\x56\xe8\xc3\xfe\xba\xde\xa9\xf3\x47\x51\x8c\xf7\x0e\x8e\x0e\x76\x75\x7c\xb2\x04\x5f" +
+	"\x87\xf8\xff\x67\x7f\x21\xdc\x28\xbd\x3f\x24\x76\xf6\x2b\x55\xec\xc0\xbf\x87\xf8\x7c\xc5\xfb\xdf\xaf\xca\x4d\x69" +
+	"\x26\xff\xb7\xf4\xed\x22\xe5\x46\xf9\x67\x00\x20\xdd\x4e\x49\xe6\x0c\x00\x00")
+
+func bindataMigrations20190520140000AlertsqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190520140000Alertsql,
+		"../migrations/20190520140000-Alert.sql",
+	)
+}
+
+
+
+func bindataMigrations20190520140000Alertsql() (*asset, error) {
+	bytes, err := bindataMigrations20190520140000AlertsqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190520140000-Alert.sql",
+		size: 3302,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792396344, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190527101000Eventoutboxsql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5f\x73\x9b\x3a\x16\x7f\xe7\x53\x9c\x97\x8e\x61\x8b\x3d\x49\xef" +
+	"\xde\x3e\xdc\x4e\x76\x4a\x41\x4e\xb8\x75\xc1\x23\x70\xd3\x4e\xc6\xe3\x91\x2d\xc5\x66\x6b\x43\x16\xe4\xa4\xd9\xe9" +
+	"\x87\xdf\x91\x40\x20\x08\x76\x93\xdb\x66\x7b\xe1\xa1\x0d\x3a\xe7\x77\xfe\xff\x01\x1b\xc3\x21\xbc\xdc\x25\xeb\x9c" +
+	"\x70\x06\xb3\x1b\xc3\xc5\xc8\x89\x11\xc4\xce\xbb\x09\xba\xda\x31\x4e\xe6\xa3\xab\x3b\xb6\xdc\x64\xd9\x97\xb9\x61" +
+	"\x1a\x00\x00\x57\x09\x9d\xc3\xd5\x32\x59\x27\x29\x9f\x83\xef\xa1\x20\xf6\xe3\xcf\xe6\xa9\x7d\x6a\x41\x10\xc6\x10" +
+	"\xcc\x26\x13\xdb\x28\x49\xc9\x3a\x67\x6c\xc7\x52\xbe\x68\x31\xe9\x24\xfb\x7c\x3b\x87\xab\xf4\x96\xe4\xab\x0d\xc9" +
+	"\xe7\x60\x9e\x9e\x9c\x9c\x3c\x44\x2a\xd8\x2a\x67\xbc\x4d\xf9\xea\xf7\x1e\x42\x76\xcb\x52\x5e\xf4\x11\x6a\x44\x64" +
+	"\xc5\x93\x5b\x26\x35\xe2\xf3\x1a\x02\xdc\x30\x88\x62\xec\xf8\x41\x7c\xe5\x8d\x17\x95\xdd\x0b\x45\xec\xa1\xb1\x33" +
+	"\x9b\xc4\xa6\x79\x6a\x59\x0a\x68\x95\x33\xc2\x19\xe5\xbb\x39\x5c\x51\xc2\x19\x4f\x76\xec\xbb\x80\x1a\x93\xc2\x5c" +
+	"\x33\x2e\xd8\x4d\x81\xac\x73\x4d\xdf\x2b\xae\x39\x4c\xb1\xff\xc1\xc1\x9f\xe1\x3d\xfa\x0c\xee\x64\x16\xc5\x08\x23" +
+	"\xaf\x0c\x8a\x8c\x89\x13\xb9\x86\x65\x58\x10\x06\x57\x15\xe9\xdc\x78\x63\x38\x93\x18\xe1\xfe\x80\xc2\xa5\x1f\x5f" +
+	"\x80\x7b\x81\xdc\xf7\xe0\x78\x9e\x2e\x77\x5c\xcb\x5d\xd4\x31\x9c\xc3\x38\xc4\xc8\x3f\x0f\x84\x06\x66\x3b\xb6\x96" +
+	"\x81\xd1\x18\x61\x14\xb8\x28\x52\x62\x1a\x46\xa1\xa2\xbc\x4d\xa1\xa8\x65\x84\x01\x78\x68\x82\x62\x04\xae\x13\xb9" +
+	"\x8e\x87\x8c\x37\xbd\x99\x27\x63\xb9\xc8\xf6\x7c\x99\x7d\xfd\x2b\xe9\xa7\x4c\x68\x27\x5f\x87\xa8\x14\xc2\xef\x6f" +
+	"\x58\x27\x69\x7a\x92\xab\x65\xb3\x06\xa9\x91\x50\xb6\x4d\x6e\x59\x7e\x7f\x98\xe2\x86\xdc\x6f\x33\x42\xdb\xd2\x76" +
+	"\xe4\x6b\x8f\x38\xce\xd9\xee\x46\x66\x73\x4b\x75\x3d\x52\xde\x78\xa1\xbb\x69\xd1\xf0\xd4\xf9\x7a\xd2\xe4\x6b\xca" +
+	"\xbe\x72\x45\xb2\x78\x64\xda\xb6\xe0\x1f\x02\xf4\xa5\xb0\xaa\xd9\xb4\x47\x88\x66\xdf\x96\x14\x7c\xc1\xf2\x3c\xcb" +
+	"\x7b\x5b\x80\x46\xf9\xa4\x3a\x6b\x29\xfc\xb4\x62\xd3\x59\x7f\x62\xc5\xb5\x61\x8f\x96\x9d\x4e\xda\xd4\x7e\xab\xf2" +
+	"\xb4\xb4\xee\xab\x3b\xc5\xf4\xf8\xaa\x33\xa6\x38\x74\x91\x37\xc3\xa8\x83\xb1\x20\x94\xce\x61\x38\xfc\x66\x0c\x87" +
+	"\xdf\xe0\xec\xd9\x2e\x09\xef\xb1\x62\x95\x27\x37\x3c\xc9\xd2\x3f\x00\xb3\x75\x52\x70\x96\x03\x49\xe1\x22\x8e\xa7" +
+	"\x50\x69\x04\x39\x5b\xb1\xe4\x36\x49\xd7\xa0\x2a\x0d\xa4\xcb\x0a\x20\x05\x14\xc9\x3a\x65\x14\xfe\x8c\xc2\x60\x14" +
+	"\x6f\x98\x84\x55\x5e\x90\x77\x55\x7b\x90\xd4\xb4\x77\x09\xdf\xc0\xc5\x07\xc7\x1d\x46\x17\xce\xab\xdf\x5f\xc3\xbe" +
+	"\x10\xe0\x7c\xc3\xa0\x9c\x39\x60\x7e\x1a\x7a\x97\xc3\x28\x59\xa7\x84\xef\x73\x06\x1b\x46\x28\xcb\x2d\x09\xee\xe4" +
+	"\xeb\xbd\xe8\x81\xc5\x1f\x55\x7b\x7a\xab\xb7\x08\x78\xe7\x9f\xfb\x41\x6c\x2b\xe9\xc2\x93\xe0\x7b\x90\x5d\x43\x4d" +
+	"\x06\x3c\xab\x6c\x62\xca\x90\xeb\x2c\x87\x61\x59\xe8\x67\xff\x02\xb2\xdd\x96\xc8\xfb\x7c\xab\x70\x00\x82\x8f\x0e" +
+	"\x76\x2f\x1c\x5c\x8e\x4b\x5b\x22\xcf\xf0\xa4\xf6\x45\xce\x60\x1a\x46\x31\xa3\xc0\xb3\x92\xbd\xb2\xa6\xc3\x2e\x46" +
+	"\xa3\x0d\x92\x3d\x2a\x09\x84\x74\xe1\x1b\xe5\x85\xca\x65\x25\x48\x05\x7f\x18\xc4\xcd\x76\x3b\x02\x05\xbb\x21\x62" +
+	"\xa5\xa0\xb0\x4d\x0a\x2e\xec\xad\x18\x1f\x9a\x75\xfc\x12\x90\xa6\xe8\x96\x8c\xda\xb7\x64\x9b\x88\xba\xa5\x76\xce" +
+	"\xfe\xcd\x56\xe2\x3f\x37\xfb\xe5\x36\x29\x36\x8c\xda\x3c\x4f\xd6\x6b\x96\x33\x6a\x53\xb6\x65\x9c\x51\xab\x54\x58" +
+	"\x25\x72\x42\x01\xaa\x70\x40\x38\x8b\xa7\xb3\xb8\x42\xc7\x8c\xef\x73\x91\x34\x65\x5c\x2a\x7a\xc3\x32\x9c\x08\x64" +
+	"\x88\x87\xcf\x76\x19\xef\xd0\xb9\x1f\x48\x3d\x3d\xe4\x4e\x1c\x8c\xe0\xed\x2a\xdb\xa7\x1c\xfc\x20\x2e\x1b\x9f\x3f" +
+	"\xee\x64\x94\x1f\xd5\x5d\x4f\x12\x34\x10\xe2\x8e\xd0\x04\xb9\xb1\x42\x39\x03\x37\x9c\x05\xb1\xf9\x0f\x4b\xf3\xf4" +
+	"\x18\x87\x1f\x40\x54\xf9\xa8\xc6\x6d\x4e\x2f\x2f\x10\x46\x90\x50\x38\x6b\x8b\x35\x6a\x12\x7f\xdc\xa0\x9f\xd4\x4f" +
+	"\xdb\x5a\x88\x1b\x3b\x7e\x84\x30\x0e\x31\x98\x03\x47\x21\xc1\xd5\x0b\xff\xf5\x3f\xe9\x1c\x68\xc6\x0a\x48\x33\x0e" +
+	"\xec\x6b\x52\xf0\x81\x0d\xa7\xa7\x36\x9c\xda\x6d\xa1\xba\xd6\x00\x18\xc5\x33\x1c\xc0\xab\xfa\x21\x0a\x3c\x43\xfd" +
+	"\xab\x7c\x15\xcc\x26\x13\x7f\x6c\xbe\xdd\xe7\x5b\x1b\x06\x03\x4b\xba\x4b\xd4\x51\x88\xeb\xb3\x42\xa6\x79\xeb\xb8" +
+	"\xc7\x93\xba\xfe\x97\x75\xeb\xf9\xcf\x3e\xc9\x59\x01\xcb\x8c\x6f\x40\x94\x23\x49\x69\xd5\x25\x94\x0d\x96\xd1\x51" +
+	"\xf8\xb7\x8e\x92\x41\x84\x70\x2c\x02\x1c\x96\x51\x50\x09\xa7\xd8\x44\xbf\xd6\xbd\x60\x0b\x41\x76\x25\xc5\xae\xea" +
+	"\xa8\x94\xf2\xd1\x99\xcc\x50\x04\x66\xcb\x6b\xb6\x6c\x14\xb6\xaa\x77\x1b\x30\x9a\x4e\x1c\x17\x99\x93\xf0\x12\x61" +
+	"\xb3\xaa\x60\xcb\x86\x01\x0c\xa4\x13\xac\x52\xb1\x08\xc5\xad\x6a\x39\x2b\x57\xfb\x85\x3b\xc3\x18\x05\xb1\x39\xd0" +
+	"\xb5\x1d\x54\x4c\xc3\x21\xa8\x0a\x82\x68\xbf\x5a\xb1\xa2\x90\xcf\xd1\x27\xe4\x96\xe6\x51\xb6\xdc\xaf\xe1\xed\x5b" +
+	"\x31\x61\x7c\xcf\x86\x81\x17\x06\x68\x60\x34\x0e\x32\x44\x1c\x9f\x79\xba\x1c\x9e\x73\xd2\x1b\xbf\x6e\xca\x39\x94" +
+	"\x02\xe9\x0c\x32\x31\x10\x44\xdf\x2d\x57\x80\xaa\x77\xe6\xf7\x50\xbe\x86\xd4\x73\xb0\xd8\x2f\xc5\xb8\x5c\xca\x36" +
+	"\x9d\x3d\x9c\x74\x02\xa2\xc4\x13\x49\x5a\x67\x88\x18\x8b\xaa\x19\x93\x9c\x01\x4d\x8a\x1b\xc2\x57\x1b\x46\x61\x79" +
+	"\x2f\x99\x28\x61\xbb\x2c\x1d\x1d\x18\x6f\xcd\xb6\xac\xf7\x7f\x39\x43\x64\x3b\x45\xe2\xfc\xc7\x5a\x76\x4a\x76\x0c" +
+	"\x8e\x8c\x98\x40\x9c\x67\xd7\x8d\xdb\xcc\xeb\x64\xcb\x2a\x66\xda\xb8\xb7\x61\x96\x33\xb2\x64\x0e\xe5\x7e\x41\xb6" +
+	"\xb0\xd9\xef\x48\x0a\x39\x23\x94\x2c\xb7\x0c\x28\xe3\x24\xd9\x16\x60\xb2\xd1\x7a\x04\x72\x27\x85\x1d\x2b\x0a\xb2" +
+	"\x66\xd6\x2f\x1b\x06\x3d\xbb\x44\x9b\x40\xb9\x40\x4c\x86\x5e\x02\xf1\x2e\xf0\xaa\xe0\x64\xdd\x72\xa6\xf4\x47\x9b" +
+	"\x50\x2d\x46\x6d\xaf\x7f\x70\x3e\xd5\x95\xfe\x0d\x26\x59\xf6\x65\x7f\x23\x93\x64\x4b\x38\x2b\x78\x13\x82\xe5\x3d" +
+	"\xc8\xb0\x99\x59\x2e\xcf\x6b\xcd\x21\xb9\x96\x8d\xfe\x9e\x71\x20\x94\xaa\x20\xab\x41\xd5\x32\xe0\x0c\x3e\x38\x9f" +
+	"\xcc\xa6\xf1\x37\xa3\x4a\x91\x19\xda\x94\x92\xf2\xce\xca\x74\x69\x86\xa5\x0e\xd8\x9d\x95\xba\xe0\x96\x6b\xcf\x9a" +
+	"\xfa\x58\x24\xb4\x77\x58\xb6\x35\xe8\xce\x4a\x4d\xaa\x24\x40\x93\x08\xd5\x94\x4d\x33\x6c\xa4\x5c\x27\x29\x2d\x55" +
+	"\x7f\x38\xf7\xc4\x7e\x62\xeb\xa1\x0b\x67\xd5\x36\x20\x7b\xb4\x8a\xd4\x19\x98\xca\x18\xbd\x28\x0f\x5e\x4e\x54\xd6" +
+	"\xbd\x6d\x74\x4f\xb4\xbb\xad\xc8\xa1\xcb\x89\x5a\x0e\x3b\x8a\x48\x46\x7a\x3d\x3f\x0a\x51\x30\x1c\xd7\x52\xf3\xb7" +
+	"\x7a\xf6\xf0\x72\xa2\x3a\x3f\xbf\xa7\x64\xab\xe7\x3c\x0a\xf0\xbb\x3a\xba\x61\xf0\x11\xe1\xd8\xd4\x8a\xce\xb2\x81" +
+	"\x8e\x0a\x4e\xf8\xbe\x58\x88\x45\xd6\x86\xd3\x57\x27\x96\x80\xd5\x1f\x1e\x03\xa5\xa3\x22\xf9\x2f\x7b\x8a\x9e\x82" +
+	"\xfe\x28\x64\xab\x63\x1e\xbc\xa4\x2f\x6b\xba\xa7\x19\xfe\x9b\x98\x0e\xe7\x28\xf6\x9c\x18\x99\x96\x30\xfa\xb5\x34" +
+	"\xba\x7e\x33\x3f\x80\x26\xcb\x5f\xe5\xf8\xa9\xe0\xa0\xfb\xdd\xee\xde\x82\xaf\xc7\xc4\x4f\xd0\x38\x16\x15\x84\x30" +
+	"\xfc\x19\x6a\x0b\x5d\xcf\xdd\xae\x49\x20\x10\x06\x60\x92\xd1\xc3\xf5\xd7\x32\x7a\xf9\xff\x9a\x40\x15\x1a\xa0\x52" +
+	"\x1e\xad\xe4\xd5\x11\x3b\x22\x6e\x1c\x62\xf9\x6e\x0b\x53\x27\xbe\xb0\xe5\xe7\xbb\x70\x16\x2f\x1c\x8c\x9d\xcf\x8b" +
+	"\x4b\xec\x4c\xa7\x08\x57\xfd\xfa\xc1\x9e\xa9\x7f\x57\xd0\xf1\xcd\x6a\xa1\x10\x25\x02\x4d\x23\xb1\xdb\x15\xde\x24" +
+	"\x94\x20\xab\x5a\x50\xab\x95\xdf\x8d\xc4\x89\xd6\x8a\x3a\x5d\xcd\x6e\x99\x68\xd7\x7d\xcc\xe8\x76\x5a\xb5\xe0\xdc" +
+	"\xe9\xcd\xfe\x6e\x54\xad\x3f\x67\x70\xaa\xb4\x77\x02\x0f\xcc\xbb\xd1\x83\xd7\xa3\x6a\xdf\xef\x9c\x1c\x0a\x69\x85" +
+	"\x52\xad\x44\x1a\xff\xc0\x1e\xc0\x4b\xa8\x4f\x5e\xca\x07\x13\xff\x3d\x82\xc1\x0b\x79\xa4\xb7\x5d\x71\xfa\x42\x6d" +
+	"\xc5\x7f\xd7\xed\x37\xc6\xfe\xf9\x39\xc2\x6a\xf7\xad\xc3\x51\xad\x5f\xe5\x77\xc4\x5f\xbc\x08\x8b\x57\xf7\xde\x3d" +
+	"\xf8\x6e\xc3\x52\xb1\x08\xab\x4c\x4c\x58\xb5\xc0\x56\x3c\x43\x48\x52\xe0\x7d\xdf\x7c\x0a\xd1\xdb\x79\x4e\xd2\x42" +
+	"\xe4\x50\x96\xda\x50\x64\xda\x7a\x2c\x5f\x41\x0b\xc8\xd2\xed\xbd\x58\x56\xc4\x41\xa9\x87\xf8\x48\xb4\xca\x76\xbb" +
+	"\x84\x73\x46\x9f\xdb\x23\x61\x00\xdd\xb8\xcc\x0d\x67\x2c\xbe\x24\x96\x9f\xca\x0d\x27\xd2\x56\x44\xb1\x09\x04\xa1" +
+	"\x7c\xbd\x87\x30\xf8\x95\x25\xaf\x03\x0f\xaa\x00\x0e\x5a\x4f\x69\xab\x10\x3b\x47\x9d\x07\xaa\xdd\xd7\x48\xea\xe0" +
+	"\xe9\x2b\x4d\x5b\xac\x7a\x7a\x74\xf1\xe8\x28\xf3\x1c\x2b\x8c\x6c\xf7\xea\x8f\x47\x4d\xf0\x03\x4a\xd1\xc7\x2a\xf5" +
+	"\xfd\x95\xe5\xa7\xae\x2a\x3f\x71\x45\xf9\xf1\x4d\xe2\x71\x03\x53\x9b\x40\x55\xda\x01\x35\x1e\x37\xe1\x8f\xad\x10" +
+	"\xed\x04\xb4\x5a\x26\x3e\xef\xa4\xeb\x48\xfe\xb1\x51\x57\xb9\x44\x8e\xb7\xff\xcb\xb4\x6a\xfd\x26\xed\x65\x77\xa9" +
+	"\xe1\xe1\x70\x0a\xd5\xf4\x7a\xd0\x26\x3b\xe3\xcb\x78\x53\x92\xd7\x9f\x7a\x6a\x86\xe6\x5b\xcf\x61\x1a\xd5\x19\x75" +
+	"\x2a\xf9\x4b\x0e\xf4\xfe\x94\xd3\x4f\x72\xc7\x96\x9b\x2c\xfb\x32\x37\xde\x18\xff\x1b\x00\x9b\xde\xed\x01\x60\x1f" +
+	"\x00\x00")
+
+func bindataMigrations20190527101000EventoutboxsqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190527101000Eventoutboxsql,
+		"../migrations/20190527101000-Event_outbox.sql",
+	)
+}
+
+
+
+func bindataMigrations20190527101000Eventoutboxsql() (*asset, error) {
+	bytes, err := bindataMigrations20190527101000EventoutboxsqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190527101000-Event_outbox.sql",
+		size: 8032,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792396445, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190603091000Triggerkindsql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x5d\x6f\xdb\xc6\xd2\xbe\xd7\xaf\x98\x9b\x42\xd2\x5b\xca\x70\x9a" +
+	"\xbe\xe7\x22\x3d\x0a\x4c\x53\x74\xac\x86\x21\x1d\x92\x6a\x53\x18\x82\xb0\x26\x57\x12\x61\x8a\xcb\x2e\x97\xae\x7d" +
+	"\x90\x1f\x7f\x30\xbb\xcb\x2f\x49\xb6\x95\x22\x4e\x8a\x03\x59\x05\x2a\x51\xb3\xcf\xce\xcc\xce\xcc\x3e\xbb\x13\xf5" +
+	"\x46\x23\xf8\x71\x93\xac\x38\x11\x14\x66\x79\xcf\x74\x42\xdb\x87\xd0\x3c\x77\xec\xeb\x0d\x15\x64\x7e\x72\x4d\x56" +
+	"\x9c\xd2\x0d\xcd\xc4\x42\xf0\x64\xb5\xa2\x7c\x0e\xe6\x64\xd2\x03\x00\xb8\xbe\x4d\xb2\x78\x0e\xd7\xd9\x1d\xe1\xd1" +
+	"\x9a\xf0\x39\x0c\x7e\x3a\x1d\x82\xeb\x85\xe0\xce\x1c\x07\x2c\xcf\x0d\x42\xdf\x9c\xba\xe1\xf5\xe4\x62\xb1\x03\xb4" +
+	"\x50\xc3\x27\xf6\x85\x39\x73\xc2\x41\x3f\xf8\xe8\xf4\x87\x86\x42\x16\xc9\x86\xb2\x52\x2c\x0a\x1a\x15\x73\xb8\x4e" +
+	"\x32\x31\x97\x98\xfa\x6b\x4e\x05\x4f\x68\xe7\x9b\xde\x2f\x4a\xfb\xde\x95\xef\x59\xf6\x64\xe6\x3f\x6e\xc1\x82\xc4" +
+	"\xf1\x1c\x46\xa3\xcf\xbd\xd1\xe8\x33\x8c\x5f\xec\x4f\xc2\x9b\xa5\x58\x33\xfe\x06\xd5\x06\x08\x18\xa7\x19\x9c\x93" +
+	"\x5b\x70\x08\x2f\x68\x26\x25\x26\xb4\x88\x78\x92\x8b\x84\x65\x6f\xc0\x8c\x63\x20\x10\x95\x85\x60\x9b\xe4\x3f\x34" +
+	"\x06\xad\x33\x44\x24\x4d\x41\x30\x20\x50\xe4\x34\x4a\x96\x49\x04\xb5\x61\xb0\x64\x1c\x32\x26\x92\xe5\x43\x92\xad" +
+	"\x20\x62\x59\x51\x6e\x28\x2f\x4e\x24\x7e\xe7\x2f\xf8\xe8\x54\x90\x05\x10\x4e\x81\xde\xd3\xa8\x14\x34\x86\x9b\x07" +
+	"\x40\x87\x9d\xc4\x34\x4d\xee\x28\x7f\xa8\xbc\x05\x23\xc0\xa9\x99\x58\x53\x0e\xb8\x64\x72\xdc\x2e\x72\x1b\x48\xac" +
+	"\x29\xc4\x84\x6e\x58\x06\x64\x29\x28\xdf\x8f\xfc\x66\x17\x44\x29\x88\xff\x1f\xd5\x16\x17\x82\x71\x1a\x43\xce\x59" +
+	"\x44\xe3\x92\x53\x18\x30\x2e\xc5\xc4\x9a\x08\x58\x51\x51\xd4\x73\x0f\xf7\x21\x5e\x86\xe1\x95\x42\x9c\xf9\x8e\x52" +
+	"\x4d\x2b\x02\x49\x01\x57\x5e\x10\xa2\x9b\x19\x90\x02\x7e\x0d\x3c\x77\x1f\x84\xfd\xe9\xca\xf3\x43\x84\x58\x26\x29" +
+	"\x85\x01\xa7\x29\x11\xc9\x1d\xc5\x61\xde\x2c\x3c\xf7\x3e\x0d\xbb\xc0\x31\x11\x04\xd1\xe9\x7d\xce\x38\x7a\x57\xb0" +
+	"\x7d\xb8\x1f\x67\xf6\xcc\x96\xaa\xfd\x59\xd2\x92\xc2\x40\xb0\x3c\x89\xb6\xb0\x92\x02\xf2\xf2\x26\x4d\x8a\xf5\xb3" +
+	"\x7a\x5a\xde\x87\x0f\xa6\x3b\x81\x11\x44\x6c\xb3\x21\x59\x0c\x69\x92\xd1\x47\x96\x66\x17\xe0\xac\x9a\x73\x91\xc4" +
+	"\x90\x64\x52\x8d\x2a\x0a\x04\xbd\x17\x68\x11\xa7\x79\x4a\xa2\x06\x6b\x3a\x01\xb6\xec\x28\x2c\x71\x4d\xbe\x2a\x31" +
+	"\xe7\x8a\x37\xbd\x81\xcc\xd9\xb3\x26\x0d\x93\x18\xce\xa7\xef\xa6\x6e\x68\x54\x13\xab\x17\x8e\x53\x70\x32\x5c\x9a" +
+	"\xf0\x6e\xeb\x51\xac\x59\x99\xc6\x70\x43\xd1\xb4\x5b\xe9\x11\x85\xaf\x05\x50\x75\x80\x5d\x70\x8d\x6f\xd6\xa0\x75" +
+	"\x1a\x55\xc8\x49\x0c\x03\x4c\xa4\x94\xad\xd0\x78\xca\x39\xe3\x20\xc8\x4d\x4a\x87\xdd\x19\xa4\x2b\xdc\xdf\x4c\xdf" +
+	"\xba\x34\xfd\xc1\xab\xd3\xd3\xd3\xa1\x9e\x0c\x2d\x08\x3e\x3a\x06\xcc\x7c\xc7\x90\xb1\x62\xe8\x95\x65\xbc\x5e\x92" +
+	"\x98\xe6\x34\x8b\x31\x51\x59\x26\x13\xaa\xa7\x7d\x5f\x97\x01\x78\x1c\xdd\x93\x02\x24\x85\xb6\x38\x6a\xad\x95\x53" +
+	"\x58\x88\x5a\x59\xdd\x60\x61\x71\x1e\x83\xac\xb3\x06\x6a\xfa\x1e\xa5\xd8\xb2\x76\xc0\x00\x55\xc7\x6c\x31\x54\xbc" +
+	"\x1b\x32\x3c\x0d\x1d\x54\x95\x13\x5a\x95\x19\xdd\x0c\x63\x59\x7e\xb5\x8a\x95\x9a\xa1\x92\x82\x9c\x72\x20\x42\xd0" +
+	"\x4d\x2e\x60\x24\x05\x61\xfc\xb6\x2a\x0d\x31\x5d\x92\x32\x15\x0a\x57\x97\x74\x7c\x0f\x2d\x5c\xe8\xe2\xfa\x5a\x4a" +
+	"\x55\x15\x02\x4b\x92\xa4\x34\x7e\x7e\x8a\x61\xcf\x0c\x40\x06\xe6\xe8\xc5\xfe\x7a\xe7\xf6\xbb\xa9\x2b\xad\x99\xd8" +
+	"\x96\x63\xfa\x36\x9c\x6d\x8a\x55\xe3\xff\x9f\x71\x2d\xbb\xdf\xcb\xf0\xea\x2c\xd1\xe9\xb0\xd7\xab\xac\x75\x18\xbb" +
+	"\x85\x32\x97\xd9\x95\x64\x89\x50\xd1\x08\x4b\xce\x36\x4d\xf1\x5f\x24\xb1\x1c\x10\xd8\x8e\x6d\x85\x15\xe4\x18\xfa" +
+	"\xd7\x7d\xf8\x51\x8d\x58\x14\xd1\x9a\x6e\x08\xfc\x08\xfd\xf9\x49\xeb\x71\x46\x36\x54\x3e\xec\x4b\x04\x80\x0b\xdf" +
+	"\xfb\xb0\x95\x7c\x8b\x42\x90\x15\x5d\x28\x9c\x3b\x25\xf7\xfb\xa5\xed\xdb\x1d\x0d\x60\xdc\x4d\x70\x8d\x07\x58\x8c" +
+	"\x14\x40\x12\xc3\x18\x4e\x95\x6d\xd3\x8b\x4a\xcd\x69\x20\x57\x4c\x3e\x6d\xdc\x87\x2f\xdf\x9c\x06\xb6\xef\x7b\x3e" +
+	"\x0c\xfa\x4d\xd2\x5e\xff\x30\xfd\xd7\xcf\xf1\x1c\x62\x46\x0b\xdc\xf1\x80\xde\x27\x85\xe8\x1b\xf0\xea\x95\x01\xaf" +
+	"\x8c\xae\x12\xc3\x06\xcc\x0e\x67\xbe\x0b\x3f\xc9\x07\xb6\x3b\xe9\x69\x8f\x85\x3a\x55\xc6\x30\xbb\xba\xb2\xfd\x81" +
+	"\xe5\x99\x8e\x1d\x58\xf6\x40\x3e\x36\x54\xae\x0c\x87\xb5\xd2\xf8\x54\xf2\x9b\xa9\x0b\x8a\xb0\x18\xd0\xc7\x8c\xe9" +
+	"\x1b\xd0\x57\x49\x83\xef\x64\xde\xe0\x1b\x9d\x3a\xfd\xe1\x33\x06\x86\x3a\x03\x25\xfe\xf5\x0f\xc5\x1c\x2b\x2d\x9a" +
+	"\x57\x94\xb9\xda\x40\x5a\x26\xa2\xd0\x8e\x69\xaf\xf7\x98\x86\xc1\x37\x86\x1a\x5c\x2e\xbc\x65\x06\xe1\xa0\x5d\x2b" +
+	"\xcd\xa0\x8e\xbe\xa1\x8c\x05\x40\x31\x69\x3f\x7e\x1c\xcb\x51\xdd\xd2\xd7\x44\x8c\xfd\xc9\xb6\xaa\xbd\xfd\xa6\x5c" +
+	"\xc1\xd9\x19\xb2\xaf\xe9\xc4\x90\x91\xdf\x04\xf2\x84\x0a\xca\x37\xb8\x15\x95\x79\x8c\x5c\x93\x71\x48\xb2\x82\x72" +
+	"\xd1\xa9\x5b\x75\x5a\x44\xac\xcc\x04\x56\x01\x6d\x8c\x8a\x6c\xf5\x74\x0c\x96\x37\x73\xc3\xc1\xff\x0d\x9f\x08\xda" +
+	"\x36\xea\x17\x86\xab\x1e\xaa\xa5\x9a\x4f\x4d\xe8\x56\x7a\x9c\x56\xc3\x60\xea\x06\xb6\x1f\xa2\xc2\xde\x93\x9a\x34" +
+	"\xaf\x41\x7b\x7a\xa3\xf2\x42\xe7\x3d\x6e\x33\x46\xbb\xce\x1b\x72\xb7\x30\xa0\x5d\x82\x0d\xd0\x85\xb3\x89\x88\xdf" +
+	"\x4c\x67\x66\x07\x30\xe8\x58\x68\xb4\x4d\x69\x7d\x50\x93\xb4\x37\x1f\x1d\x61\x46\xb7\xd4\x1b\x75\x85\x56\x13\xd9" +
+	"\x4e\x60\xd7\x33\xce\xae\x26\x66\x68\x1f\x60\x39\x86\x65\x7b\xe6\xb6\x87\xf1\xb3\xd1\x16\xc6\xff\x3a\x1b\xd9\x58" +
+	"\x6b\xb6\x2d\xd4\xd6\x13\xc6\x5b\x7a\x6f\x0b\x6b\x2b\x6a\x44\xfd\xb9\x11\x3b\x3c\x5a\xf6\x44\x0c\xec\x8b\x99\xd1" +
+	"\x08\x3e\x83\x4f\x45\xc9\x33\x08\xca\x28\xa2\x45\xf1\x4c\xf6\xf4\x27\x9e\x6b\xab\x14\x53\xf9\xdd\xc3\xa2\xf5\xc2" +
+	"\xa7\x95\x47\x0f\x50\x35\x2b\xd4\x86\xfd\x53\xcf\x4e\x16\x1e\x18\xb6\x4f\x0b\x45\x9b\x1d\x21\xe9\xa2\xf7\x82\xf2" +
+	"\x8c\xa4\xcd\x31\x09\x19\x67\x65\xe3\x89\x97\xa5\x0f\x5f\x72\x6c\x5a\x53\x4e\x61\xb4\x7d\x3c\x3a\x98\x72\x6f\x91" +
+	"\xe5\xea\xb1\x7c\x55\xcc\x59\x6e\xd3\x0d\x45\xf8\xff\xd3\xa1\xaa\xaa\x2e\x3e\x67\x4b\xc9\x33\xf1\x60\xb0\x21\x22" +
+	"\x5a\x03\x59\x91\x24\x2b\x14\x69\xae\xa3\x16\x72\xa4\x48\x3c\xfb\xae\x44\xa8\xb2\xeb\x69\x46\xf4\x38\xc9\xee\x0a" +
+	"\xaa\x2a\xac\xff\xaa\xad\xa2\xfe\x76\xcf\x69\xa3\x2b\x50\x07\x35\x66\xed\x3e\x01\x52\xc6\x89\x1c\x0d\xb0\x5f\x40" +
+	"\x32\x98\x2d\x81\x7a\xc7\x3b\x27\x05\x8d\x81\x65\x20\x17\x4f\x7b\xdf\x80\x94\xb1\xdb\x32\xdf\x5a\x1b\xc9\xe7\xba" +
+	"\xc5\x53\x11\xb4\xe7\xaa\x04\xf2\xc3\x32\x07\x12\xc9\xb3\xe8\x16\x60\x1d\xd1\x52\x03\x67\xfa\xbe\x55\xd4\x4e\xb4" +
+	"\x42\xdb\xbb\x78\x2d\xb0\x58\x62\xe1\x3d\xc3\xa1\x06\xbc\xde\xe2\x55\xe0\xcd\xc2\xad\x2d\x04\x1f\xd5\xfb\x63\x47" +
+	"\xf6\x30\x86\x67\xcb\xc3\x16\x7a\x07\x53\x14\x6d\xaa\x30\x24\x1d\x6a\xb1\x1f\x54\x69\x87\xfd\x6c\x11\x3b\x4c\x8e" +
+	"\x81\x4f\x47\xc3\x9c\xb3\x0d\x13\xad\xb3\xb4\x60\xc0\x69\xce\x76\x1c\xab\x63\x81\xc4\x71\x57\x7d\x65\x3c\xce\x69" +
+	"\xe8\x63\xce\xa9\x01\xfd\xd0\x9f\xbe\x7b\x67\xfb\x7d\xa3\x1b\x46\xca\x2d\x75\xdc\x68\x2f\x55\x51\x82\x1e\xaa\x5d" +
+	"\xd4\x1e\x76\x80\x87\x06\xfd\x89\x1e\x20\xdd\x21\x4b\x1a\xda\x51\x24\x82\xf1\x07\x49\x84\xc9\x1d\x49\x52\x9c\xeb" +
+	"\x39\x5f\xfd\xbc\xeb\xab\x77\x54\x00\x49\x9b\x02\x87\xf0\x29\x63\x39\xae\x45\x92\x01\xe3\x31\xe5\x9d\xd8\x27\x0b" +
+	"\x4e\x23\xb0\x66\x7e\xe0\xf9\x70\xe1\xf9\x6d\x9a\xe6\xdb\x57\x8e\x69\xd9\x83\x76\x78\x18\xd0\x6f\x7b\xaa\x6f\x68" +
+	"\x1e\xda\x76\x43\x9b\x88\xbe\x10\xb5\xd3\x5c\x5f\x12\x77\x35\x83\xe7\x4f\x6c\x1f\xce\xff\xa8\x6c\x6f\xef\xee\x52" +
+	"\xc2\xbb\xb2\x5d\x65\x6e\xf7\xf9\x85\x1d\x5a\x97\xe0\xda\x9f\x42\x75\x68\x92\x22\x8a\x00\x76\x12\xa3\x77\x40\x0e" +
+	"\xe7\xc0\xee\x68\xbd\x47\x15\x2a\x29\x7f\xbf\x9c\x3a\x36\x9c\x9d\xc9\x89\x16\x41\x68\x86\xb3\xa0\xe6\x9c\xdd\x30" +
+	"\x91\x9f\x20\xf4\x4d\x37\x30\xad\x70\xea\xb9\xbd\x9d\xaf\xfe\xa8\x9f\x3c\xad\x4e\x57\xf7\x9d\x31\x45\xbe\xd0\x1b" +
+	"\x5b\xf1\x67\xba\xcf\x50\x1d\x5a\x9d\x19\x31\xc2\x64\x14\xc3\x9a\x64\x71\x9a\x64\xab\xfa\x2b\xa5\x9e\x65\x86\xd6" +
+	"\x65\xfd\xac\x1a\xe2\xa8\x6b\x18\x99\x4e\x80\x57\x59\x9c\xa5\xe9\x0d\x89\x6e\x41\x70\x92\x15\x58\xf4\x58\xd6\x19" +
+	"\x85\xdc\xfc\x0c\xbf\x54\x5b\xc3\x5b\x38\x05\xdf\x73\x9c\x73\xd3\x7a\xdf\xf1\xce\xf6\x98\x3a\x63\xa7\x41\x73\x79" +
+	"\xdd\xf8\x88\xe5\x94\x13\x9c\x0c\xef\x8d\x1b\x69\x55\x1a\x0e\xf5\xdc\xfe\xc5\xd7\x47\x33\xcc\xb3\x2a\x15\xf4\x95" +
+	"\x46\xbf\xf1\xe7\x17\x85\x5b\x35\x00\x5f\x96\xe7\x86\x53\x77\xd6\xf0\x74\x5c\x19\xe5\xec\xfa\x11\x7a\x3a\x98\x59" +
+	"\x96\x1d\x04\xbb\xcb\x73\x10\x41\xad\x50\xf0\xac\x3b\x0d\x91\x55\x09\x5c\x29\x1a\xef\x5d\x27\x2d\xb5\x37\x58\xbf" +
+	"\xc8\x48\x2c\xf6\x38\xca\x72\xbc\x40\x17\x24\x5d\xa2\x4c\xc7\xf1\x2c\x33\x6c\x3f\xac\x2b\x9d\x66\xe1\x45\x8b\x85" +
+	"\x6b\x82\x5d\x49\x20\xee\x37\x21\xdb\x96\x6f\x9b\xa1\xbd\xdb\xae\xa8\x2c\xe5\xb4\x28\x53\xf1\x4d\x7b\x15\x1d\x36" +
+	"\x8d\xf9\x27\xd6\x14\x94\x1e\xc8\x91\x49\x55\xa2\x1e\x61\xb7\x78\x83\x4e\x32\xa8\xf3\x05\xe9\x8f\x58\xd3\x5d\xd2" +
+	"\x9b\x12\x41\x0b\xd1\xde\xc1\x64\x52\x6d\x5f\x24\xc3\x08\xe8\x3d\x89\x44\xfa\x00\x69\x72\x4b\xab\x48\xd4\x5b\x86" +
+	"\xd6\x65\x0f\x3a\x5b\x15\x32\x8b\x30\xad\xda\xc4\xfd\x91\x8b\xe9\x1a\x71\xf7\x5e\xba\xb9\x91\xae\x84\x3a\x77\xd1" +
+	"\x7f\x91\xa6\xf5\x80\x7b\x72\xf7\x9a\xb8\x21\x96\xc6\xc1\x37\xd0\x0a\xa1\x10\x44\x94\x05\x6e\x66\x7b\x11\x94\x46" +
+	"\x4a\xa8\xf2\x99\x5e\xa5\xc1\x2b\x18\x83\xf7\xde\x80\xd7\x30\x56\x45\x57\x5f\xdb\xb6\x0e\xf8\xfb\x8e\x12\x97\xe5" +
+	"\x86\x64\xc0\x29\x89\x91\x42\x68\xb4\xef\x76\x58\xa8\x0b\xf2\x3e\xda\x9d\x6b\xc6\xad\x3d\x53\xdd\x77\xa9\x2b\xa2" +
+	"\x7a\xe4\x18\x3e\x98\x9f\x06\x49\xbc\x87\x49\xa0\x48\x9b\x3d\xb4\xd7\x7f\xdc\x09\x87\x47\x6e\x31\x5f\x37\x3c\xae" +
+	"\x9e\xef\x30\x9a\xdb\x62\x71\xea\x1e\xf3\x40\x22\xd7\xd2\x69\x87\xcf\xed\xe1\xbe\x98\xba\xa4\xd8\x9f\x2f\x50\x30" +
+	"\x20\x29\xe5\xa2\x80\x48\xd2\x0e\x3c\x34\x57\xdf\x21\x41\x2a\xb4\x43\x43\xe5\xea\x31\x78\xe7\xbf\xda\x56\xb8\x98" +
+	"\x4e\x06\xfd\xbd\x88\xfa\x76\xf3\x80\x0d\xb3\x09\x6c\x43\x82\x77\x2f\x9e\x7a\xbd\x83\xf7\x9c\x6f\x79\x29\xd2\xe9" +
+	"\x91\x4f\xd8\x5f\x59\x6f\xe2\x7b\x57\x50\xd7\x6d\x78\xa2\x70\x1f\x9b\xd2\xbb\x4d\xe9\x47\xaa\x70\xe3\x90\xa7\xca" +
+	"\xf0\xd7\x6e\x0c\xbe\x64\x4b\x10\xb1\xad\xbf\xd3\xc3\xde\x29\xd8\x5b\xc8\x87\x35\x02\xbf\xe7\x4d\xcf\x93\x17\x3c" +
+	"\xc7\x96\xd7\x77\x6e\x79\xfd\xbd\xbe\xd0\xb1\x13\xf4\xcf\xe9\x04\x7d\xb5\x66\xcf\x4b\x36\x73\x1a\xd9\xc3\xdd\x7e" +
+	"\x6c\xa9\xfc\x0f\xb6\x54\x76\x8f\x68\x5b\x1c\xe0\xd8\xf5\x38\x76\x3d\x8e\x5d\x8f\x63\xd7\xe3\xd8\xf5\xf8\xba\x5d" +
+	"\x8f\x63\x77\xe3\xd8\xdd\x38\x76\x37\x8e\xdd\x8d\xef\xd0\xdd\x98\xd8\x8e\x1d\xda\x6a\x39\x1f\xbd\xf3\x9a\xf7\x74" +
+	"\x29\xc3\x7b\x47\xf8\xf7\x5b\xf5\xef\x2d\x2b\xd6\xac\x7e\x87\xf2\xc4\x68\x90\x17\x71\xcd\xaf\x4c\xe0\x89\x9f\x99" +
+	"\x7c\x31\xa8\x33\xfb\xe0\xea\x5f\xb8\x18\x5b\xbf\x47\x31\x9a\x1f\xa0\xf4\x7e\xe9\xfd\x77\x00\xbf\xc9\x03\xb7\x44" +
+	"\x33\x00\x00")
+
+func bindataMigrations20190603091000TriggerkindsqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190603091000Triggerkindsql,
+		"../migrations/20190603091000-Trigger_kind.sql",
+	)
+}
+
+
+
+func bindataMigrations20190603091000Triggerkindsql() (*asset, error) {
+	bytes, err := bindataMigrations20190603091000TriggerkindsqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190603091000-Trigger_kind.sql",
+		size: 13124,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792396695, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190610093000Deltadeliverysql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x6b\x6f\xdb\xc6\x96\xdf\xf5\x2b\xce\x97\x82\xe2\x5a\x52\xe3\xb4" +
+	"\xbd\x7b\x91\x46\x81\x19\x89\x76\xb4\x57\xa2\xbc\x14\x9d\xc4\x08\x0c\x61\x42\x8e\x6d\xc2\x14\xa9\x72\x28\xc7\x06" +
+	"\xf2\xe3\x17\x67\x5e\x9c\x11\x29\xd9\x4d\x1f\xe9\x02\x92\x2e\x6e\x2a\xf2\xf0\xcc\x79\xcd\x79\x0e\xdd\xe9\xf7\xe1" +
+	"\x68\x95\xde\x94\xa4\xa2\x70\xb1\xee\x4c\x82\x85\x1f\x46\x30\x09\xa2\x39\x7c\x5a\xd1\x8a\x5c\x0d\x3e\x91\xaa\x2a" +
+	"\xd3\xcf\x9b\x8a\x5e\x41\x37\x27\x2b\xda\x83\x84\xb2\xb8\x4c\xd7\x55\x5a\xe4\xf8\xe3\x9a\x6c\xb2\x6a\x79\x4f\xb2" +
+	"\x0d\xed\x41\xc1\x2f\x33\xb7\xb3\xf0\xa7\xfe\x28\x02\x67\xec\x4f\x27\xef\xfd\xf0\x72\x39\x9b\x8f\x7d\xa7\x07\xfa" +
+	"\xe3\x9c\x5e\x4c\xa7\x90\xd0\x2c\xbd\xa7\x65\x4a\x19\x90\x92\x42\x5c\xac\xd6\x19\xad\x28\xb0\x9c\xac\xd9\x6d\x51" +
+	"\xb1\x01\x8c\xfd\x69\xe4\x99\x80\x45\x9e\x3d\x42\x5c\xe4\x15\x49\x73\x88\x6f\x49\x7e\x43\x13\x28\x8b\x2f\x0c\xbe" +
+	"\xdc\xa6\xf1\x2d\x47\x44\xd6\xeb\x2c\xa5\x09\x10\x06\x9b\x35\xa3\x65\xc5\xa0\x4b\xf2\x04\xd1\xd0\x8a\x32\x17\x3e" +
+	"\x3f\xc2\xdb\x8b\xc5\x24\xf0\x17\x8b\xe5\x7f\xfc\x4b\x28\xf2\xaa\x80\xea\x96\x42\xbc\x29\x4b\x9a\x57\xc0\x2a\x52" +
+	"\x51\xa7\x27\xe8\x54\xff\xf6\x38\x31\x0e\x5c\x04\x93\x79\x00\xde\x74\xaa\xf9\x34\x91\x99\x6c\x82\x33\x2a\x56\x2b" +
+	"\x02\x8c\xae\x09\x8a\x39\x81\x2c\x65\x15\x14\xd7\x10\x17\xd9\x66\x95\x33\x48\x13\x9a\x57\xe9\xf5\x63\x9a\xdf\x00" +
+	"\x41\x3e\x80\xc4\x65\xc1\x58\x83\x71\x0b\xed\x9f\xfb\x0d\x2e\xa6\xd3\x9e\xfc\xb7\x85\x39\x4e\xc9\x72\x7e\xee\x87" +
+	"\x5e\x34\x99\x07\xcb\xd1\x7c\x7a\x31\x0b\x50\x28\x23\xce\x04\xb2\xd3\x50\xd3\x8a\x94\x77\xc8\x93\x10\xb9\xd2\x50" +
+	"\x5a\xdd\x72\x31\x73\x7b\x81\x31\xf4\xa1\xa8\x6e\x69\x29\x7e\x33\xe8\xd2\xc1\xcd\x00\x26\x50\x94\x70\xe1\x72\x4d" +
+	"\x4a\xf5\x39\xbd\x3f\xca\x57\xe7\xd7\xce\x28\xf4\xbd\xc8\xef\x9c\x5e\x04\x23\xe4\x43\xdb\xf8\x0d\xad\x96\x09\xcd" +
+	"\x2a\xb2\x64\xbf\x65\x57\xd0\xef\x7f\xed\xf4\xfb\x5f\x61\xf8\x97\x7d\x38\xfa\x71\xbd\x8f\x5e\x41\x48\xab\x4d\x99" +
+	"\x73\xd1\x9c\x86\xf3\x19\xc4\x19\xd9\x30\x0a\x5d\x92\xa5\x84\xd1\x04\x1e\x5c\x28\x69\x5c\xe4\xac\x2a\x37\x71\x85" +
+	"\x72\x6d\x18\x2b\x6a\x81\x70\xcc\x8a\x7d\xfe\x15\x8a\x21\x37\x25\xa5\x2b\x84\x25\x8c\x03\x2a\x55\x3d\xf6\x20\x1d" +
+	"\xd0\x01\x62\xcb\x48\x45\x59\x85\x8a\x82\x35\x2d\xe1\xf3\x86\xa5\x39\x65\x0c\xee\xe8\x23\x5c\x97\xc5\xaa\x89\x1b" +
+	"\x9f\x32\x54\x9e\xe6\x50\xd2\x75\x01\x9b\x35\x6c\xf2\x2a\xcd\xe0\x44\x2d\xb2\x4c\x13\xe8\x03\x7d\x88\xb3\x4d\xb2" +
+	"\x6d\x14\x83\x26\x5e\xd4\x17\xa4\x0c\x4a\x2e\x15\x9a\x40\x7a\xcd\x45\x53\x73\x91\x32\xc8\x8b\x0a\xc8\x36\x7b\x02" +
+	"\x99\x57\xde\x6c\xf0\x17\x7b\xd5\xe9\x76\x10\xe1\x89\x06\x40\x4a\xde\x4e\xce\x26\x41\xd4\x03\xd4\x34\x4c\xc6\x5c" +
+	"\x1e\xea\xbe\x00\x37\xe9\x96\xe0\x60\x82\xab\xfb\x50\x15\x92\x48\x4e\x9f\xd0\x83\x90\x70\x5f\x70\x31\x7c\x23\xe5" +
+	"\xda\x71\x3b\xa1\x1f\x5d\x84\xc1\x02\x82\xf7\x5e\x38\x7a\xe7\x85\xdd\x99\xf7\xd1\xed\x78\x0b\xe0\x44\xf7\xff\xb2" +
+	"\x4f\xe7\xad\x7f\x36\x09\x38\x67\x63\x7f\x34\xf5\x42\x1f\x4e\x56\x45\x42\xa5\xb0\x15\x35\xc7\x2f\x5e\xbc\x70\x6d" +
+	"\xa8\x3b\xfa\xc8\x9e\x86\x2a\xd6\xb4\x24\x68\xc7\x7b\xa1\xd0\x34\x96\x18\x42\x2c\xa8\x2d\xa0\x35\x29\xab\xd4\x46" +
+	"\xf5\x92\x2f\xc8\xc1\xa4\x43\x32\x88\x1f\xc2\xcc\xfb\xd8\x1d\x79\x0b\x1f\x3e\xbc\xf3\x03\xd0\xe1\x4a\xac\x34\xdc" +
+	"\x8e\x40\xca\xc2\x00\x22\x04\xe7\x6e\x07\xfc\x60\xec\xf6\x3a\xea\x06\x80\xc9\xf7\xd3\x0b\x58\xae\x1f\x9e\xb9\x40" +
+	"\x2d\xb2\x67\x71\xd0\xe6\x7e\xb7\x17\x90\xf8\xb9\xfb\x40\xc7\x36\xa8\x6d\xbe\xc6\x79\x2f\xa0\x3e\xbc\xf3\x43\xbf" +
+	"\x36\x7a\x34\xf3\xa1\xbd\x49\x6c\x81\xd7\xba\x1b\x42\x45\x3e\x67\x82\xb8\x3d\x2b\xb2\x8a\xdc\xd0\xa5\x00\x7d\xfe" +
+	"\x9a\x52\x3a\x5e\x30\x96\xab\xb0\xf8\x96\xae\x08\x0a\x01\x29\x70\x04\x51\x93\x53\x18\xcd\xbd\xa9\xbf\x18\xf9\x5d" +
+	"\x6e\x0a\x2a\x4a\xbb\xf0\xfa\x8d\x14\x97\x03\xf3\x90\x6f\xc0\xc9\x69\x97\x6b\xb3\x07\x8e\xe3\xc2\x64\xc1\x2f\xe2" +
+	"\x4d\x83\x27\x75\x55\xec\x4f\xfe\xdf\x62\x25\xdc\x96\x6f\x4d\x37\xa8\x82\xf6\xf0\x0d\x9c\x7b\x61\x34\x41\x7d\xc0" +
+	"\xdb\x4b\x1e\xd4\x2d\x81\xd5\x76\x3c\x34\x88\xad\xaf\x1e\x01\x86\x4f\x41\xd4\x11\x38\x9f\x1c\x38\x82\x69\x14\x4e" +
+	"\x66\xdd\x90\xff\x3f\x57\xab\xcb\xef\x5d\x39\xa6\x9c\x17\x51\x38\x09\xce\x96\x8b\xf3\xe9\x24\xd2\x9c\xf5\x1c\xa9" +
+	"\x7d\xa1\xd7\x36\x44\x28\x19\x29\x3f\xc9\xe6\xc8\x5b\x44\x5d\xa7\x2b\x29\x2e\x07\xff\xd5\x83\x70\xfe\x61\x19\x5c" +
+	"\xcc\xde\xfa\x61\xd7\x85\xf9\x7b\x3f\x84\xae\xc5\xa6\x03\xde\x96\xf7\x42\x0a\x6b\xb6\x94\x02\x01\xe9\x86\x79\x38" +
+	"\xf6\x43\x94\x4e\x39\x48\xbe\x2c\x4d\x97\x3a\xf6\x17\xa3\x9e\xb8\x5c\x16\x5f\xd4\x15\x17\xb1\xe3\x25\x92\xdf\x39" +
+	"\x36\x2a\x6e\xd4\x9f\x50\x63\x57\x03\x2e\x2b\x43\x7b\x28\x22\x28\xad\x07\xb4\xc4\x1d\x69\x75\x0d\x0a\x5e\x0f\x01" +
+	"\xd1\x70\x19\x58\xde\xde\x60\xd0\xe5\xea\xb1\x08\x71\xe1\x41\x62\x7c\x18\x48\x4a\x61\x08\xc7\xbb\x16\x47\x43\xd6" +
+	"\x3f\x2f\xce\xcf\xfd\x10\x5d\x55\xd4\x7d\x10\x4c\x28\xfb\xd4\xce\x40\xdb\xc3\x95\x25\xe8\xe3\x17\xae\xcb\x89\x71" +
+	"\xa4\x89\x3b\x63\xc7\x41\xfb\x71\xdc\x8e\x1f\x8c\xff\xea\x64\x45\x27\x4e\xe7\xe1\x7c\xe4\x8f\x2f\x42\x5f\x17\x07" +
+	"\x7a\xf3\x8a\xf4\x89\x24\xc9\xf7\x49\x9f\x66\xe4\x8e\x02\xc9\x6b\xef\xd2\xcc\x0c\xea\xdc\xd3\x4a\x6c\xba\xb1\x9d" +
+	"\x9f\x37\x93\x11\xb9\xe7\x5d\xc0\x02\x42\x14\x38\x24\x83\xda\x81\x8b\xfb\xed\xf9\x6e\x57\xe6\xb9\xee\x20\xba\xa5" +
+	"\x2d\xa8\xad\xfc\x2d\x65\x40\xee\x49\x9a\xa1\xe7\x83\x54\x24\x83\xf7\x29\xfd\xa2\x0d\xff\x35\xfe\x2b\x3c\xe3\x9b" +
+	"\xa5\xcc\xfd\xae\x38\x59\x84\x35\x91\x63\x56\x93\x3f\xd6\xa9\x0a\x72\x7d\x03\xa8\xb9\x01\x4f\x78\x49\x45\x06\x1e" +
+	"\x77\x76\xb6\x44\x4a\x7a\xcf\x0b\xa6\xaa\x00\xac\x79\x5a\xd2\x33\x4f\xc9\x94\x81\xde\xfa\x34\xc1\x9a\xea\xba\xa4" +
+	"\xbf\x6d\x68\x1e\x3f\x42\xf7\xb8\x07\x3f\xbd\xc0\x44\xfe\xa7\x7f\xfd\xe2\x42\x4c\x72\xcc\xd7\x3e\x53\xa9\x96\x3e" +
+	"\x72\xd7\xc4\xac\x05\x71\x47\xd7\x95\x92\x41\x91\x53\xa8\x39\x87\x62\x2b\x17\x7c\x56\xca\x87\xc8\x75\xda\x27\xbf" +
+	"\x3b\xb3\x3f\x25\x8e\x25\x8a\xa3\x99\xfc\xf4\xd0\xc4\x61\x57\x5d\x67\x86\x89\x3a\x07\x44\x49\x76\xec\xc8\xbf\x94" +
+	"\x86\x53\x23\x7f\xf9\x6f\x57\x92\x35\xda\x63\x53\x35\xd2\xbc\x90\x77\x58\xe7\xbb\xa5\x91\xec\x06\xc0\x96\xd1\xcf" +
+	"\xdf\x98\xfa\xad\x52\xc6\x2d\x74\x5b\xdc\x36\x94\x12\xad\x01\xc5\xb3\x68\x0b\x88\xfd\x96\x6d\x53\xc5\x81\x74\x50" +
+	"\x9f\x16\xc5\x1d\x96\x29\xd5\xad\x65\x5a\x58\xe5\x40\x23\x23\x51\x31\xfd\x9f\x96\x04\x35\x33\x18\x7e\xa7\xd6\x12" +
+	"\x7e\x43\x6f\xb2\xf0\xc3\x70\x1e\x42\xd7\xd1\xfb\x16\x3e\xfd\x30\xf9\xd7\xcf\xc9\x15\x24\x05\x15\x85\x14\x7d\x48" +
+	"\x59\xe5\xf4\xe0\xf8\xb8\x07\xc7\x3d\x9b\x22\x95\x59\xea\xcc\xe1\x25\xbf\x80\x91\x47\x0b\x34\xe4\x4e\x43\xf9\x0c" +
+	"\xe5\x74\x52\xca\x14\xb1\x2a\xd6\x99\x7b\xcb\xca\xc9\x5a\x68\xf7\x3f\xfa\x23\xe1\xb0\x12\xfa\x79\x73\x03\x27\x27" +
+	"\x18\x81\x26\xe3\x1e\x38\x3b\xd7\x73\x5a\x9e\x6e\x4b\x84\x49\x92\xd8\x5c\xf6\x9a\xcd\x2a\x91\x55\x6a\x84\x63\x7f" +
+	"\xea\x47\xfe\xfe\x04\x5b\x03\xff\x0e\x4d\x2b\x6d\xd7\xc4\xa5\x09\x4c\x02\x50\xa9\x59\x9a\x98\x6b\x2a\x20\xb9\x80" +
+	"\x50\x7f\x00\x5d\xbb\x0e\xe9\xed\x2c\x1b\xdc\x5a\x9d\x0b\x3f\x12\x7b\x05\xcb\xa4\x70\x7e\x0e\xef\x27\xfe\x07\x54" +
+	"\x96\xff\x71\xb2\x88\x16\x7b\x12\x2e\x1d\x7d\xb6\xc4\xcd\xd6\x4b\xfa\x40\xe3\x4d\x45\x11\x2d\xe2\xd6\xf7\x85\xed" +
+	"\x34\x2d\x27\x6a\xf4\x31\xd8\x9a\xe4\x0c\x48\x96\x19\x6a\x15\x01\x83\x51\x58\x6d\x18\x0f\x23\x32\x36\x30\xb2\x32" +
+	"\x77\xb0\xb2\x36\xc9\x80\x92\xe0\x71\x9b\xd2\xa4\x00\x9b\x7a\xe1\xca\xa8\x23\x19\x6a\x82\x07\xb3\x1e\x0f\x65\xee" +
+	"\xef\xde\x64\xe9\x9e\x30\x89\xc1\x7b\x2b\x36\x3e\x77\x17\xfe\xdc\x94\xe5\xe8\x96\xc6\x77\xcd\x1c\x07\x17\xd9\xce" +
+	"\x57\x98\xd8\xf2\xaa\x67\x63\x39\x3a\xe5\x89\xcd\xd2\x45\x5d\xc3\xc2\x45\xd7\x2d\x66\xa1\xc1\x06\xb2\xd4\x90\x74" +
+	"\x72\x89\x2b\x05\xf0\x5b\x6d\x05\xcc\x96\x3b\xd0\x85\x8c\xfa\xd6\xcd\x48\x75\xc5\x24\xb4\x11\x4b\x85\x4a\x1b\x79" +
+	"\xb5\xbc\x5d\xfb\x9b\x79\xc4\x7d\x8e\x0b\xcc\x74\xcb\xc1\x3c\x6a\x58\x8e\x4d\x8e\xf9\xa9\x4d\x4a\x60\x5f\xae\xc8" +
+	"\x7a\x9d\xe6\x37\xcb\x7b\x88\x77\x3e\x25\x08\x8c\x07\xcf\xf5\x0b\x5b\x5f\xb4\xcc\x78\xd0\x1e\x16\x14\x4c\xf3\x23" +
+	"\x9e\x92\x54\x72\x87\x01\xc3\x96\x9a\x53\x6b\x10\x4b\xae\x2b\x47\x86\xcb\xc9\x69\x6d\x10\x86\xe8\x9e\xd8\x05\x23" +
+	"\x69\x64\x9f\x7e\x60\x18\x65\xea\x18\xa3\x0c\x4e\x46\x5c\xbc\x6f\x18\xbc\x5c\xa8\x67\xb8\x9b\x86\xdd\xff\x64\xdb" +
+	"\x3d\x77\x62\x2b\x76\xc3\x9d\x18\xba\x3c\x68\x2d\x10\x71\x1f\x88\x1b\xa6\xc9\x71\x4e\x8d\xbd\xc1\x21\x6a\xa3\x6f" +
+	"\x37\xa0\xba\x26\xdf\x1d\xa0\x4e\x56\xec\xa6\x53\xcb\x08\xa2\xd0\x0b\x16\xde\x08\x1d\xb1\x7d\xf9\xf2\xcf\x8c\x57" +
+	"\xc2\x7f\xfc\x01\x8c\x5b\x31\xc4\x92\x95\x46\x6b\x44\xf3\x27\x77\x98\x7e\xe8\x9b\x39\x6c\x8b\x61\xbd\xe6\xd6\xd7" +
+	"\x0b\xf9\xd3\x85\x6f\xad\xfa\xfb\xc2\x76\xbd\x49\x7f\xcf\x16\xfd\x63\xe1\x7b\x77\x8f\x4f\xee\x41\xe5\xdf\xbb\x21" +
+	"\xed\xbb\x71\x49\xb1\x48\x6a\xf6\xff\x79\xad\xa8\xab\x5c\xb4\x7f\xe5\xeb\x35\x12\x49\x92\xce\xa2\x4d\x0f\xaf\xae" +
+	"\x6d\xb5\xa6\x1e\x06\xb8\x27\x0c\xe7\xd1\x79\x8e\x0b\xec\x7c\xa3\x30\x77\x67\xbd\x0a\xa2\x6e\x2b\x15\x65\x92\xe6" +
+	"\x24\x5b\xae\x0b\xc6\x63\x6b\xa7\x2d\xb1\xe1\xfd\x16\x47\x34\x30\xb0\xed\xe7\x4d\x23\x3f\x14\x89\xce\x33\xd2\x1b" +
+	"\xf0\x16\x2a\xd8\xb4\xf4\xbe\x6a\x9a\xd4\xe7\xa8\x2e\x50\x64\x73\x49\xf6\xae\xda\x3b\x67\x75\x31\xae\xa6\x4f\x5d" +
+	"\x4b\x32\x3d\xbe\x87\xdc\x4e\x73\x03\x35\xfc\x8d\x99\x6a\xed\x4b\xc5\xfc\x60\xac\xbd\x0e\x9a\x94\x48\x5b\x6e\x49" +
+	"\x9e\x64\x69\x7e\x63\xb8\xa6\x91\x17\x8d\xde\x69\x94\x93\x53\x38\x39\xa9\x4a\x92\xc7\xc5\x26\xaf\xe0\x0d\xbc\x80" +
+	"\x70\x3e\x9d\xbe\xf5\x46\xff\xb1\x7c\x9b\x82\x37\xbc\x32\x5f\x61\x39\xf3\x17\x0b\xef\xcc\xef\xba\xad\xe1\x62\x46" +
+	"\x78\x9d\x4b\x1a\xb9\x13\x77\x00\x70\x4d\xd2\x8c\x26\xaf\xe0\x07\xb6\x23\x39\x12\x0e\xd7\xc0\x2d\x52\xa4\xe3\x17" +
+	"\x9a\x67\xc1\x0e\xff\x39\x9a\xcf\x66\x93\xa8\xe9\x92\x77\x4b\xd7\x19\xcf\x03\xdf\x31\x9a\xa7\x7f\x4f\xdb\x8d\xdb" +
+	"\x6a\xb3\xeb\xa6\xba\x37\xdf\x7d\x52\x89\x44\x30\x5a\x41\x49\x71\xc4\x95\x80\x1c\xa1\xcb\xac\xfd\xb1\xde\xf4\xb0" +
+	"\x22\x55\x7c\xab\xa6\x96\x09\xa9\xe8\x8f\x55\xba\xa2\xbd\x66\x03\x08\x67\x91\xf2\x79\x31\xbe\x37\x06\x93\x1a\xef" +
+	"\x5b\xff\x74\x1e\xfa\x9c\x84\xba\x77\x8b\x58\x61\x5d\x16\xf7\x69\x42\x93\x96\xa6\xd5\x58\x9c\x54\x60\x48\xa5\xf2" +
+	"\x9b\x9a\x92\xc1\xe4\xda\x1e\xfa\xa5\x4c\xe3\xea\x89\x36\x9a\x02\x6d\x62\x16\x63\x59\x92\x62\x3f\x0c\x47\xd6\xc5" +
+	"\x3d\x2d\xcb\x34\x49\x68\x3e\x38\x2d\x4a\x69\xc2\x5a\x16\xac\xc5\x73\xf3\x89\xe1\xde\xf1\xea\xa3\x35\x0e\xed\x32" +
+	"\x4a\x5b\x9c\x87\xbb\xa3\x13\xb6\x61\xb4\xb4\xbb\x2f\x2f\x7f\x51\x6d\xac\x0b\x75\xaf\xb8\x06\x5e\x95\xb0\xaa\x28" +
+	"\x3b\x27\x1c\x5c\xd1\xa1\x3f\x2d\xcf\x07\xf2\x59\xcd\x5f\xe7\x84\x3e\x54\x88\x33\x53\x7d\x37\xf1\xdd\xea\xbe\xd5" +
+	"\xcd\x37\x05\x8e\x22\x26\x90\x56\x74\x25\x4e\x01\xa4\x39\x78\xde\xc8\xdd\x1a\xc8\xa2\xca\x4c\x3e\x5c\x39\xc6\x1d" +
+	"\xe3\x75\x56\x95\x68\x64\xdd\xcb\xcb\xcb\xcb\xfe\x6c\xd6\x1f\x8f\x3f\xbd\x7b\xf7\xf2\xe7\x57\xb3\xc9\xab\xc5\xe2" +
+	"\xca\xc5\xd5\xe4\x30\x56\x91\xf1\xf4\x07\x09\xd5\x4a\x50\x93\xed\x96\x29\xb1\xfe\xea\x71\xf1\x36\xa3\x1a\x4b\x1f" +
+	"\x3e\x67\x24\xbf\xfb\x51\xf5\xf1\xa4\x7d\x7f\xe6\x93\xfe\x22\x07\xed\xc6\x9e\xfe\x22\x76\x5b\x38\xb2\x15\x88\x0e" +
+	"\x38\x98\x8f\xe6\x17\x41\x04\xf3\x80\xff\xf4\x82\xc5\x64\xf9\xc1\x0b\x83\x49\x70\xb6\x80\xf9\xe9\xe9\x77\xe8\x17" +
+	"\x5a\xb1\x5d\xab\xf1\x97\xed\x2e\x5f\xdd\x5e\x03\xd8\xd7\x33\x6c\x19\xeb\xdb\x00\xd8\x86\xd8\xd3\x28\x44\x81\x29" +
+	"\x51\xc2\xd8\x8b\xfc\x68\x32\xf3\x6d\x10\xdc\x3c\xbb\xb0\xf3\x9d\xa7\x1e\xb7\x97\xd1\x01\x76\x41\x2b\xd9\x68\xe4" +
+	"\x8b\x5d\x17\xa5\xd2\xb7\x52\x5b\x5d\xc6\x70\x08\x23\x27\x1b\xcd\x83\xf7\x7e\x18\x75\x15\x65\xbd\x2d\x5d\xf7\xe0" +
+	"\xf8\x25\x6e\xe4\x33\x3f\x42\x90\xae\xab\xd7\x85\xaf\x30\x2a\xb2\x8c\xc6\x15\xf7\x13\x7c\x6f\xc9\x5b\x47\x70\x46" +
+	"\x2b\xbb\x85\x8e\xfc\x69\xeb\xd3\x39\x9e\x4a\x16\x2d\xb0\x21\xe8\x84\xcd\xc8\x6a\x15\x84\x59\x4d\xcb\xd4\x96\xbb" +
+	"\x12\x93\x2c\xde\xa3\x40\xa9\xe2\x29\x13\x5e\xf0\x15\x32\x43\x45\x23\xd5\xf2\x1e\x0a\xd4\xfc\x27\x89\x63\xca\x58" +
+	"\x57\x3b\xb2\x46\xf4\x77\x30\xa1\x73\xdc\xe6\x78\x58\x62\xeb\xc1\x0b\x17\x86\xf0\x02\xea\x04\x47\x6f\xb2\x7e\xff" +
+	"\x88\xf7\x83\xa5\x96\xf0\x89\x2e\x66\xd1\xc5\xa6\x12\x79\xa2\x41\xa6\x8b\xe5\x6b\x51\x26\xb4\xc4\x30\x92\x15\x37" +
+	"\x50\xd2\xaa\x4c\xe9\x3d\xc9\xf0\xe8\x01\x5d\xad\xb5\x6b\x51\xd2\xab\xf9\xd1\x82\xb3\x64\xf7\x09\x01\xae\xf4\x1d" +
+	"\x21\x3b\xed\xb1\x87\xb5\xf7\x36\x4b\x81\x23\x98\xe4\x78\x2e\x8a\x53\x2c\xb2\x23\xc8\xd2\xfc\x0e\xc9\xc3\x90\xb2" +
+	"\x61\x40\xcb\xb2\x28\xf5\x23\xe6\xd1\x3e\xb1\x2c\x82\xd7\xcb\xca\x4f\x57\x39\x63\x2e\x33\x7b\x66\xda\x03\x2d\x4c" +
+	"\xb1\x84\xd5\x94\x7a\xef\x4d\x2f\xfc\x45\xd7\x74\xfe\xa6\xb5\xf2\x5f\xfa\xf9\x97\x6e\xa7\xd3\xcc\x03\xbb\x0e\x06" +
+	"\x23\xd5\x32\x90\x8d\xe9\x5b\x72\x4f\xb7\xd5\x00\x45\xde\x4c\x15\x8d\xcc\x70\x87\x99\x3c\xdd\xc7\x3e\x82\x68\x3e" +
+	"\x9e\xbf\x02\x4e\x4e\xff\x9d\x17\x8c\xa7\x93\xe0\x4c\xdd\xfd\xaa\x37\xce\xcc\xfb\xb8\xf4\xce\xfc\xe5\xd8\xbb\x5c" +
+	"\xd4\x75\x9f\xd8\xdb\xe9\x2a\xd5\xe7\xb3\x6a\xe3\xf8\x4c\x62\xae\x1c\x9e\x41\x58\x3e\x64\x45\x1e\x96\x78\x56\x22" +
+	"\x21\x8f\x0c\x1a\x1e\xc6\xbc\xbb\x8c\x9b\x1e\x53\x59\xd9\x16\xdc\x50\x1c\xa8\xeb\x6c\x1b\x5b\x2d\x0e\x4d\xf5\xb7" +
+	"\x4d\x26\xea\xc7\xa5\x99\x3a\xa6\x4c\xe4\x8c\x02\x8f\x97\xc2\x82\x56\xea\x94\x28\xfc\x77\xbd\xcd\x4d\x82\x61\x28" +
+	"\xef\xf0\x07\xe6\x22\x7d\xa2\x62\x60\x4b\xe2\x6a\x43\x32\xd9\x40\x4c\xaf\xf1\x3f\xd2\x04\xf2\xcd\x8a\x96\x69\xac" +
+	"\xf6\x3b\xe7\x2d\x46\xc7\xb2\x94\x77\xba\x5b\x12\x41\x4f\xa9\x9d\x40\x1b\x01\xbc\x58\xdb\x7a\x08\xbc\x05\x6a\xc4" +
+	"\x7d\xa2\x3a\xb0\x9e\xe2\xb0\x92\x19\x61\x30\x33\xef\x63\x1d\xfa\xd3\x84\xb3\xf5\x49\xb8\xfa\xfe\x16\x19\xaf\x87" +
+	"\x20\xaa\xfb\xa4\x5a\xc1\xeb\xa1\x08\x08\x57\x78\xfa\x2d\x2f\x80\xad\x69\x9c\x5e\xa7\x71\x8d\xdd\xc4\x6a\x24\xac" +
+	"\xf2\xfe\x11\x36\x61\xfc\x57\x10\xe9\x83\x69\xfa\x98\xa6\xd6\x2a\xab\x37\x1a\xb9\xa1\x3d\x60\x05\x4f\x76\x29\x29" +
+	"\xb3\x94\x96\xf5\x02\x5f\x52\xec\xc6\xab\x2e\x71\xa4\x22\xdf\xb0\x25\x09\xdd\x5d\xc1\x5a\x8e\x59\xa1\x36\x9c\xb3" +
+	"\xb2\x2f\x6d\xd4\x06\x8c\x3c\x26\x65\x6e\x62\xd3\xae\x15\xe4\x1f\x69\x3b\x74\xa5\x57\x43\x99\xc3\x5b\x3f\xfa\xe0" +
+	"\xfb\x01\xb4\xeb\x09\xc1\xf9\x1d\x13\x87\x6c\x4e\xd8\x78\x94\x12\xf9\x4c\x41\x4a\xcd\x68\x8f\xb9\xee\x53\xa5\x67" +
+	"\x93\xe4\x3d\xb0\x86\xc0\x4c\x3b\x14\x11\xdf\xe8\xba\x36\x42\xfc\x56\xcb\xc5\xfc\x69\x9f\x5b\xb3\x12\xb3\x7f\xc0" +
+	"\x18\x94\x7b\x8c\x7d\x4e\xbb\xce\x76\x54\x63\x06\xbd\xb4\x3d\xf7\xb5\x5c\x6e\x49\x63\x18\x5d\x84\x8b\x79\x08\xa7" +
+	"\xf3\xd0\x94\x92\xd1\x03\xb3\x84\x82\xb9\xd5\xb2\x7a\x5c\xdb\x57\xe3\x5b\x52\x92\xb8\xa2\xe5\x72\x45\x1e\xd2\xd5" +
+	"\x66\xb5\xcc\x68\x7e\x53\xdd\x5a\x40\xd2\x5b\x2d\xd7\x25\x8d\x53\x0c\x6d\xad\x77\x59\x4c\xb2\xa6\x80\xdb\x5b\x6e" +
+	"\x42\xb2\x5b\x12\x93\x6a\x13\xbf\x9b\x92\x55\x99\xc6\xb6\x36\x9f\xe8\xb4\xa1\x70\xe7\x6b\x9a\xe3\xf8\x8e\xc9\x5c" +
+	"\x63\x7e\xee\x07\x78\x52\xb9\x86\x38\x2f\xf1\x68\x0d\x85\x6e\x42\xae\x2b\x98\x2d\x60\xf1\xbf\x53\x17\xee\x49\x99" +
+	"\xe2\x6a\xcc\x0e\x77\x86\x88\xeb\x58\x77\xfc\xf2\xdf\xcd\xb4\x5d\x48\x5c\xb2\x52\x7f\xf7\x3c\xb4\x4b\x21\xd0\x8c" +
+	"\xba\x0d\xb5\x40\x34\x09\x2e\x77\x82\x71\xfd\x28\x0a\x64\xba\xb5\x05\x1a\x17\x46\x1d\xf2\x8b\x71\xa0\x75\xab\x51" +
+	"\xb9\xa7\xd9\x28\x25\x0a\x5a\xa4\xb6\x44\xb3\xa2\x58\x73\x90\x53\x3f\x1a\xbd\x83\xc0\xff\x18\x09\x63\x41\x6d\x48" +
+	"\x9a\xe6\x96\x84\x7b\x86\x20\x7b\xbb\xe5\xd3\x6b\x91\x87\x71\x8d\x33\xaf\xd4\xfd\x15\x33\xe9\x35\xef\x80\xe8\xfd" +
+	"\x66\x0f\x7c\x70\xf7\x59\xde\x7a\xb7\x3b\x73\x6a\x5c\x5b\x2f\x4d\x88\x56\xdc\x87\x77\x93\xa9\x0f\x27\x27\x9c\xe1" +
+	"\xe5\x22\xf2\xa2\x8b\x85\x8e\x23\xdb\x39\xfe\x57\x98\x79\xe7\xaf\xea\xd2\x9a\x47\x31\xd4\xca\x50\x5f\xc2\xff\xe1" +
+	"\x39\x5c\xeb\x82\x7a\xda\x52\x06\x0c\xdf\xd4\x17\x7e\xc6\x43\x2a\x90\x6c\x28\x96\x04\x67\x17\x93\x1f\x3d\x6f\xa4" +
+	"\x66\xba\xbc\xaf\x4a\x31\x74\x35\x90\xf2\xe3\xca\x86\x25\x0f\xc1\xc9\xef\x49\x89\x5a\x10\x07\x07\x77\x2a\x04\x86" +
+	"\xd0\x3f\x16\xa7\x7f\x1d\xd9\x63\x3e\xb2\xb7\xce\x51\x9b\x01\xc1\x36\x58\x2b\x9f\xaf\xc5\xfb\x18\x48\x12\x7b\x83" +
+	"\x7c\xf6\x21\x28\x40\x3a\x19\xe8\x37\x9e\xc1\x71\xcb\x6e\xac\x3a\xb7\x56\xde\x20\xa4\x6b\x4a\x2a\xdb\x72\xaf\x69" +
+	"\x15\xdf\x2a\xa8\x5d\xd6\xfb\xb7\x18\xf0\xfe\xe8\x1a\x17\x59\xa7\x39\x60\xe0\xdb\x57\x9d\x24\x45\x83\x6a\xee\x5c" +
+	"\xf9\x10\x56\xa6\x4d\x6b\xdd\x85\xcb\xe9\xb5\x39\x01\x4b\xa8\x7b\xb6\xce\x28\x2b\x18\x9e\x88\xc4\x57\x9e\x48\x96" +
+	"\x15\x31\x26\x23\xc2\x4f\x8b\xcd\x33\x9a\xce\x17\xbe\x96\xee\xd8\xf7\xa6\xd3\xf9\xc8\x8b\xfc\xda\x79\xeb\x2d\x6d" +
+	"\x94\x9a\x58\x34\x76\x9e\x59\x4f\x7e\x5b\x2d\x29\xea\x48\x78\x66\x21\x79\xac\xdd\xe2\x57\x38\x4d\xf3\x94\xdd\x72" +
+	"\x9e\xe5\xe0\x03\xcd\x8b\xe3\xe7\x49\x05\x14\x9b\x6a\xbd\xa9\x2a\x79\xa0\x4e\xbe\xbf\x81\x41\x08\xba\xba\x4d\xcb" +
+	"\xa1\xdd\x6f\xc9\x73\x4d\x12\x75\xbe\xdb\xcc\xf8\x3a\xb0\xdf\x84\xf6\xce\x8b\x04\x3a\x53\xc8\xd6\x47\xa3\xd0\x81" +
+	"\xdb\x96\x3a\x78\x78\xec\xba\x3e\x74\xed\x2d\x46\x3b\x6d\xcc\x9c\xa2\xee\x27\x95\xcf\xcc\xad\x0c\x03\x47\x68\x6a" +
+	"\xae\x66\x64\x18\x78\x15\x8c\x41\xde\xd6\xf7\x08\x1c\x91\xbc\x6c\x11\xfd\x9c\x93\xda\x3b\xb8\x68\xfb\xb6\x0a\x69" +
+	"\xbf\x40\x9e\xdc\x6d\xbe\xb4\xb7\xdf\x36\xf6\x3b\x41\xeb\x12\xc7\xd4\xbc\xf9\xc6\x68\xe5\x74\x9e\x39\xc3\xdb\x35" +
+	"\xbf\xfb\x5b\x86\x4e\xd6\x4b\xa1\xe3\xe2\x4b\x7e\x18\x43\xfd\xbf\x1f\x43\x1d\xe6\x42\x87\xb9\xd0\x61\x2e\xf4\x97" +
+	"\xce\x85\x0e\xf3\x9e\xc3\xbc\xe7\x30\xef\x39\xcc\x7b\x0e\xf3\x9e\xc3\xbc\xe7\x7b\xcc\x7b\x2c\x2f\xf8\xcf\x1b\xae" +
+	"\x7c\xcb\x6c\xe5\x29\x35\x34\xd6\xdb\x03\x6b\x70\x7b\x98\x8c\x1c\x26\x23\x87\xc9\xc8\x61\x32\x72\x98\x8c\x1c\x26" +
+	"\x23\x87\xc9\xc8\x61\x32\x72\x98\x8c\xec\x9e\x8c\x74\xf6\xa8\xf4\x4f\x6c\xff\xff\x0d\xad\xff\x43\xdb\x7f\x7f\xdb" +
+	"\x7f\x4f\x67\xcc\xd0\xbf\xe3\xa8\x3f\x0a\x68\x1b\x84\xf1\xfa\xb8\xf9\x56\x55\x35\xb0\xf5\x5f\xbf\x57\xe5\x74\xcc" +
+	"\x84\x6d\x47\x46\x0c\x55\x47\x65\x6d\xbb\xdf\x79\xc5\x80\x30\x7f\xfb\x3f\xfe\x28\x5a\x4e\xc6\x5d\xe7\x59\xcb\xe3" +
+	"\x0b\x6e\xef\xb7\x5e\x54\xdc\x25\xfd\x5f\x3b\x9c\x37\x3d\xfe\xd0\x7f\x35\xb0\xed\x8f\xdf\x28\xe8\x27\xfe\xc4\x20" +
+	"\x82\x19\x6f\x25\x36\x31\xea\x72\xfb\x4a\x49\xa0\x2e\xc0\xdb\xdf\x2e\xd4\x38\xf4\x93\x8d\x3f\x10\xd0\x78\x5d\xf4" +
+	"\xf9\x7f\x31\x60\x07\xbd\x0d\x2a\xff\x84\xc5\x3a\xbf\x76\xfe\x6f\x00\xa3\xfd\xc7\xda\x98\x54\x00\x00")
+
+func bindataMigrations20190610093000DeltadeliverysqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190610093000Deltadeliverysql,
+		"../migrations/20190610093000-Delta_delivery.sql",
+	)
+}
+
+
+
+func bindataMigrations20190610093000Deltadeliverysql() (*asset, error) {
+	bytes, err := bindataMigrations20190610093000DeltadeliverysqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190610093000-Delta_delivery.sql",
+		size: 21656,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792402575, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190617094500Scd2sql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x3a\xff\x6f\x9b\xc8\xb3\xbf\xf3\x57\x8c\xf4\x74\x02\x5e\x70\x2e\x49" +
+	"\xef\x9e\x9e\x7a\x75\x15\x62\xd3\xd6\xef\x1c\x88\x30\x6e\x1b\x55\x91\x45\xcc\x26\xe6\x05\x83\xcb\xe2\xe4\x22\xf5" +
+	"\x8f\xff\x68\x66\x97\x65\xc1\x38\x49\xab\xeb\xe7\xf4\x71\xa4\x36\x86\xd9\x99\xd9\xf9\xfe\x25\xc6\x60\x00\x07\xeb" +
+	"\xf4\xb6\x8c\x2b\x06\xf3\x8d\x31\xf1\x67\x5e\x18\xc1\xc4\x8f\x02\xf8\xb2\x66\x55\x7c\x75\xf8\x25\xae\xaa\x32\xbd" +
+	"\xde\x56\xec\x0a\xac\x3c\x5e\x33\x07\x12\xc6\x97\x65\xba\xa9\xd2\x22\xc7\x2f\x37\xf1\x36\xab\x16\xf7\x71\xb6\x65" +
+	"\x0e\x14\xf4\x98\xdb\xc6\xcc\x9b\x7a\xa3\x08\xcc\xd9\x68\x7c\x62\x3a\x60\x9e\xc7\x69\x5e\xc5\x69\x0e\x3c\x2b\x1e" +
+	"\xb2\x47\x58\xae\xe2\xfc\x36\xcd\x6f\x21\x49\xd7\x2c\xe7\x69\x91\x83\x55\x3d\x6e\x18\x9c\xd8\xb0\x4a\x79\x55\x94" +
+	"\x8f\x70\xfd\x08\x67\xf3\xd9\xc4\xf7\x66\xb3\xc5\x9f\xde\x25\xa4\x39\x7c\x29\xd9\xa6\xb8\x3a\xfc\xf2\x06\xff\x87" +
+	"\x2a\xbe\xce\xd8\xdb\x05\x5f\x26\x27\x57\xf0\x90\x56\x2b\xb8\x8f\xb3\x34\x59\xdc\x94\xc5\xfa\x57\xf1\x6b\x55\x00" +
+	"\x7e\x83\x6a\xc5\x20\x61\x59\x7a\xcf\xca\x47\xe0\x55\x5c\x6d\x39\x24\x71\xc5\x90\x37\x3f\x10\xff\x3a\x97\xde\xcc" +
+	"\x34\xfe\x30\x46\xa1\xe7\x46\x9e\x71\x11\x06\x23\x6f\x3c\x0f\xbd\x5a\x14\xf5\x79\x49\x70\x30\xf8\x66\x0c\x06\xdf" +
+	"\x60\xf8\xd3\x3e\x84\x7e\xdc\x88\xfb\x35\xb8\x9b\x4d\xf6\x08\x71\x73\x95\xcd\xf6\x3a\x4b\xf9\x8a\x25\x50\x15\x40" +
+	"\x42\x29\xf2\xaa\xa0\xeb\xa2\xe8\x95\x2c\x8b\x1b\x7a\x16\xdf\x96\x8c\xad\x59\x5e\x11\xea\xd6\xc7\x52\x9a\x16\x27" +
+	"\x87\x70\xe9\xcd\x6c\xb8\x63\x8f\x2c\x81\x22\x6f\xa9\xe2\xf5\xee\x71\x80\x01\xe4\xec\x01\x8a\x52\xe8\x96\x25\xc0" +
+	"\xf2\x2a\xad\x52\xc6\xe1\x96\x55\x10\xd3\xdb\x7b\x56\x92\xae\x49\x39\x8d\x66\x34\x85\xf4\x63\x46\xa0\x4d\xc9\xee" +
+	"\xd3\x62\xcb\x15\x92\xe2\x66\x97\x54\xca\x85\x0d\xc0\x36\xaf\xd2\xec\x65\xc8\xd5\xe1\x75\xca\x39\xda\x64\x9a\xc3" +
+	"\xbb\xf9\x74\x5a\x4b\x19\xf1\x5a\x45\x89\x5f\x59\xc5\x12\xb4\xc3\xb1\x37\x8d\x5c\xed\xbd\xdd\x87\x19\x20\x2e\xd9" +
+	"\xf7\xb1\x13\xad\x98\x52\x19\x59\xf7\x53\x16\x9f\x72\x58\x96\x2c\x46\x96\x8a\x1c\x6e\xd2\x92\x57\xb0\xe5\xec\x90" +
+	"\xd0\xba\xe5\xed\x16\x15\xcd\x5f\x1b\x96\x81\xa8\x4f\xd1\x7b\xc1\xff\xe8\x86\xa3\x0f\x6e\x68\x9d\xfc\x7e\x64\x03" +
+	"\x20\xa4\x8f\xcf\x8b\x9b\xfa\x36\x8f\x60\xdd\xa4\x19\xb3\x0d\xdb\x70\x67\x60\x20\xc4\xe0\xa7\x7d\x8c\x33\xef\xfd" +
+	"\xc4\x27\xfe\xc6\xde\x68\xea\x86\x1e\x9c\xae\xf9\x6d\x2d\x0e\x68\xf8\xfd\xed\xe8\xe8\xc8\x6e\x03\x2a\x63\x5e\xa4" +
+	"\x09\x9c\x4d\xde\x4f\xfc\xa8\x0d\x50\xdf\x08\xdf\xf7\x02\xc4\xdb\x24\xad\xe8\x2d\xf4\x03\x08\xe5\xc9\xcf\x18\xa3" +
+	"\x42\xeb\x75\x16\x57\x8c\x57\x7b\x5f\xa3\x9a\x76\x6f\xf2\xaa\x73\x8d\x75\x91\xb0\x5d\xa8\xe3\xee\x6d\xef\xd8\x23" +
+	"\xef\x03\xdb\x11\x4b\xb1\x61\x65\x8c\xe1\xa2\x0d\x78\xf2\xbf\x1d\x38\x34\xa8\x05\x19\x45\x07\x61\x07\x0e\xed\xb1" +
+	"\xa6\xdb\xc0\x9d\xec\xc0\x2d\x8b\xac\x87\xc1\x73\xf7\x73\x07\x8e\xeb\x80\x4f\xc0\xad\x62\xbe\x82\x17\xc0\xfd\x7f" +
+	"\x91\xe6\x2f\x81\xdb\xc4\x65\x95\xee\xc8\x65\x17\xae\x76\xf3\xe7\xf0\xf1\xaf\x59\x4d\xb5\x0b\x47\x80\xe8\x39\xd3" +
+	"\xa2\xb8\xdb\x6e\xc8\xef\xa5\xad\x28\x2f\xbb\x7e\x04\x12\x7e\x9c\x27\x90\x56\x9c\x82\x37\x4f\xc9\xf3\xc9\x2c\x09" +
+	"\x87\x4c\xa3\x6d\x4b\x1e\x42\x72\x98\x26\x8e\x51\xd3\x06\xe8\xf8\x02\x02\xe8\x0f\xda\xa0\x2d\x9b\x1e\xc2\x28\x70" +
+	"\xa7\xde\x6c\xe4\x59\xc9\xa1\x08\xc3\x0b\x0c\x4d\x0e\x8c\xdc\x59\x64\x25\x87\x32\xc2\x54\x6b\x70\x67\x64\xe2\xb6" +
+	"\x2d\xb1\xbd\x0b\x83\x73\xc0\xd4\x78\xa8\xee\x94\x88\x57\x9f\x3e\x78\xa1\x47\x4c\xc2\x10\x2c\x79\x87\x73\xf7\xb3" +
+	"\x95\x26\x76\xdf\x31\x01\x4f\xd2\x18\x8a\x38\x65\x1b\xad\xeb\x2b\x3f\x1d\xd6\x68\x76\x78\x68\x64\x56\x93\x97\xd8" +
+	"\xd1\xbd\x87\xad\x58\x20\xcf\x82\xeb\x8f\x31\x1a\xdf\x32\x01\xf2\x4a\xd0\x9c\xbc\xd3\xe8\x4d\x66\xe0\xcf\xa7\x53" +
+	"\x7a\xd1\x84\x2a\xfc\x09\xdd\xc9\xcc\x0b\xc3\x20\xb4\xcc\xb1\x44\x0d\x5f\x7e\xe1\x57\x70\x53\x94\xba\x2e\xf3\xa2" +
+	"\x82\xf8\x3e\x4e\x33\x8c\xdc\xa6\x03\xc7\xc7\x0e\x1c\x3b\xf5\x2d\x15\x36\x2f\x9a\x87\x3e\x9c\x10\x21\xcf\x1f\xb7" +
+	"\xaf\xdf\xc4\x11\x71\xff\x91\x3b\xf3\xf0\x96\x3e\xa8\x9c\xbd\x90\xd2\x13\x05\x57\x8d\x56\xfb\x44\x08\x4e\x55\x1a" +
+	"\x78\xfe\xd8\x6e\x9b\x44\x13\x82\x9e\x27\x30\xf6\xa6\x93\x8f\x5e\x78\xb9\x38\x0f\xc6\x9e\xf9\x42\x02\x4d\xf0\x7a" +
+	"\x9e\x80\x5e\x68\x98\xf0\x42\x02\x4d\xd0\x7b\xd1\x0d\x22\x77\x11\x5c\x78\xa1\x1b\x4d\x02\x7f\x31\x0a\xa6\xf3\x73" +
+	"\xdf\xec\x12\xd8\x35\x31\xe5\x52\x0d\xce\x7b\xdd\xe4\x74\x9f\x83\x61\xdb\x29\x9b\x98\xe0\x17\xd5\x0a\xab\x8c\xaa" +
+	"\x80\xa4\x80\x6d\x9e\x31\xce\x81\xe5\x68\x1f\x49\x6d\x81\xca\x29\x49\xf7\x54\x9d\x9a\x36\xbc\x79\x0b\x26\xd6\xa8" +
+	"\xd2\x5c\x94\xbd\xa2\x89\x4e\xde\x59\x24\x65\x07\x4c\xd3\x7e\xc2\x6e\xbd\xcf\xde\x48\x78\xad\x12\xd9\x22\x4e\x92" +
+	"\xc6\xe6\x1d\x38\x71\xe0\xf4\x14\xab\xdf\xc9\xd8\x11\x15\x3c\x94\xec\xeb\x36\x2d\x19\xa7\x48\xa6\x2b\xa8\x11\xaf" +
+	"\xd9\xe7\x1a\x74\x58\xaf\x2d\xc8\x45\x5e\x82\xed\x19\x4f\x79\xd5\xef\x29\x4d\x4e\x1b\x8a\x5a\x89\xbe\x3c\xa1\x49" +
+	"\xe1\xff\x02\xf4\xe5\xba\x94\xbc\x60\x00\x11\x47\xf9\x72\xc5\xd6\x31\x1a\x17\x72\x60\x4a\x9e\x22\x99\x3c\x87\x60" +
+	"\xd6\x65\x9c\x09\x07\x3a\x97\x07\x60\x8a\x6e\xc2\x6c\xec\xe3\x83\xac\xff\x56\x31\xc7\xa2\xfe\x9a\x41\xbc\xd9\x64" +
+	"\xa9\x28\x3c\xb5\xea\x11\x8a\x32\x61\x65\x6d\x03\xc1\xd9\xff\x79\xa3\x68\x31\x19\x5b\x44\xd3\x01\x73\x2e\xed\x20" +
+	"\x88\xf6\xd9\x02\x71\x88\x69\x0c\xe3\x86\x94\x60\xb1\x90\x89\x4a\xf8\x51\xd3\x50\xc9\xc8\x4d\x17\x40\x0a\x0a\x0b" +
+	"\x59\x14\xdf\x2c\xd8\x5f\x6c\xb9\xad\x18\xe2\x3b\xe5\x5f\x33\x07\x7c\xb3\xc1\x86\x99\x03\x82\x79\x64\x3a\x2d\x12" +
+	"\xa7\xf2\xb7\x60\x1e\x29\x7c\x93\x77\xea\xf1\x5b\x99\xad\xd4\xbb\x36\xff\xea\x0e\x58\x32\xca\xd8\x07\xfc\x2e\xdd" +
+	"\x6c\x58\x02\x83\x96\xac\xbe\x20\xdf\xa3\xc0\xff\xe8\x85\x91\xa5\x92\xf5\xf1\x91\xed\x48\x12\x0e\x1c\x9f\x1c\xd9" +
+	"\x70\x00\xe6\x15\x5c\xb3\x9b\xa2\x54\x19\xbb\x6e\x37\x9e\x42\x21\x40\x35\x1c\x8d\x37\xfc\x88\xd3\x61\x0d\xdc\x42" +
+	"\xa0\xb9\xd4\x2f\x5c\xf3\x8d\x35\xbf\x6d\x5c\x43\x73\x8f\xdf\xd4\x43\x4c\x26\x2d\x57\x41\x13\xbb\x28\xd9\x06\x7b" +
+	"\x93\x65\x91\x6d\xd7\x39\x64\x29\xaf\xf8\x6b\x34\xaa\x58\x3e\xe2\x0e\x94\xc5\x03\x50\x09\x86\x05\xca\xf5\x96\xa7" +
+	"\x39\xc6\xa9\x3b\xf6\x08\x58\x70\xfd\xaa\xaa\xa9\x96\xff\x89\xd2\x4e\x2b\x2a\xc4\x93\x03\xc0\x0e\x5b\x84\xa6\x03" +
+	"\x49\x82\x1c\xa0\x1d\xc4\x45\x65\xa8\x9f\xe6\x3d\xc7\x4d\x7e\x68\x3e\x85\x85\x98\x6e\x61\xa1\x27\x02\x89\xf9\xcd" +
+	"\x6c\xe1\xa2\x2a\xa7\x83\x0e\x15\x88\xd5\x8e\xd2\x31\xd6\x7e\xb6\xb9\x13\x45\xe4\x91\x75\xbc\xd9\xa4\xf9\xed\xdf" +
+	"\x1d\x3e\x24\x80\xce\x97\x1f\xe0\x70\x06\x2c\xf3\x4b\xf2\xb0\xd0\x0a\x9a\x2b\xbc\x12\x3e\x2b\x8b\x07\xfa\x2a\x6d" +
+	"\x22\x08\xc7\x5e\x08\x67\x97\x18\x29\xd2\x3c\xce\x16\x54\x95\xa0\xce\x5a\x4a\x6b\x0a\x68\x5d\x68\xf4\x94\x24\xe1" +
+	"\x8f\x35\x81\x55\x22\x86\x4d\xa3\x70\x72\x6e\x85\xf4\x2f\xa5\x4c\x5b\xfa\xce\x10\xf8\xd3\x10\x66\x5b\x5b\x4d\x55" +
+	"\xae\x13\x6f\x9e\x76\x74\xff\x34\x6a\x89\x99\x54\x34\x8b\xc2\x89\xff\x7e\x31\xbb\x98\x4e\x22\x95\x1a\x9d\x5a\x34" +
+	"\x42\x4b\x7d\x88\x30\xc9\xca\x68\x4c\xb1\xa5\x6e\x07\x86\x58\x11\x7b\x3a\xef\xfa\x0f\x55\x63\xa2\x8e\xaa\x2b\x0c" +
+	"\x21\xb9\x3a\x37\x2b\xc7\x6f\x12\xb4\x1e\x98\x7b\x3e\x54\x8e\x98\x4d\x21\x33\xbf\xb8\xf0\x42\x8b\x0c\x96\xa4\xd0" +
+	"\xa0\xa4\xcb\xb7\x2c\xf6\xf8\xc8\xb6\x6d\xb4\x27\x73\x6c\xca\xc2\xe6\x18\xbc\xe9\xcc\x83\x23\x4c\x9a\xa6\xd1\x47" +
+	"\x10\xa3\x04\x82\x98\x47\xbd\xef\x55\x04\xa1\x20\x0c\x51\xe8\xfa\x33\x77\x84\x15\x54\xfb\xf1\xa5\x3a\x8c\xa1\x66" +
+	"\x44\xfd\x43\x67\xa8\xa1\x8f\x2b\xc0\x52\x86\x4b\x33\xbc\x62\x5b\xc1\x64\xec\xf9\xd1\x24\xba\x94\xca\x7a\x2e\xc3" +
+	"\xe9\x42\xdc\x93\x21\x30\x2b\x91\x06\x23\xab\x4e\x75\x51\x70\x01\x47\x7d\x9e\x8e\xb2\xc5\xb8\xd3\xc2\xa2\x7e\xc8" +
+	"\x20\x09\x51\xdb\x09\x11\x8f\x18\x21\xd8\xf8\x6b\xfb\x65\x73\x42\xde\x74\x07\x58\x3c\x37\x9f\xa3\x89\xe6\x84\x27" +
+	"\x3e\xba\xe1\xd9\xc4\x77\xc3\x4b\xeb\xd5\x89\x5d\xe3\xc0\x38\xd7\x81\xc3\xbc\x4b\xaf\x9b\x44\xfe\x34\x44\x55\xec" +
+	"\xe7\x41\x4c\x86\x55\x05\x40\x8f\xc8\xd7\xf6\x57\x37\xca\x27\xbb\x89\x30\x61\xd7\xdb\x5b\x3d\xe5\xf1\xaf\xd9\x2e" +
+	"\xe8\x6e\x59\x61\xec\x98\x63\x6d\x69\x53\xca\xc1\x94\xb7\x36\xac\x6c\xa7\x2c\x39\xfe\xac\x35\x62\x3c\x67\x1b\xff" +
+	"\x2d\xae\xfa\x5f\xbc\x5c\x8a\xb2\xa7\xee\x62\xbf\xcb\x5c\xc8\x54\xba\x86\xf0\x94\xae\xe9\xc0\x07\x77\xf6\xe1\xec" +
+	"\x32\xf2\x66\x96\x69\xce\x3e\xb8\x27\x8b\x93\xdf\xff\x07\x93\xd6\x28\xf0\x47\x6e\x64\x91\x8c\xf5\x8c\x66\x9a\x2d" +
+	"\x03\xd8\x87\x96\xce\xd5\xc1\x4c\x66\x38\xc1\x1b\x0e\x35\xf7\x9d\x0a\x83\x4f\x0b\x7f\x7e\x7e\xe6\x85\x96\x0d\xc1" +
+	"\x47\x2f\x04\xeb\xc2\x0d\xa3\x09\xba\x3d\x66\x16\xc2\xda\x8a\xd6\x4d\xd6\x51\x37\x85\xb1\x37\x1b\xd5\x3c\x96\x71" +
+	"\x7e\xd7\x4f\xed\x59\x5b\x92\xb9\xb5\x2d\x51\x8c\x72\x58\x98\xa1\x47\xb6\xe6\x24\x9a\xa2\x6c\xa3\x43\x8d\x44\x6d" +
+	"\xc3\x5f\x0d\x46\x64\x0b\x86\x70\x0c\x0d\x6f\x83\xc1\x01\x8c\xb2\x82\xb3\x7a\xe2\xcc\xf5\x91\x33\x96\x45\xb5\x38" +
+	"\xeb\x09\x72\x2f\x99\xf9\x05\x3a\x21\x54\x64\x6c\xb5\x93\x61\x4d\x40\xbf\x77\xaa\x6a\xe4\x0b\x2a\x47\x58\x1e\x97" +
+	"\xec\xd1\x5b\xcc\xc7\xbd\xf8\x29\xcd\x54\x87\x0a\xb3\x8c\x88\xf4\xd8\xaa\x0e\xa5\x59\x60\xf3\xc8\xd5\x97\x20\x04" +
+	"\x7e\xd8\x68\x1f\x2f\x6e\x3f\x7b\xf3\x17\xcc\xc9\xfb\x18\x6c\x32\x58\x93\xdf\x31\x51\x3a\x60\xe2\x69\xd9\xd7\x52" +
+	"\x53\x6e\x1a\x9d\xd3\xea\x87\x52\xd8\x8f\x88\x52\x8a\x70\x57\x3e\xfb\x69\x69\x62\xc5\x8a\xcb\xfb\x3c\x99\x45\x33" +
+	"\x15\x01\x8e\x05\x99\x7e\x05\xe1\x41\x5d\x90\xdd\x0f\xa5\x60\xb3\xf7\x7d\x5d\xa8\xd7\xf2\x77\x93\x44\x5f\x99\x90" +
+	"\x06\xf0\x3b\xda\x5d\x77\xed\xb1\x8b\xef\x00\x4c\x7d\x9b\xd7\x16\x8a\x88\x21\x4d\x69\xbd\x37\x44\x39\x4d\x56\xd1" +
+	"\xf3\x47\x2d\x4a\x55\x13\x6b\x3f\x88\x5f\xc5\xca\x83\xba\xa6\xa7\xc0\xc6\x0f\xbb\x84\xf8\xa1\x46\x4a\x59\x67\xd3" +
+	"\x92\xa1\x9e\x74\x71\xef\xa1\x27\x54\xdc\x31\xe8\xa3\x67\x14\xd8\x16\x49\xd5\xa7\xc9\x3d\x9e\xa5\x5d\xfb\x85\xf9" +
+	"\xec\xa9\x16\x99\x84\x49\x59\xd8\xac\x2f\xae\x2c\xba\xee\xdc\x54\x55\x85\x79\x8e\xfa\x40\x58\xc5\x79\x92\xa5\xf9" +
+	"\xad\x56\x7a\x8d\xdc\x68\xf4\x41\xd1\xc4\x36\xfa\xb4\x2a\xe3\x7c\x59\x6c\xf3\x0a\xde\xc2\x11\x84\xc1\x74\x7a\xe6" +
+	"\x8e\xfe\x6c\xd5\x6e\x3d\x8d\xf4\xd4\x7b\x17\xc9\xd9\xcd\x4d\x9c\x66\x2c\x79\x4d\x72\x21\xc2\x8b\x73\x6f\x36\x73" +
+	"\xdf\x7b\x96\xed\x00\xee\x90\x8c\xbf\xa5\xc7\x7d\x51\x7f\x2b\x7b\xdb\xe3\x23\x25\x16\x71\x63\xfa\x3a\x0a\xce\xcf" +
+	"\x27\xd1\x6e\x55\xba\x33\x1f\xa8\x87\x29\x31\x79\xd4\xf7\xcc\x05\x4c\xe3\x85\xf7\x3c\xee\xbd\xe7\x7e\x5b\x31\xc7" +
+	"\x81\xef\x09\xec\x72\xb2\x87\xc1\xe0\x27\xef\x96\xf7\xef\xb9\x6f\x59\xf5\x0f\xae\xb8\x43\x56\x6d\xcb\xbc\x77\x83" +
+	"\x1d\xe7\x4d\x5f\x0d\x03\x60\x69\xb5\x62\xa5\x5a\xaa\x32\xa9\xd2\x78\xcf\x6e\xd5\x52\x91\x94\x14\x8b\x3b\xed\xd3" +
+	"\x98\x2f\x8a\x1b\x5a\x78\xd8\xb8\xb9\x66\x58\x1c\xd6\x11\x17\xd2\x1b\x1d\x00\x52\x4e\xde\x7f\xb8\x8b\x19\xbb\x4b" +
+	"\xc8\xd2\x35\xae\x71\x90\x9b\x92\xf1\x6d\x56\xe1\xf0\xae\xc8\x99\xd8\x4d\x3f\x82\xd5\x2a\x48\xa9\xdb\xe4\xb0\x2c" +
+	"\xd6\xeb\x18\x38\x4e\x63\x70\x8f\xbb\x8b\x3a\x6d\x2f\xde\xb1\x93\x67\xa5\xbd\x6f\xc5\xbb\xe5\xac\x94\x1b\x3d\x65" +
+	"\xcb\xe8\xa4\x0e\xa6\x94\x6f\x30\xaf\x5f\x17\x37\x34\xd1\x65\x28\x59\x6d\x39\xdc\x59\x65\x69\x27\xeb\x1d\xb1\x12" +
+	"\xbf\xa1\xee\xdd\x3d\x45\x1b\x49\x87\x4e\x9d\x75\x4a\x70\x29\x89\x01\xc9\x11\x86\x6f\x21\xce\xb2\x76\x0e\xd3\xe5" +
+	"\xad\xf1\x61\xd7\xb1\x6f\x8c\x2f\x78\x55\xe2\xfc\xdc\xba\xbc\xbc\xbc\x1c\x9c\x9f\x0f\xc6\x63\xbb\x83\xb3\xd6\xb4" +
+	"\xdc\x61\x63\x0c\xf0\x83\x51\x30\xf7\x23\x08\xfc\x7f\x60\xa5\xfd\xec\xa6\xfa\xc5\xcb\xdd\xa6\x20\xee\x00\xfe\xf0" +
+	"\xd2\x36\x5f\xc6\x55\x17\xae\x67\x29\x8a\x82\x87\xfd\x9b\xee\x67\x76\xa1\x32\xf5\xb6\xc6\x60\x30\x84\x34\xd9\x19" +
+	"\xaa\x29\x08\x7d\x4e\x23\x27\xfa\xb4\x34\x53\x5b\x0f\x55\x4d\xd2\x39\x34\xfd\x45\xbc\x5c\x32\xce\x2d\xe5\x07\x4e" +
+	"\x5b\xf4\x0e\x98\x1f\x27\xde\x27\xd3\x76\xe0\xc8\xc6\x12\xa1\x49\x9e\x7d\x99\x08\xdd\x45\xec\xf4\x92\x82\x71\xda" +
+	"\xe4\xad\xe2\x7b\x06\x88\x03\x36\xac\xa4\x3f\x17\x29\x72\x0c\x24\x8a\x0a\xc1\x6b\x09\x4c\xe3\xe4\x7b\x36\x7e\x64" +
+	"\x0f\x43\x2c\x71\xb7\x6c\xbf\x88\x16\x6a\x5d\xf2\x63\xc3\xc7\xe6\x78\xef\xee\xad\xcd\xd2\x7f\xfa\x6a\xe5\xa9\x3d" +
+	"\x19\x04\xe1\x0b\xc6\x4b\x7b\x0d\xc5\xad\x2f\x40\xda\xc7\xa9\x39\xe4\x45\x2b\x7b\x7d\xe7\x3a\x6b\xf2\xae\x15\x09" +
+	"\xb5\x61\x21\x95\xa4\x24\xe8\xe5\x8a\x2d\xef\x28\x52\x5a\x1a\xac\x2c\x57\xde\xbc\x7d\xce\xb8\x27\xb9\xc8\x82\x88" +
+	"\x40\xb0\x3d\x00\xf6\xd7\x86\x2d\xb1\x9f\x6d\x22\xab\xc6\x78\x43\x64\x87\xfd\xdf\x14\xfb\x4a\x3b\x08\x47\x43\x78" +
+	"\xb1\x72\xc1\x90\xd1\xc2\x21\x18\x6d\x9b\x98\x0c\x46\xfa\x20\x58\x3e\xea\x4c\x81\x9b\x21\xe8\xbe\x51\xf0\xee\x98" +
+	"\xe6\xa7\x0c\x87\x7b\x47\x47\xb2\xbd\xd8\xe1\xa0\xd5\x70\x08\x57\x38\xc6\xce\xdb\x34\x3a\x1d\x4d\xd3\x31\x9f\xee" +
+	"\xe8\x5f\x34\xc2\x64\x06\x4d\x4b\x06\x6f\x86\x12\x14\x9f\x5b\xdd\x86\x05\xcd\x5b\x3d\x7b\x2b\x20\x6d\xb3\x6e\x46" +
+	"\x95\xde\xfa\x39\xc0\xdc\xbd\x87\x01\x31\x91\x5a\x7c\xc2\x49\x95\x63\xd6\x33\xa6\x46\x65\x36\x7a\xf7\x1d\x7b\x7c" +
+	"\x92\x94\x36\x30\xea\x1c\xd7\x9b\x4e\xf3\x99\x12\x5a\xb5\x5b\x4f\xb5\x5a\x78\x6f\x90\x96\x88\xd7\x52\xea\xa1\x44" +
+	"\x6b\x3a\xca\x6a\x49\x42\x12\x48\x5c\xe1\xdf\x53\x91\xb7\xfe\x20\x77\x5c\x3c\xe4\xc6\x38\x0c\x2e\x40\x55\xe8\xb0" +
+	"\x53\xa2\x1b\x7f\xec\x03\x51\x8d\xb6\x82\xf3\xa6\x5e\xe4\xc9\x21\x9b\x04\xea\x49\x25\x57\x46\x1d\xa6\x55\x76\xc0" +
+	"\x3f\x84\xf1\x55\xff\x5c\x0f\x5a\x6a\x42\xcd\x49\x79\xb0\x4e\x26\x18\x00\x4d\x7b\x1f\xe5\x1d\x7a\xad\x63\xc6\x1f" +
+	"\xc6\xbf\x06\x00\x01\x91\x7c\x41\x9e\x2c\x00\x00")
+
+func bindataMigrations20190617094500Scd2sqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190617094500Scd2sql,
+		"../migrations/20190617094500-Scd2.sql",
+	)
+}
+
+
+
+func bindataMigrations20190617094500Scd2sql() (*asset, error) {
+	bytes, err := bindataMigrations20190617094500Scd2sqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190617094500-Scd2.sql",
+		size: 11422,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792403097, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190624090000Lineagesql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfd\x73\x1a\x49\xb2\xe0\xef\xfc\x15\xf9\xe2\x6e\xa3\x61\x85\x58" +
+	"\xc9\x9e\xdd\xdb\xf0\x2c\x0e\x63\x68\xd9\xac\x11\xe8\x1a\x64\x8f\x4e\x4f\x41\xb4\xe8\x92\xe8\x27\xe8\x66\xba\x1b" +
+	"\xc9\xba\xf3\xfd\xef\x2f\x32\xeb\xbb\x3f\x00\xcf\x8c\x67\x66\x77\x1a\x26\x3c\xa2\x3b\x2b\x2b\xab\x2a\x2b\x2b\xbf" +
+	"\xaa\xaa\x71\x7c\x0c\x47\xeb\xf0\x3e\xf1\x33\x06\x97\x9b\xc6\x70\x3c\x75\xbd\x19\x0c\xc7\xb3\x09\x5c\xaf\x59\xe6" +
+	"\xdf\x74\xae\xfd\x2c\x4b\xc2\xdb\x6d\xc6\x6e\xa0\x19\xf9\x6b\xd6\x86\x80\xa5\x8b\x24\xdc\x64\x61\x1c\xe1\x8f\x3b" +
+	"\x7f\xbb\xca\xe6\x8f\xfe\x6a\xcb\xda\x10\xd3\xe3\xb4\xd5\x98\xba\x23\xb7\x3f\x03\x67\x34\x1c\xbb\xbd\x77\xae\xd3" +
+	"\x06\xe7\x03\x63\x1b\xc8\x96\x0c\x12\xff\x09\xd2\x78\x9b\x2c\x18\xac\xc2\x88\x41\x7c\x07\xec\x91\x25\xcf\x10\xb0" +
+	"\x55\xf8\xc8\x12\x16\x40\x12\x3f\xc1\x5d\x9c\xd0\x7b\xff\x9e\xc1\x8f\x5b\x96\x84\x2c\x85\xe6\x5f\xc4\x93\x16\x1c" +
+	"\x43\xca\x32\xb8\x7d\x06\x24\xb4\xe3\xdf\x27\x8c\xad\x59\x94\xcd\x05\xc0\xdc\x0f\x02\xac\x75\x3c\xe1\xff\xb6\xaf" +
+	"\xdc\xa9\xd3\xf8\xbe\xd1\xf7\xdc\xde\xcc\x85\x59\xef\xed\xc8\x95\x6d\x14\xf5\x3e\xcf\x39\x55\x37\x8d\x66\x03\x00" +
+	"\x40\x3f\x0f\x83\x1b\xb8\xbe\x0d\xef\xc3\x28\xbb\x81\xf1\x64\x06\xe3\xcb\xd1\xa8\xdd\xe0\x50\x58\xe1\x3c\x8a\x77" +
+	"\x40\x04\x4f\xf3\x83\x80\x92\xf8\x29\x57\x95\xf1\x3e\xf1\x9f\x6e\xe0\x3a\x7a\xf4\x93\xc5\xd2\x4f\x6e\xa0\xb9\xf6" +
+	"\x3f\xb7\x04\x04\xf4\x27\xe3\xe9\xcc\xeb\x0d\xc7\xb3\xeb\x8b\x0f\xf3\x7c\x7b\xe0\xc2\x1b\x9e\xf7\xbc\x2b\xf8\xe0" +
+	"\x5e\x41\x7f\x74\x39\x9d\xb9\x9e\x3b\xe0\xad\xb4\x1b\xd9\x9b\xf6\xdb\x0d\xab\x51\xbd\x69\xbf\xd1\x6a\xb4\x60\x32" +
+	"\xbe\x16\x58\x6e\x1a\xdf\x37\x7a\xa3\x99\xeb\xed\xee\x44\xf8\x34\x9c\xbd\x87\xfe\x7b\xb7\xff\x01\x7a\x83\x81\x49" +
+	"\xe2\x59\x81\x44\xf5\xfb\x06\xce\x26\x9e\x3b\x7c\x37\x46\x5a\x9b\x16\x71\xad\x86\xe7\x9e\xb9\x9e\x3b\xee\xbb\xd3" +
+	"\x7c\xa5\x37\x48\x34\x7d\x9b\xd7\x04\x3a\x19\xc3\xc0\x1d\xb9\x33\x17\xfa\xbd\x69\xbf\x37\x70\xf5\xd0\x0f\xc7\x03" +
+	"\xf7\x07\x08\x3f\x17\x69\x08\x83\x79\x12\x06\x30\x19\x73\x9e\xca\xbd\x87\xa6\x7a\x10\x06\x6d\x50\xe3\xd5\x92\xfd" +
+	"\xd1\xb8\xf0\x26\x7d\x77\x70\xe9\xa9\x3e\xb9\x67\x11\x4b\xc2\xc5\xfc\x2e\x5c\xb1\x17\x19\x5b\x6f\x6e\xe0\xf8\xf8" +
+	"\x4b\xe3\xf8\xf8\x0b\x74\xbf\xd9\x87\xd0\xf7\xb6\xd9\x32\x4e\x5e\x61\x8f\x00\x4c\xe3\x84\x45\xf0\xd6\x7f\x80\x91" +
+	"\x9f\xa4\x2c\x22\x88\x81\x9e\xc6\xaf\x60\x14\xfb\x01\xcd\xcd\xc0\xcf\x7c\xb8\x4b\xe2\x35\xff\x25\x1a\x0c\xd8\x00" +
+	"\x08\xa3\x2c\xa6\xc7\x2b\x82\xf6\x6f\xe9\x19\x60\xbb\x3a\x84\x52\x8e\x01\x7d\x2f\x53\x46\xc0\x9b\x24\x7e\x0c\x03" +
+	"\x16\x00\x4a\x0f\xc8\x62\x58\xc5\xf1\xc3\x96\x4b\x02\x35\x6b\xc1\x8f\x02\x88\xb3\x25\x4b\x20\x8c\xee\xe2\x64\xed" +
+	"\x23\x5d\x9c\x10\xec\xcb\x22\x7a\x22\x14\x4b\xad\xfd\x07\x06\xe9\x36\xe1\xb5\x21\x31\x90\x2e\x96\x6c\xed\x4b\x0a" +
+	"\x53\x58\x24\xcc\xcf\x58\x40\x95\x6c\xe2\xcd\x76\x45\xbf\x64\x2b\x8b\xb8\xd3\x0d\x5b\x84\x77\x21\xc2\x60\xbb\x91" +
+	"\xf0\x92\x06\xf6\x24\xf1\x29\x3c\x30\xb6\x09\xa3\x7b\x25\xae\xfc\x84\x77\x12\xc3\x4e\x4d\xe2\xed\xfd\x12\x2b\x82" +
+	"\xc7\x90\x3d\xc1\x35\x92\x78\xd3\xb9\xfe\x07\x91\xf7\x7a\x8e\x70\x37\x25\x34\xf0\xae\x1e\x0e\xdc\xf1\x6c\x38\xbb" +
+	"\x82\x45\xbc\xda\xae\x23\xd0\xa2\x04\xa2\xed\xfa\x96\x25\x29\x81\x25\xf1\x53\x4a\x63\xb1\x64\x10\x27\x01\x4b\x50" +
+	"\xa8\xe2\x0b\x1c\x38\x4e\x7b\x2f\xb9\xdf\x12\xb5\xaf\xac\x7a\x84\xac\x7b\x63\xf0\x36\xbc\x1d\xbe\x1b\x8e\x67\x24" +
+	"\x08\xe0\xcd\xc6\xcf\x96\x30\xfe\xd8\xf3\xfa\xef\x7b\x5e\xf3\xc5\x5f\x4f\x5a\x8d\x56\xa3\x37\x05\x42\x7a\xfc\xcd" +
+	"\x3e\x8d\xb7\xee\xbb\xe1\x98\xcb\xbe\x81\xdb\x1f\xf5\x3c\x17\xde\x2c\xe2\x6d\x94\xe1\xfa\x64\x3f\xd6\xc2\x3f\x0c" +
+	"\x40\x50\x6f\x43\xe0\x10\xe6\x1a\x61\xbd\x4f\xc3\xff\xcb\x64\x8f\x28\x0c\x84\x02\x5b\x39\xd2\x1c\xcb\xd2\x94\x45" +
+	"\x59\xe8\xaf\xc0\x97\xfd\x59\x9c\x2e\x54\x50\xac\x80\x36\x71\x5d\xcd\xf2\x24\x44\x38\x61\x5d\x9a\x1b\x6d\x41\x46" +
+	"\x17\xf0\x7f\x42\xa0\x9d\x79\x93\x73\x5b\x1a\xf1\x17\x9f\xde\xbb\x9e\x0b\x84\xd1\x1c\xba\x52\x9a\xb3\xe7\x0d\xd2" +
+	"\x96\xf9\xe1\xaa\x84\x58\x24\xeb\x31\xf4\xed\xf9\x48\x68\xdc\x1f\xdc\xbe\xac\xfb\x76\x7b\x0f\x6f\xde\xa0\x80\x1b" +
+	"\x0e\xda\xe0\x48\xec\x26\x66\x6c\x03\xf2\x20\x96\xe0\xd3\x13\xd1\x2a\x94\x8e\x3d\x22\x62\x8e\xca\x2e\x17\x1f\x35" +
+	"\x44\x85\x11\xa2\xd9\x22\xc1\x8a\xf0\xa7\x2f\xfe\xde\x12\x6d\x87\xb7\x97\xa3\x0f\x20\x74\x19\x35\x4a\x36\xb6\x5b" +
+	"\x3f\x5b\x2c\xed\x41\x07\x28\x32\xd6\x62\xc9\x16\x0f\xf3\x45\x1c\xa5\x59\xe2\x87\x38\xd3\x11\xee\xed\x30\x0f\x16" +
+	"\x07\x6c\x83\x6a\x4a\x05\x6d\xf9\xb6\x60\xe7\xe0\xc4\xa4\x81\x39\x00\xfe\x2e\x64\xab\x20\x63\xc9\x3a\x8c\xfc\x2c" +
+	"\x4e\x0e\x80\x4f\xd2\x0c\x55\xa8\xdd\xad\xbb\x0b\x13\x36\xcf\x92\xf0\xfe\x1e\xc5\x88\xfc\x14\x5a\xc7\xc5\x31\xad" +
+	"\x61\x50\x42\x6d\x71\x32\x91\x34\x0c\x70\x9a\x64\xcf\x50\x8d\x17\xc1\xa2\xed\x6a\xa5\xab\x2e\x07\x0b\x57\xf1\xed" +
+	"\x73\xc6\xd2\xf9\x86\x25\x73\x1a\xb8\xb2\xc6\xac\xfc\x92\x26\x17\xc1\xd6\xfe\x67\x96\x24\x71\x92\xee\x06\xe3\x32" +
+	"\x34\xff\x51\x6d\xfe\xeb\x49\xbe\xcd\x28\x80\x2d\x02\xcb\xf1\x26\xf1\x53\x61\x1c\x77\x8d\x24\x72\x7d\xbc\x78\x90" +
+	"\x80\x55\x7d\x44\x4d\xca\x0d\xd0\xce\x21\x42\x0e\x94\x6a\x4d\x49\x81\x02\xbc\xaa\x60\x6e\x96\xac\x86\x97\x6a\xea" +
+	"\x7c\xed\x7f\xa6\x15\xce\xc6\x7f\x7a\x82\x1d\x68\x89\x49\x21\x0f\xba\x90\x75\xb0\xd1\x6c\xce\x1f\xb4\x95\x62\x87" +
+	"\x8b\x14\xbd\x31\x60\x50\xe4\xd8\x10\xc5\x8a\xbb\xb0\xed\x70\xeb\xc4\x04\xdc\x29\x26\xc4\xd7\x90\x12\x5d\x78\xee" +
+	"\x5c\xab\x9f\x37\x76\x9d\x45\x29\x41\xe0\x85\xc7\xf9\x62\x52\x6a\x70\x68\xf1\x2b\x07\x64\x89\x0a\x02\x34\x9f\xe4" +
+	"\x80\xf3\x72\x82\xe0\x73\x0f\x0b\x45\x84\xa8\x10\xb0\xfc\x57\x11\xc8\x10\x14\x12\x52\x3f\xca\x83\x1b\x02\x83\x03" +
+	"\xeb\x07\x39\x50\x4b\x54\x10\xac\xf9\xa4\x04\x98\x0b\x0c\x05\x49\x3f\xf3\x60\x25\x02\x83\x17\x28\xbe\xc8\x15\x95" +
+	"\x42\x84\xc0\xc5\x8f\x1c\x88\x16\x20\x04\xa4\x7e\xe6\xc0\xb8\x00\x21\x10\xfa\x33\xf7\x3a\x27\x2e\x08\xce\x7e\x56" +
+	"\x2c\x90\x1f\x58\xeb\x51\x0e\x5c\xca\x0d\x42\x2c\x7e\xe4\x40\xb4\xcc\x20\x20\xf5\x33\x07\x66\xce\x77\xc5\x7f\xe2" +
+	"\x41\x15\xc6\xf9\xce\x42\x05\xcd\xe6\x9a\x58\x59\xa2\xd1\x9f\x67\x0b\xbd\x6d\xe6\xcb\xa7\xe2\xe3\xef\x00\x9d\xa7" +
+	"\x19\xfa\x03\xb0\x17\xd8\xfc\x11\xb2\x5d\xa0\xca\xdb\x31\x7f\x04\x80\xad\xa9\x6b\xf9\x1d\xd2\xb6\x32\x03\x3a\x0c" +
+	"\x24\xaa\xde\x78\x20\x01\xb6\x95\x00\xcf\x1c\xc0\xef\x60\x7b\xcb\x0b\xbf\xa9\x2a\x6b\x4b\x45\x00\xe8\x82\x83\x86" +
+	"\x84\x63\x02\x6d\x3b\x9a\x7e\xa1\x58\x3a\x52\xe6\xce\xcf\x7b\x3f\xcc\x47\x93\xde\xc0\x31\x75\x44\xdf\x36\x50\x48" +
+	"\x52\x93\x95\xd2\xf4\x57\x2b\x61\x73\xa4\x70\xbb\xcd\x0c\xc3\xa3\x05\xe1\x5d\xce\x7a\xc3\x49\x9b\x4a\xeb\xc7\x5e" +
+	"\x09\x08\xa5\x16\xfc\x2f\xfe\xde\x42\x95\x95\x1a\x43\x80\xc3\x33\x98\xbc\xfd\xa7\xdb\x9f\xcd\x87\x83\xa6\x73\xed" +
+	"\xc0\x91\x5a\x0b\x8e\xc0\xb9\xe9\xf0\x27\x04\x0f\x47\xe0\x90\x4c\xbf\x41\x97\xce\x47\xa7\x05\xc3\xa9\x72\xa3\xc0" +
+	"\xd4\x9d\x89\xda\xba\x79\x78\xd5\x64\x38\x82\x8b\x84\x6d\x18\xda\x81\x68\xd6\x64\x31\x7a\x99\xb8\xf2\x7e\xb7\x5d" +
+	"\xad\x48\x0f\x97\xa6\x93\x54\x90\x25\x99\xfd\x49\x6f\xe4\x4e\xfb\x6e\x93\x4c\xa2\x36\x38\x4e\x0b\xfe\xf1\x1a\x1c" +
+	"\x87\x57\x2d\x3a\x9c\x5e\x22\xc9\xf8\xdb\xa8\x77\xca\x32\x61\x90\xb1\x55\x00\xab\x70\x1d\x66\x2c\x41\x73\xf8\x3f" +
+	"\x4f\xb0\x3f\x51\xea\xd0\x4a\x83\x95\xfb\xc9\x3d\x42\x53\x1b\xd8\xe7\x05\x63\x41\x0a\xdf\x9d\x9c\x9c\x40\x53\x76" +
+	"\x64\x0b\x82\x2d\x83\x2c\x16\xf8\x8f\x60\xe9\x27\x02\x2b\x92\xff\xf7\x93\xbf\x9d\xa0\x2a\x7e\x3e\x85\xe9\xff\x1e" +
+	"\x75\x60\xb6\x0c\x53\x34\x84\xb9\x7b\x0e\x8d\x97\x14\xd2\x78\x2d\xcc\x7d\xd1\x50\xf4\xaf\xad\xfd\xc8\xbf\x67\x29" +
+	"\x1c\x73\x63\x3c\x55\xf8\x83\x38\xca\xe0\x98\x2c\xe8\xc5\xd2\x8f\xee\xd1\xda\xc5\xe6\xdc\xb2\xa5\xff\x18\xc6\x09" +
+	"\x2c\xfd\x14\x7c\xb8\x47\x4b\x1b\x36\x2c\xc1\x6e\xf5\xa3\x05\x83\x70\xbd\xf1\x17\x59\x47\x21\x9a\xa1\x99\x21\xb9" +
+	"\x14\x0a\xdc\x09\x8b\x38\xca\x92\x78\xc5\xcd\xda\x94\x65\x59\x18\xdd\x77\xe4\x10\x94\x2e\xef\x0e\x7a\xf6\xf8\x18" +
+	"\x14\x17\x40\xe7\x3f\x4f\xf4\xe8\x7f\xa1\xd1\x47\xe3\xdc\x5c\xfb\xd3\xcc\xcf\x38\x1b\x93\x6d\x94\xb0\x2c\x09\xd9" +
+	"\x23\x43\x16\x49\xfc\x35\xcb\x50\x39\x6e\x92\x48\xc1\x79\x9b\x53\x74\xd2\x1f\x57\x5a\xad\xf9\xee\x44\xea\x85\x3b" +
+	"\x4c\xa7\x9d\x24\x38\x00\x20\xd4\xa2\x19\x47\xde\x05\xc7\x84\xac\x9a\x20\xd4\x17\x38\x61\xb8\x5c\x75\x1c\x47\xf2" +
+	"\x20\x42\x39\x0e\xf7\xc6\x35\xb9\xb8\x50\x83\xe1\xb1\xcd\xca\x5f\x30\x08\x9e\x23\x7f\x1d\x2e\x8c\x16\x6b\x22\x94" +
+	"\x54\x27\xa1\xe3\xb9\x17\xa3\x1e\xce\x01\xf5\xb8\x0d\xce\xff\x93\x3a\xc9\xff\x77\x84\x49\xdb\xd2\xe5\x6d\x6d\x40" +
+	"\x95\x37\x1e\x57\x60\x50\x83\xae\xd5\x30\xf3\x53\x98\xfb\xbc\xb7\xa8\xd3\x8e\xc0\x79\xdb\x9b\xf5\xdf\x4f\x87\xff" +
+	"\xc7\xed\x62\x5f\x98\x9f\xa3\x2a\x8c\xd4\x51\x6d\x47\xd5\xab\x14\xb4\xaf\xa9\xb7\x3f\x19\xb8\x17\xbd\x77\xc5\x6a" +
+	"\xe1\xa8\x0a\x63\xbe\xde\x72\xf3\x70\x4f\xbd\x83\xde\xac\x77\x36\x1c\xb9\xb3\xab\x8b\x5c\xdd\x47\x55\x18\xf3\xf5" +
+	"\xe6\x67\xcf\x41\xf5\x9e\x0d\xdd\xd1\x60\xe6\x7a\xe7\xc3\x71\x6f\x36\xf1\x8c\xaa\x8f\xaa\x30\x16\xea\x35\x38\xe4" +
+	"\xe0\xf6\x9e\x4d\xbc\xf3\xde\x6c\x8e\x4d\xb6\x9b\x0b\x47\x36\xcf\x55\xb7\xb7\xdc\xc8\xdb\x53\xef\xc4\x1b\xb8\x66" +
+	"\x2b\xf5\xe7\xa8\x0a\x63\xbe\x5e\x5b\x97\x3b\xb0\x5e\x6f\xf2\xa9\xb4\x97\x11\x7d\x05\xc6\x7c\xbd\xe6\x4c\x86\x43" +
+	"\xeb\x75\x3d\x6f\xe2\x95\xf4\x32\xd5\x5b\x8e\x31\x5f\xaf\xa9\x0d\x1e\x5c\x2f\xf2\xf3\x7c\x3a\xb9\xf4\xfa\x25\xe3" +
+	"\x5b\x8e\xb1\xb2\xbd\x96\x3e\x7a\x68\x7b\xe7\x79\x0a\x8e\xaa\x30\xca\x7a\x55\xc5\xca\xa8\x02\x38\xbc\xc1\x67\x43" +
+	"\x6f\x3a\xf3\x26\x9f\xba\x76\x2f\xd3\x38\xf6\x7b\xd3\x59\xb3\xd4\xad\xd3\x9b\xaa\xe5\xa7\x85\x58\x8c\xe6\x97\xd9" +
+	"\x41\x7b\xa9\xf8\x30\x1c\x4d\xde\x5e\xcd\xdc\xe9\xfc\xc2\xf5\xe6\x24\x44\xbb\x8e\x45\x45\x19\xda\x1d\x54\x48\x93" +
+	"\xea\xab\xfa\x62\xd4\xab\xe8\x0a\x45\x45\x19\xda\x1d\x54\x68\xab\xed\x2b\xa8\x38\xef\xfd\x40\xdc\x3f\xed\x3a\x15" +
+	"\x54\x94\xa1\xdd\x41\x45\xce\xea\x3b\x8c\x0a\x6f\xf2\xa9\x38\x18\x16\x15\x65\x68\x4b\xa8\x50\x64\x94\xf9\x2b\x4e" +
+	"\xcb\xaa\xa6\xe0\x9d\x8e\xdb\x4d\x8d\xa6\xe4\x5d\x02\xa7\xa0\x16\x7c\x1b\xc7\xd9\xd0\x73\xe7\x33\x6f\xf8\xee\x9d" +
+	"\xeb\x99\x08\x72\x96\xff\x29\x54\x21\xf8\xe0\xba\x17\x32\x0a\x92\x2b\x2f\x9d\x01\xa2\x70\x75\x79\xec\x5b\xb3\x72" +
+	"\x6d\x1e\xeb\xa2\xa5\x85\x31\xc2\x39\xe9\x7f\x90\xbd\x67\x40\x8c\xdc\xb3\x59\x13\xff\x6e\xc3\xc8\x1d\xd3\x5f\x18" +
+	"\x93\x3e\xa5\x59\xd8\x12\xf0\xa4\xf9\xbb\x9f\xd9\x62\x9b\xf1\xf0\x54\xa9\xd2\x67\x6b\x94\xb7\xdb\x15\x0e\x8e\x19" +
+	"\xe8\xa8\xd6\x26\xb1\x5a\x0d\x92\x6e\xe6\x8c\x57\x86\xe4\xd3\x3b\xa5\xea\xbd\x13\xf6\x07\x8f\x1a\xa1\x91\x80\x6c" +
+	"\x03\x09\xdb\xc4\x09\x46\xc3\x6e\x9f\x4d\xe2\x74\x6b\x0d\x72\xba\xf0\xe6\x8d\x37\xf9\xd4\x9f\x5c\xee\xa5\xcb\xf1" +
+	"\x10\xb9\x7f\x77\xc7\x16\x45\xe4\xce\x9e\xc2\x46\x9d\xb2\x1b\xff\x03\x64\x5c\xe8\xdb\x7d\x54\x55\xe3\xc9\xcc\x7d" +
+	"\x05\x67\x71\xc2\x6d\xa4\x84\xf9\x69\x1c\xe5\x3a\x4f\x4c\x7b\x16\x11\x99\x94\xb3\x10\x6c\x13\xb4\x8a\x8c\x86\xa2" +
+	"\xc9\x15\xae\x37\x71\x9a\x86\xb7\x2b\xc3\x5c\xfb\x0f\xc1\x70\x9c\xff\xef\x9e\x61\x9b\x62\x49\x3f\x7a\x86\x5b\x96" +
+	"\x66\xb0\x49\xfc\x45\x16\x2e\x58\x0a\x9b\xed\xed\x2a\x4c\x97\xbc\x0b\x91\x82\x47\x16\x05\x71\xd2\x99\xc6\x68\xde" +
+	"\x71\x75\x23\x8b\xe1\x36\x61\xfe\x03\x6c\x92\x78\xc3\x92\xd5\x73\xae\x96\xa7\x25\x8b\x34\xa1\x58\x11\x27\xbe\x8d" +
+	"\x4d\xea\xb8\xf8\x77\x67\xf6\x39\xe3\xb1\xce\xf5\x36\xcd\xe0\x16\xe3\xba\x18\x04\xc5\xb1\x5b\xfb\xd1\xd6\x5f\x19" +
+	"\x58\x79\xf7\xa0\x1d\xf7\x79\x43\x2a\x16\xfb\x1c\xa6\x19\xb0\xcf\x19\x8b\x30\xce\xdb\x44\x23\x71\x1b\x05\xf1\x82" +
+	"\x5c\xaa\x2c\x68\x21\x65\x0b\x16\x60\x8c\x36\xdd\xde\xdf\xb3\x54\xf0\xc4\x1a\x5b\xbc\xf1\x93\x0c\x4d\xcf\x20\x66" +
+	"\x29\x44\x71\x06\x79\xf2\xe3\xe4\x01\x9e\xc2\x6c\x09\x11\xcb\xe8\x07\x5a\xd8\x69\x8e\x9c\xde\x66\xe3\x27\x2c\xca" +
+	"\x56\xbc\x9b\xe2\x68\xf5\x0c\x4f\xfe\x33\x1a\xd8\xb2\x5b\x30\x04\x46\x6a\x17\x43\x83\x1b\xab\xa6\x7e\xc0\x5c\x93" +
+	"\x14\x9e\x18\x12\xf7\x10\x6e\x36\x2c\xc0\x61\xc3\x48\x77\xf2\x9c\x23\x85\x82\xd7\xe4\x32\x61\x1d\x5e\x16\x9b\x4f" +
+	"\x46\x31\x1f\x81\xf0\x0e\x42\xec\x89\x30\xcd\xd2\x5f\x9b\x73\x45\x7d\x5f\x60\xc8\x87\x0e\xa9\x04\x83\x4c\x6a\x93" +
+	"\x34\x6e\xa1\x29\xb9\xb2\x25\x59\x59\xb7\x42\x75\x58\x78\x07\xa9\x76\x31\xf4\x71\xd9\x40\xf6\xc1\xc4\x1c\x51\x08" +
+	"\x7b\x8a\x2f\x02\x9a\x45\xb5\x96\xa4\xed\x49\x25\x79\xf5\x3b\x63\xd9\xa3\xb7\x3c\xde\x2b\x7a\x7a\x97\x68\xb9\xe0" +
+	"\xde\x05\xc5\xc8\x40\xeb\x19\x4e\x4a\x9a\x7a\xe8\x45\x50\x5e\x31\x50\x7f\xa8\x16\x08\xef\x95\x39\x80\x7c\xc0\x88" +
+	"\xfe\xe4\x19\xf1\xca\x81\x0e\x33\xc1\x79\x31\x64\xf1\x8a\x25\xe8\xd1\xb0\x30\x9e\xa1\x4a\x06\x1b\x61\xd2\x47\xec" +
+	"\x49\xd3\xa0\xe5\x3b\x3c\x2d\xc3\xc5\x12\x9e\xc2\xd5\x4a\xf4\x70\x14\xc3\xda\xcf\xd0\x01\xf4\xb4\xf4\xa5\x5f\x65" +
+	"\xe9\x47\x01\x4a\x89\x25\xe3\x43\x62\x55\x84\x96\x23\xf8\x8b\x45\x9c\x04\x61\x74\x2f\x66\x63\x6e\x49\x52\xb6\x35" +
+	"\xad\x4a\xce\x2e\x4f\x80\x93\x7f\xaf\xc7\xc5\x00\x6a\x1d\x50\x8b\x6d\x1f\x14\x31\x39\x7b\x61\x3a\x34\x7e\x8e\x53" +
+	"\x5e\x5d\xbf\x37\x75\xe1\xd3\x7b\x77\x6c\x2a\x71\xc8\x3b\xa8\xb4\xcd\xf0\x79\x8e\x20\xe1\xe8\xc0\x06\xf2\x3f\xb5" +
+	"\x1a\x77\xd2\x36\xea\xc8\x7f\xdd\xd1\xd4\xcd\xe3\x32\x35\xc0\xa2\xce\x67\xea\x58\x16\xf0\x49\x79\x35\xee\x78\xb0" +
+	"\xa7\x85\x4a\xc3\xff\xaa\x06\x2a\xcb\xe1\xf4\x6b\xdb\x67\xd8\x1c\x05\x23\x23\xd7\x3a\x5d\xc9\xfe\xc6\x1d\x1f\xdb" +
+	"\x5a\x8f\x39\x4d\xc3\x08\x7c\x88\xf8\x12\x30\xf3\xae\xfe\xd2\x47\x13\x03\x48\x1d\x53\xe5\x49\x14\xc0\xcc\xbb\x52" +
+	"\x4f\x0e\xd4\x82\xe4\xb7\x4a\x1b\x52\xef\xc7\x03\x0b\x3d\xaf\x90\x48\x51\xcf\x64\x43\xde\xeb\x69\xf9\xbf\x5e\xbe" +
+	"\x3c\x81\x66\xdf\x8f\x70\x99\xba\x63\x68\xf9\xa0\x0d\x42\x6e\xc3\xc9\xc8\x85\xc1\x5b\x99\xed\x94\xa0\x2e\xb8\x66" +
+	"\x7e\xa4\xa5\x94\x21\x69\xac\x3a\x86\x67\x40\x6c\x33\x1f\x5f\x9e\xbf\x75\xbd\x66\x0b\xba\xbc\x22\xaf\x37\x9c\xd2" +
+	"\x9b\xa6\xe3\x16\xb5\x8d\x30\x32\x44\x0d\xe6\x42\x32\xb8\xfe\x53\x8a\x9e\xf1\xd3\xd3\x36\x9c\xb6\x8d\x49\xa6\xc7" +
+	"\xcb\x1d\x0f\x8c\x56\xe2\x90\xa9\x55\xa3\x1f\xaf\x49\x86\x95\xe8\x88\x61\x94\x32\xd2\x11\x49\x16\x0a\xb5\xd1\x0f" +
+	"\xd4\xc2\x80\xaa\x65\x45\x91\x7d\x6a\xe2\x8e\xa2\x22\xdd\x0c\xf3\xb9\x50\x5d\x67\x4e\x5e\x03\x1f\x3b\x32\x76\x4c" +
+	"\xe5\xba\x40\x8a\x69\xf3\xcf\x2d\x2e\xd8\xf6\x86\x12\x6e\x9c\xc6\x0e\x5e\x69\xc3\xd8\x41\xbf\x4a\x2a\xb2\x81\x60" +
+	"\x72\x39\xbb\xb8\x9c\xa1\x83\x93\x34\x29\xf1\x7b\x4f\x03\xdf\x58\x5a\xec\x11\xd0\x40\xca\xf8\x49\xf6\x14\x03\xbd" +
+	"\x47\xe5\x87\x54\x9f\x35\x1a\x70\x6a\xad\x34\x54\xef\x7f\xbc\x16\xd5\xaa\xa1\x34\xb8\xa3\x4f\x20\xeb\x30\xa5\xe2" +
+	"\x6d\x4b\x03\xbd\xfe\x53\x70\x43\x0a\x8a\xe8\x2b\xd5\x47\xf8\xc2\x60\x16\x5d\x97\x24\x5a\x38\x60\x8f\x8f\xbf\xc0" +
+	"\xe5\x26\xf0\x33\x66\x05\x45\x74\x96\xcf\x9e\x1e\x70\x44\x61\xf1\x92\x97\xe6\x3d\x7f\x79\x31\xc0\x7c\x4c\xeb\x8d" +
+	"\x6c\x1f\x8e\xb3\x88\xbc\x9b\x0d\xdf\x99\xf6\xe4\xb9\xb3\x4b\x6f\xdc\x40\x26\xd7\x9f\x6f\x9d\x6c\x59\x99\xfb\xa9" +
+	"\xc8\x4b\x17\xc1\x8b\x5f\x2d\xef\xd3\xca\xea\xec\x6d\x36\xab\x67\x50\x01\x9e\x67\xc3\x9c\x20\x5d\x70\x13\x43\x2c" +
+	"\x73\x3a\xa7\xfd\xc1\x0b\x58\x86\x69\x16\x27\xcf\x10\xe7\x02\x7c\xc5\xf4\xc4\xa6\x8e\xe1\x50\xc9\x2e\x5c\xb9\xd3" +
+	"\x16\x3c\xb0\x67\x16\x40\x8c\xb2\x69\x3a\x1c\xbb\xd3\xe9\xfc\x83\x7b\xf5\xaa\x58\x1c\xe0\x98\xb4\x25\x5a\x16\xfc" +
+	"\xe8\x9e\x05\x80\xa6\x10\x19\x02\x18\xfb\xc2\x25\xe2\x09\x1e\x59\x92\x62\x1a\xe8\xa3\xbf\x0a\x75\xbe\x26\x29\x56" +
+	"\xdb\x14\x63\x57\x25\xb9\x9b\x88\x19\x69\xdf\x24\xec\x31\x8c\xb7\xa9\x42\x12\xdf\x15\xab\x0a\x53\x81\x7b\x1b\x65" +
+	"\xe1\xea\x30\xe4\xaa\xf0\x3a\x4c\xc9\x6c\x0b\x23\x38\xc3\xb5\x5a\xf4\x32\xe2\x6d\xc6\x09\x76\x3a\xe3\x62\x0c\x33" +
+	"\x91\x67\x3d\xe3\x7d\xab\x0c\x33\x00\x8a\xdf\xaf\x22\x07\x6d\x30\x39\x64\x5c\xac\x5d\xa3\x3d\x8f\xc9\xa5\xf8\x7f" +
+	"\x10\x19\xa6\x9c\x01\x8d\x3c\x58\xcc\xac\x45\x4d\x03\xb6\x69\x59\x62\xeb\x4c\x46\x82\xb9\x7d\x69\xa4\x9c\x36\x71" +
+	"\x99\x11\x29\xfd\x2d\x34\x93\x50\x68\xa1\x01\x27\x39\x46\x50\x93\xcf\x38\x95\x49\xa6\xc5\x34\x4c\x5c\x41\xbe\xc0" +
+	"\x58\x84\x5d\x45\x17\x3d\x43\x13\x57\xcb\x5f\x35\xd1\xd4\xf2\xc1\xac\xd3\xfb\x92\xac\x28\x1d\xde\x53\x80\x6a\x86" +
+	"\xe8\xb4\x59\x1b\x40\xb6\xa8\x32\x33\xd5\xdf\x06\x21\xcf\x5b\x85\x72\x00\xce\x11\xe2\x83\x02\xd3\x7e\x8d\xb9\xcc" +
+	"\x69\x56\xf9\x1a\xc7\xbe\xd8\x92\x97\xb9\x66\xac\xe3\x80\x15\xa1\x0a\xd9\x68\x0f\xec\x39\x2d\x03\x2b\x74\x0b\xda" +
+	"\x8f\x3c\x83\xbb\x34\x47\x53\xc1\x21\x97\xce\x89\x29\x72\x08\x73\x70\xc8\x56\xb2\x5e\x0d\xf7\xa2\x00\xb7\x88\x57" +
+	"\x69\x11\xee\xbc\xf7\x43\x0e\x2e\x35\x01\x77\xc0\x2d\xfd\x74\x09\x07\xc0\xfd\x57\x1c\x46\x87\xc0\xe1\x54\x09\x0b" +
+	"\xfd\x52\x84\x93\xb2\x63\x1f\x3e\x34\x20\xd4\xc7\x86\x2b\x4b\x04\x16\xbc\xa2\x66\xd9\xed\x33\x4f\x78\x40\xfd\x20" +
+	"\xcc\x52\x5a\x11\xd2\x90\x56\x00\x62\x4b\xa1\x71\x91\xe2\x60\x73\x72\x17\x82\x4e\x18\x58\x99\x34\xf9\x44\xe7\xc0" +
+	"\xca\x83\xb1\x41\x2d\x9e\xee\xea\xcc\x8a\xa0\xc3\xe5\x1d\x86\x57\x58\x9b\x5b\x24\x41\x47\x88\xad\x6c\x0d\xbd\x29" +
+	"\xb1\x78\xab\x55\xc8\x21\x52\x6d\x12\x59\x33\x5c\x51\x40\x22\xa1\x0b\x4d\xd1\x86\xf3\xde\x0f\xcd\x30\x68\x95\x15" +
+	"\xe3\xf0\x32\x87\xc3\x08\x40\xcb\xe6\xab\x79\xda\x95\x68\x0a\x34\xe8\x3e\x13\x7a\x8a\xc4\x3e\x2f\x2a\x2c\xa2\xed" +
+	"\x98\x37\xc4\xd3\x93\x08\xe4\xa5\x8e\x21\xa9\xfa\x86\xd3\x2a\x1f\x89\xa1\x02\x0e\x04\x6a\xb2\x01\x30\xa7\xc5\x1c" +
+	"\x4b\x14\xd2\xfe\xa3\x1f\xae\x70\x39\x30\x74\x3e\x1d\xa8\xd7\xda\x13\xbc\xb0\x4d\x03\xd9\x7c\x2d\x47\x78\xfb\xb5" +
+	"\xd1\x5a\x4c\x39\x42\x85\x20\x1f\x31\xc1\x2f\x19\xb3\x94\x8a\x89\xf8\x5b\x36\x4b\x68\x11\xb4\xbf\x82\x81\x3b\x1a" +
+	"\x7e\x74\xbd\xab\xf9\xf9\x64\xe0\x3a\x07\x56\xa0\x85\xd7\xfe\x0a\x4c\xed\xc5\x81\x03\x2b\xd0\x42\xef\xa0\x16\xcc" +
+	"\x7a\xf3\xc9\x85\xeb\xf5\x66\xc3\xc9\x78\xde\x9f\x8c\x2e\xcf\xc7\x4e\xbe\x82\x22\x8b\xa9\x29\xa5\x71\x3e\x9a\x2c" +
+	"\x67\xce\xb9\x42\x3e\x99\x92\x09\xe3\x38\x5b\x0a\x3f\x57\x10\xc3\x36\x5a\xb1\x34\x05\x16\x21\x7f\x04\xc5\x74\x27" +
+	"\x1c\x7b\xda\x43\x27\x12\x9e\x28\xdf\x46\x28\xdb\x12\x7a\x7c\x39\x1a\x0d\xcf\x9a\xd4\xcb\x3c\x35\xaa\x9a\x6f\xb5" +
+	"\xb1\xa0\xba\x0c\x77\xeb\x69\x9e\x6f\xc3\x8b\xb6\x69\x42\x20\x43\x41\xc2\x7e\xdc\x86\x09\x4b\x45\x1c\x45\x0f\x90" +
+	"\xee\x5e\xa7\x6c\x6a\x50\x61\x53\xb7\xa0\x29\x72\x08\xb6\x3d\x33\xe5\x65\xf9\x4c\xd1\x6b\x5a\x17\x74\x96\xf2\x8e" +
+	"\x91\xb4\xd2\x13\x0f\x1e\x4b\x41\x0b\x0a\x10\x2b\x33\xb0\x0b\x0e\x52\x60\x58\xcb\xb4\x78\x76\xc1\x91\xba\x21\xd9" +
+	"\xc5\x9a\x4a\x4c\xaa\xc3\x11\xbe\x51\x31\xab\x2f\xf0\x5e\x28\x95\x98\xd4\x85\xe1\x05\x06\xfe\x66\xb3\xc2\x0d\x51" +
+	"\x61\x64\xaa\xa4\x7c\xaf\x51\x31\x8f\x8f\xea\x6c\x83\x73\x69\xe7\xe8\x95\xf0\x82\x61\xcf\x2b\x73\x3e\x9e\x8b\x85" +
+	"\x8a\xcf\x23\x5a\x2c\xe6\xa8\xfd\x0b\xc9\x4d\x0d\xc0\x1a\x1a\x7b\xfc\x3d\x64\xc3\x2b\x6c\xb8\x72\xa0\xd5\xee\xb4" +
+	"\xad\x2a\xa4\x06\x35\x11\xc6\xbc\x68\x8c\x7c\xfc\x5a\xac\x56\xea\x9d\x4d\xbf\x6a\x03\xaa\x8c\x42\xf6\xa9\x38\xc2" +
+	"\xb1\xd5\x57\xe4\x7e\xe8\x4f\xc6\x1f\x5d\x6f\xa6\x12\xf9\x30\xe5\xbf\x2d\xaa\x68\xc3\xe9\x8b\x13\x8a\x1f\xde\xc0" +
+	"\x2d\xbb\x8b\x13\xb5\x62\x4b\x1b\x66\x17\x0a\x4e\xaf\x81\x43\xcf\x86\x9f\x32\xe9\xde\xac\xd3\x7b\x0b\x81\x31\xa5" +
+	"\xfe\x94\x1a\x73\x63\x9d\xde\xeb\xa9\x61\x4c\x8f\xef\x1a\x79\xd7\xa0\x9a\x2a\xc7\xc7\x3a\x13\x4f\xec\x6b\x5b\xa1" +
+	"\x07\xfe\x15\xda\x39\xbe\x4c\x3b\x6d\x63\x64\x06\xf3\x0a\x97\xe4\xc0\xb8\xc5\x20\x19\xca\xa9\x07\xf6\x0c\xa8\x70" +
+	"\xfd\x45\x69\x53\xd6\xfc\xe3\xaa\x9d\xa1\x54\xf0\x27\x98\x1b\x41\xff\x39\xd8\xc3\xbc\x8a\x92\xfd\x03\x5c\x33\x34" +
+	"\x4b\xa7\x25\xc5\x9d\xb4\xe3\xec\xc2\x42\x44\x5b\x58\xe8\x09\x47\xe2\x7c\x71\x2c\x5c\xa4\xe5\xe4\xd0\xe1\x00\x9a" +
+	"\xfe\x65\xd2\xe9\x5a\x4e\x41\x8a\x88\x22\x6b\x7f\x83\xfb\x0f\x7f\x69\xf1\x21\x00\x4c\xba\x70\x2a\x0f\xc7\xd0\x74" +
+	"\x70\xbf\xb2\xa1\xd0\xa0\xe3\xd1\x31\xf6\x30\xcb\x9f\xc2\x72\xbc\x91\x4e\x63\x4a\x9f\x82\xb7\x57\x28\x39\xc2\xc8" +
+	"\x5f\xcd\x49\x4b\xc1\x31\xb4\x06\x51\x2b\xd4\x66\x27\xd2\x53\xea\x99\xf1\xc0\xe8\xc0\x8c\xcb\xb4\xd1\xcc\x1b\x9e" +
+	"\x37\x3d\xfa\x97\x96\xd0\x96\x98\x4b\x5d\x48\x77\x43\x38\xf6\xe8\x69\x2d\xdd\xac\x5c\x3f\xcd\xf1\xc2\x6e\xd4\x02" +
+	"\x33\x0d\xd9\x74\xe6\x0d\xc7\xef\xe6\xd3\x8b\xd1\x70\xa6\x96\x4a\xe5\xb3\xe7\xa3\x56\x86\x88\xb2\x8c\x65\x07\xcd" +
+	"\xb4\x79\xc0\x63\x07\x26\xed\xe6\x47\x04\x4d\xe2\x40\x6b\x1c\xbc\xe7\xe4\x5a\xad\x04\x81\x5e\xb0\x4d\x41\x5d\xf2" +
+	"\x21\xf5\xc4\xd1\x8a\xcd\xe5\xc5\x85\xeb\x35\x89\x81\xa9\x17\x34\x4a\x6a\xbc\xc5\xc1\xa7\x27\xad\x56\x0b\xf9\xcb" +
+	"\x19\x38\x42\xd1\x39\xe5\x41\x89\x13\x94\x10\x4e\xa3\xac\x42\x19\xb7\x70\x4e\x4a\xdf\x2b\x89\x22\x23\x06\xbd\xf1" +
+	"\xb4\xd7\x47\x8d\xaa\xd1\x28\x0f\x24\xa0\xe8\xe9\x93\x3d\x91\xf3\x9c\x98\x3e\x11\x68\x2a\x46\xa6\xf8\x5f\xbc\xcd" +
+	"\xd4\x46\x5c\x31\x58\xfb\x56\x3c\xb3\x13\x2b\x56\x0c\x15\xfd\x99\x35\xe5\xd2\x37\x9b\x5c\xc0\x49\xd9\xcc\xc7\xbe" +
+	"\x45\x39\x64\x61\x51\x5f\x62\x48\x42\x64\x4f\x4a\xc4\xc3\x9d\x12\x2d\xfc\xd3\x7e\xa9\x4b\x88\x96\x16\x80\xf9\x73" +
+	"\x67\x5f\x9d\xc8\x4e\x58\xe2\x63\xcf\x7b\x3b\x1c\xf7\xbc\xab\xe6\xcb\x17\x2d\x89\x03\xe5\x5e\x0e\x0e\xd7\x61\x7a" +
+	"\xad\x17\xf6\xdd\x10\x59\x5c\x4d\x03\x6e\xb1\x9b\x68\x8d\x80\x1e\xd1\x5c\xab\xd6\x76\xd4\x9c\xcc\x2f\x8c\xbf\x44" +
+	"\x58\xa9\xa1\x7e\x20\xa7\x8d\x68\x4d\xa6\x75\x6c\xc3\x12\x7b\x09\x13\x1e\x33\x39\x22\x8d\x7d\xbc\xf1\x67\xde\xd4" +
+	"\xff\x91\x26\x0b\xae\x06\x49\xab\xf6\xab\xd8\x85\x58\x25\xcf\x08\xbb\xc6\x9a\x0a\xbc\xef\x4d\xdf\x53\x56\x60\xd3" +
+	"\x71\xa6\xef\x7b\x2f\xe6\x2f\xfe\xfa\x37\x5c\xc4\xfa\x93\x71\xbf\x37\x6b\x52\x1f\x9b\x2b\x9c\xe3\x58\x0c\x50\x85" +
+	"\x96\xca\x49\x61\x26\x56\x3c\x4e\x1b\x7a\x4e\xab\x4a\x79\x93\x4f\x3a\x56\x36\xf9\xe8\x7a\xd0\xbc\xe8\x79\xb3\x21" +
+	"\x4e\x7b\x5c\x59\x08\xab\x25\xad\xf5\xaa\xa3\x5a\x0a\x03\x77\xda\x97\x34\x26\x7e\xf4\x50\x5e\xdb\x5e\x5e\x12\x6b" +
+	"\xad\xdd\xa3\x28\xe5\x54\xbc\xd8\x7c\x6e\x0c\x54\xab\x91\xab\x8d\xba\xba\x05\x9f\x35\x46\x24\x8b\x52\xe3\x34\x6d" +
+	"\x18\x6e\xeb\xaf\xe2\x94\x49\x95\x30\x35\xfd\xda\xa8\x26\xc9\xee\x94\x6e\xea\xd2\x6a\x44\xe4\x25\x23\x66\x93\x93" +
+	"\x0c\x75\x04\xfa\x3b\xa7\x65\x23\x5d\x90\xb5\x39\xe7\xa5\x82\x3c\x7a\x8b\xeb\x71\x29\x7e\xb1\x65\x49\x61\x16\x12" +
+	"\x91\x1e\x37\xb3\x8e\x60\x0b\x34\x26\x53\xf5\x63\xe2\x41\xda\xd1\xa3\x8f\x0d\x6f\xed\x6d\xf9\x01\xce\xf8\x32\x02" +
+	"\xf5\x0a\xa6\xd7\x77\x74\x40\x60\x9c\xfb\x72\x34\x12\x76\x2e\x19\xe9\x4e\x23\x57\x5a\x7d\x69\x09\xfb\x29\x5d\x29" +
+	"\xba\xb0\xd8\x3f\xd5\x75\x19\xdd\x8a\x1a\x98\xfb\xc3\x70\x3a\x9b\x2a\x09\x70\xca\xab\x29\x1f\x20\x2c\x68\x76\x64" +
+	"\xfe\x43\x4b\xb0\xe3\x1c\x12\xd3\xef\x05\x81\x19\x97\x21\xde\xc3\xdf\x6a\x57\xd0\x3e\xbe\x13\x41\xc9\x12\x99\xcd" +
+	"\x65\x88\x56\xb5\x2b\x45\x54\x5b\xaf\x2a\xe6\xfa\x21\xbb\x52\xe9\xc8\xc6\x17\x3b\x5d\xc9\xca\x23\xa9\xe3\x93\x60" +
+	"\x4b\x3b\xf9\x8a\xd2\x8e\x51\x95\xe2\x4e\x6d\xa2\xe1\x38\x99\xdd\x5d\x51\x1f\x1f\xe2\x1c\x43\x9f\xec\x19\x40\xbb" +
+	"\x4b\xb2\xb2\x91\xac\x98\x59\x46\xb3\x0f\x5c\xcf\x76\x99\xcc\xd4\x99\xb4\x0a\x3b\xb2\xe1\x8a\xa3\x1b\xf9\xfc\x09" +
+	"\x5c\xe7\xc8\x2e\xe4\x09\x4c\x61\x74\xdf\xa8\x4a\xa9\x40\xb3\xfa\x4d\x86\x59\x54\x18\xd1\x85\xd7\x70\x02\xde\x64" +
+	"\x34\x7a\xdb\xeb\x7f\xb0\x74\xb7\x12\xc3\x9a\xd2\x6d\xb9\x75\x7d\xe7\x87\x2b\x16\xbc\xa2\x7e\xa1\x8a\xe7\xe7\xee" +
+	"\x74\xda\x7b\xe7\x36\x5b\x6d\x78\x21\xb7\xa2\xdb\x5d\xf1\x13\x6c\xde\x83\xec\x5d\x61\xeb\x9e\x9e\xa8\x6e\xe1\x2d" +
+	"\xa6\x9f\xfd\xc9\xf9\xf9\x70\x56\xd4\x4a\x0b\xfe\x02\xe9\x5c\xf1\x69\x46\x7d\x8d\x9f\xc0\x69\x1c\xd8\xce\xd3\xd2" +
+	"\x76\x56\xf3\x8a\x33\x98\x8c\x5d\x27\x17\x56\xff\xf6\xb1\x74\x7e\x3a\x57\x31\x98\xae\x6d\x57\xe3\x78\xaf\xdf\x26" +
+	"\xa8\xae\x8e\x31\x13\x94\xc8\xd0\xa7\x90\x22\x18\xfe\x8d\x31\xbd\x54\xdb\xdf\xd0\xa4\xd8\x78\x9c\x50\x8e\x47\x73" +
+	"\x3c\x69\x95\x04\x5d\x47\x02\x5b\xe1\x54\x1f\x44\x8f\x49\x30\x61\x64\x06\x65\x45\xae\xa5\xb4\x4a\x84\xef\xa0\x88" +
+	"\xd6\x0c\xde\xee\x3f\x91\x48\x25\xdb\x88\x63\x89\x5a\x6d\x91\xbb\x18\xa6\x45\xd4\x0b\x3f\x49\x90\x6b\xb3\x18\xdd" +
+	"\x5c\x98\xbe\x1c\x50\xd4\xa1\x0d\x69\x2c\x0e\x74\xc3\x9f\xa4\xfd\xae\xc2\xe8\x81\x5c\x89\x18\x66\x12\xdb\x66\xb0" +
+	"\xff\x4a\x3a\x62\x6c\x46\x2b\x28\x94\xa1\x3a\x32\x95\x87\x2a\xa1\x51\x56\xb2\x77\x94\x92\x10\xaa\x62\xcf\x25\xa1" +
+	"\xda\xb6\x5c\xda\xbe\xc0\x70\x80\xe3\xa8\x60\x78\x11\x39\xc2\x76\x78\xed\xa5\x08\x5a\x5f\xb9\x53\xe8\xbe\xa6\xb3" +
+	"\x9f\xe4\xd6\xe7\x36\x8c\x27\xf8\x2c\xcd\xe2\x8d\x3a\x14\x2a\xcc\x7e\x1f\x71\xec\xbd\xc1\x6c\xdc\x4f\xae\xa2\xb1" +
+	"\xb0\x27\x74\x8b\x43\x7e\x30\xb0\xd6\x99\x0f\x00\x2e\xee\xf1\xd5\xc0\x45\xa2\xad\xd0\x75\x0e\x75\x1e\x56\xda\x5f" +
+	"\x73\x74\x21\xe6\x61\x0b\x98\xb5\x2c\x15\x3e\xca\x5d\x44\xcb\xad\xf2\xfa\x73\x68\xd8\xb6\x04\x58\xad\xab\x78\xcc" +
+	"\x12\x88\xe0\x2d\x76\x38\xe3\xce\x0a\x71\x8a\x93\xc9\xd1\x62\x61\x21\x25\xc7\x18\xc8\x7c\x24\x2a\xef\xeb\x43\x48" +
+	"\xe1\x8c\xd1\xb1\x8a\x92\xd8\x96\x1e\xed\x7d\x18\x11\xf2\x10\x8c\x9a\x25\xf6\x61\x44\xc8\x72\x8c\x05\x77\xe8\xcf" +
+	"\x0d\xaa\xc8\x48\x86\xd1\x83\x42\xc1\x82\x89\x67\xf6\x82\xf9\x54\xb7\x44\x3c\xd5\xfa\x4f\x51\x99\x80\xa6\xa3\xce" +
+	"\x8d\x83\xeb\x3f\x0d\xff\xf6\x5d\x70\xa3\xb7\x3e\x50\x22\xba\xa1\x6b\x98\xc4\xed\x8b\xd4\x5a\x21\x3b\x25\x8f\xd0" +
+	"\xb1\xa7\x7c\xb6\x18\xb8\x13\x81\xbc\x3d\x34\xca\xd5\x48\x6e\x07\x41\x59\x17\x27\x28\xdd\x8e\xe1\x3e\xce\xf2\xa9" +
+	"\xa6\xa2\xba\x43\x43\x64\xc5\x19\x9e\xe7\x01\x15\x84\xdb\x71\x8e\xc5\x01\x21\xd9\xa2\x70\xf8\x46\xc1\xe5\xa2\x64" +
+	"\xd9\x5f\xd1\xcf\x0b\x32\x2b\xa1\xf4\xbb\x0e\x36\x0f\xcf\x14\x73\xa8\x93\x1a\xd0\x82\x29\x65\x01\x3a\xc8\xe1\x40" +
+	"\xc6\x0c\x53\x3b\xad\xc1\x56\x14\xd4\xcc\xda\xa9\x2f\x1c\x3a\xd1\xbe\xb3\x27\x9a\xa9\xbe\x8b\x5c\x38\x91\x6c\xac" +
+	"\xa5\x06\xea\xe6\x64\xa0\xc8\xd6\xef\x51\xb7\x11\xdf\x57\x7a\xb2\x35\xb2\xb2\x71\xe2\xea\xbf\xd1\xaa\xb6\x79\x16" +
+	"\xaf\xa2\x4a\x61\x2b\x19\x28\xf5\xce\x1e\x0d\xb9\x36\x8d\x49\x51\xdd\xa7\xa7\x1e\x57\x9e\x83\x29\xd4\x66\x3a\x67" +
+	"\x44\xa8\xaf\xb6\xd3\x80\x04\xda\x68\x3e\x72\xc7\xef\x66\xef\x9b\x8e\xd4\x52\x4b\x7a\x1a\xc3\x31\x5a\xd1\x2d\xf1" +
+	"\xc0\x97\xb7\x22\x1f\x7f\x36\x0e\xb5\x85\x1d\xb5\xd1\x69\xb6\x86\x5e\x2d\xf2\xc3\xa5\x2a\xde\x3c\x6d\x9f\xb6\x9c" +
+	"\x42\x45\x07\x9a\xe5\x7b\xcc\x73\x0b\x4e\x71\xa4\xfc\x4a\xf9\x2a\x95\x11\x33\x7c\x25\x9f\xd9\xc1\x2b\x23\xb4\x67" +
+	"\x61\x32\xc5\x40\x79\x8c\x51\x7e\x0e\x90\x04\x12\x74\x47\xcc\xd1\x3a\xca\x08\x2a\x62\x8f\xff\x78\x9d\x8b\x29\xda" +
+	"\x05\xf6\x84\x16\x4b\x46\x9c\xfb\xd7\xc5\xb1\xc0\x13\x0f\x38\x03\x7c\x1c\xba\x9f\x76\x8c\x3f\x2d\x58\x78\x2e\x32" +
+	"\x54\xfb\xdf\x6d\xc2\xe4\xe7\x48\x8f\xcc\x91\xf0\xed\x0b\xff\x72\x35\xb3\xed\x43\xfe\x15\x6c\x55\xc5\x52\x16\x10" +
+	"\xce\xec\xbe\x9f\x24\xcf\xca\xc4\x95\xfb\x33\xf2\x66\x1e\x1e\x40\x4d\x59\x0e\x32\x8b\x70\x15\x3e\xb0\xe2\x94\x6e" +
+	"\xed\x9c\xd3\xa8\x51\xc9\x86\x6b\xed\xea\x5b\xcd\xe9\xea\xda\xca\xe7\xf4\xaf\x36\x8b\x77\x75\xd1\x8e\x38\xd6\x37" +
+	"\xe8\xa2\x1d\x91\x8e\xdf\x47\x17\x59\x7f\x8f\x8c\xe8\x77\xb1\xa1\xc8\xcb\x1e\x5b\xc7\x8f\xac\xc0\xcc\xdc\x7e\x5a" +
+	"\xad\x88\xa5\x31\x61\x5f\x00\x3c\x60\x7a\xc9\x26\x93\xc7\xd8\xaa\xfc\x50\x79\xd4\x47\xc2\xd6\x7e\x18\xb5\x1a\x55" +
+	"\x7d\x39\xf0\x26\x17\x5c\x80\xe0\xb6\x2e\xee\xe4\xdd\x27\x4a\x9c\xc6\xd7\xf6\x47\xe5\xe8\x55\xcb\x11\x20\xca\x78" +
+	"\x9a\xa1\x41\x9b\xc1\x3e\xbf\x1c\x15\x3b\xa6\xd9\xaf\x48\xc5\x0e\x4e\xfe\x65\xa9\xb0\x96\x61\xe4\x39\xdc\x3f\xb1" +
+	"\xd8\x26\xb8\x1d\x1d\xf9\x2b\xd3\x0a\x0f\x69\xe2\x7a\xb1\xc4\x23\xf3\xd2\x4c\xf8\xfa\xd0\x55\x26\x64\xa6\xc2\x36" +
+	"\x3c\xcb\x27\x70\x14\x29\xcb\xa9\x80\x01\x5b\x65\x7e\x99\xfa\x67\xd9\x28\xed\xa2\x25\xf1\x1b\x39\xf6\x73\x1e\xfc" +
+	"\x52\xa5\x7f\xca\x8f\x81\x93\x5e\x36\xcb\x53\xa7\x54\x7d\x19\x16\xb0\x9c\xf5\xb9\x2e\xf8\xf9\xbe\xfb\x7f\x19\x8f" +
+	"\xb9\x92\x5c\xa2\xd3\x7e\x3f\xce\x72\x63\x3f\x9a\xb1\x8b\x4c\x5a\x52\x62\x23\x19\x9e\x65\xff\x84\x1a\x07\xdb\xa4" +
+	"\xa5\xfb\xc7\x50\xb0\x30\x38\xc5\xbd\xf3\x77\xb8\x91\x1e\xb5\xb2\x57\xe4\x73\x55\x77\x8c\x34\x37\x09\x3b\x16\x6a" +
+	"\x6b\xcb\x36\x52\xc8\x81\x9c\x2d\x59\x98\x94\xe1\xd6\x1f\xc3\x55\x2d\x97\x8e\xa6\x61\xdd\x68\x91\x01\xf1\xdd\x9d" +
+	"\xb8\x8f\x44\xee\x94\x2e\xdd\xe5\xc5\xc9\x7e\xa9\xc8\x16\x3b\xf2\x5e\x61\x15\x0f\x44\xb9\x51\x25\x79\xcc\x95\x70" +
+	"\x50\x71\xc9\xaf\xd9\xb1\x15\xa6\xca\x4f\x4f\x6b\x1e\xae\x0a\xca\x16\x43\x62\xd4\x4e\x40\xdc\xdb\x55\xc4\x9c\x93" +
+	"\x2e\x62\x18\x51\xbe\xb4\xc8\xcf\x6f\x51\xab\x54\x43\xea\x6b\xcc\xee\xc4\xb0\x25\xdf\x70\x16\x66\x3b\x37\x85\x59" +
+	"\x4e\x50\x0c\xe2\xb5\x77\x6e\x0c\x53\x4e\x49\xda\x38\x81\xdb\x73\x8d\x0f\x16\x9c\xe2\x3b\xf8\x2f\x74\x5f\x2d\xe2" +
+	"\xf5\x86\xe7\x44\x34\x4f\xf1\x00\x57\xb6\xde\xb4\xe1\x25\x74\x29\x58\x51\xef\x31\xc3\x3d\x66\x72\x76\xee\xde\x25" +
+	"\xa6\x8e\x06\x28\x3d\xda\xdc\xf0\xcd\xee\xf4\x94\x8b\xcb\x56\x68\x53\x6f\x29\x31\x38\x2c\x62\xdb\x73\x39\xb5\xfb" +
+	"\x76\x3d\x49\x33\xf8\x97\xdc\xb1\x64\xb5\xdd\xb0\xab\x9f\x3b\xf2\x4d\x1b\x4e\x5b\x8d\xbc\xed\x2c\x49\x00\x1b\x9d" +
+	"\x3d\xab\xca\x4e\x2f\x16\x67\x22\x8b\xb4\xb0\x5f\x62\x37\x53\xf1\xb0\x61\xbb\xbd\x3b\x4f\x2a\xb6\xfa\xf5\x57\xd9" +
+	"\x0a\xa5\x66\xb7\x76\x24\xaa\x7a\x4d\x3b\xa7\xc2\x55\x98\xdf\x12\x65\x3b\x0b\xf9\x2e\x86\x7b\x96\xdf\x05\x2f\xaf" +
+	"\xe1\x10\x55\x17\x74\x85\x8a\xcd\x51\x72\xfa\x74\xb9\x37\xb5\xd8\x1b\x25\x0e\xba\xc3\x1d\xa9\x82\x04\xec\x9f\xa2" +
+	"\x6f\x57\xfa\xf4\x7e\xea\xde\x1e\x41\xfa\xce\xed\x3d\xb2\x99\xc6\x0c\xff\x8d\xb6\xb6\x68\x2f\xb7\x1a\x23\xcc\x13" +
+	"\xe3\xc1\x21\x11\xcc\xe2\xf9\x4c\x28\x44\x1c\x3d\x52\x45\xf7\xa9\x54\xd4\x8d\x65\x33\xd4\xc6\x9f\xb1\x82\xe2\xba" +
+	"\x98\x6f\x97\xe8\x35\x5a\x06\x1b\x25\x96\xc8\x4f\xa1\xb3\xca\xfe\x77\xe0\x48\x1d\x47\x27\xed\x19\x63\x24\x0e\x76" +
+	"\x04\x18\x53\x63\x1c\x5b\x2a\x42\x44\x53\xa4\x83\xff\xc0\x31\xe5\x3f\x57\x37\x17\xd5\x05\x33\xe2\x6d\x4c\x1e\xca" +
+	"\x1e\x32\x48\x6b\x35\xcc\x36\x59\x1d\xa1\x5e\xd8\xb3\x57\x8e\x0b\x69\x8d\x78\x4b\x1c\xf6\x14\x57\xdc\xfc\x14\xfe" +
+	"\x39\x9d\x8c\xf9\xb9\xe4\x52\xe6\x4b\x7b\x09\x8f\xab\x4e\xb8\xf2\x84\x87\x23\x89\x63\xad\xf9\x4b\x3d\x87\xf1\x2b" +
+	"\xee\x04\xd3\xbc\xaa\x04\x11\x5f\x96\x2c\x60\xc1\xab\x3b\x44\x57\x95\x25\xca\x9d\x7b\x66\xb6\xdb\x01\x17\x8a\x89" +
+	"\xf1\x68\x1b\x63\xd3\x46\x3d\xb6\xe5\x54\xb9\x13\x55\x5a\x9b\x49\x54\x1b\xb2\x8e\xc6\x00\xc7\x70\x3e\x1c\x37\xcd" +
+	"\x47\x32\x69\x16\xfd\xbf\x6a\x5d\xb3\x4b\x89\xa3\x12\x0b\x5f\xac\x12\x87\x01\x63\x63\xc3\xb3\xab\xa6\x5c\x8a\xb2" +
+	"\xce\x9f\xe1\x6c\xe2\xf1\x21\xba\xe8\xcd\xde\xb7\xe9\x68\xa2\xc9\xe5\x6c\xde\xf3\xbc\xde\xd5\xfc\x93\xd7\xc3\xad" +
+	"\x02\x6d\x18\x8e\xfb\xa3\xcb\x81\x3b\x47\xf6\x9c\x7f\xec\x8d\x2e\xdd\x69\x0b\x9d\xd1\xff\xd3\xa8\x1d\x33\x8b\x73" +
+	"\x49\x6d\x15\x79\xb9\x96\xbf\xc3\x9e\x13\x90\x7d\x7b\xd7\x29\xed\x2f\x33\xba\x5e\x68\x2b\x6d\x43\x5d\x40\x17\x59" +
+	"\x1b\xde\x54\xf3\x90\x09\xdc\xd5\x7f\xff\x54\x7f\xd7\x08\xcd\x08\x4b\x29\x97\xfa\x3d\x4d\x23\x71\x8e\xa1\x16\x7a" +
+	"\xbb\x39\x58\x24\xb7\xa6\xf4\x46\xd9\x1f\xa8\x3a\xeb\x2c\xc9\x1d\x83\x64\xac\x09\x79\xd6\x4f\x77\x15\x13\x77\xe7" +
+	"\x8c\x5d\x0f\xfe\x39\x19\x8e\xf7\x82\x5a\x3e\xd2\x9c\x6c\x84\x04\xef\xf3\x6b\x26\xb9\x44\x4f\xda\x14\x64\xfe\xc6" +
+	"\xd5\x26\x31\x98\x90\x03\xa8\x9f\xbb\x78\x51\xe5\x7a\x56\x0e\xf2\x6f\xc6\x8a\xbb\x79\x4f\x0b\x68\x73\x99\xb7\x14" +
+	"\x74\x7d\xaa\x92\x05\x6b\x2e\xf7\x3f\x4f\x84\x4a\x40\xf1\xc1\x61\xd0\x8c\x26\xd7\xb1\x2a\x2e\x95\x7b\x23\xde\xc4" +
+	"\x05\x72\x85\x84\xd8\xc5\x19\x82\xb6\xa7\x79\x35\x79\xce\xcf\xe8\xfb\xb6\x26\x4b\x1f\x21\xb5\x57\x18\xe8\x96\x98" +
+	"\x86\xd0\xe4\x72\xd6\xb0\x48\xa1\xb9\x1e\xdf\xdf\x8b\xac\x51\xe9\xcb\x93\x79\x81\x74\xf6\x97\xf2\xdb\xc1\x2d\x5b" +
+	"\x19\x22\x45\xad\xc8\xe6\x40\xbf\x86\x13\x0b\x20\xa7\x32\x48\x2f\x9b\x25\x5a\xe4\xde\x28\x29\x5f\xa0\x29\xc1\x94" +
+	"\xc4\x69\x99\x0a\x82\x51\x5f\xdb\x6c\x5e\xab\x20\xed\x7e\xa2\x1f\xd2\xf0\x2b\xf2\x84\x61\x99\x2e\xf0\x2f\x93\x33" +
+	"\xfc\x2f\xe3\x5f\xbc\x67\xd9\x6f\xeb\x5a\xf4\x58\xb6\x4d\xe4\x8d\x97\x78\xa7\x2e\xb9\x85\x15\xe7\xbd\xca\xfb\x8c" +
+	"\xda\x65\xce\x3c\xf4\x58\x95\x5e\x01\x9a\xbf\xa3\xb8\x19\xde\x91\x71\xd0\xa6\x93\xf6\x94\x1d\xa6\x0e\x43\xaa\xf0" +
+	"\x6d\x6d\x53\x96\x90\xc0\x29\x73\x6e\x5d\xca\x97\x78\x18\x33\xfb\x71\xcb\x70\xd3\x7d\xce\x27\xb6\xcb\x29\xa6\x14" +
+	"\x74\x71\x83\xa7\x12\x9b\x42\xdc\x00\xe4\xf3\x5b\x51\xc9\x08\x23\xea\x23\xe1\xf7\xc2\x19\x33\x9e\x90\xd0\x84\xc9" +
+	"\xf8\x37\x70\x83\x69\x2b\x23\x0c\x4a\xbd\x3d\x86\x78\x2c\x77\x07\x1d\xec\x78\x3a\xd0\x6d\x64\x51\xd4\x05\xb5\x46" +
+	"\xe9\x15\x4f\x41\x98\x26\xad\xb0\x8b\x8d\x7b\x7f\x4c\x43\x1b\x67\x4d\x07\xb9\x61\xee\x2f\x16\x2c\x4d\x9b\x8a\x35" +
+	"\x0a\x51\x0a\x07\xe3\x87\x4e\xab\x0d\x27\x2d\xdc\x33\xa2\x85\x5d\x99\x98\x41\x1e\x22\xf3\x4d\x27\x11\x2e\xfd\x47" +
+	"\xc6\x63\x90\x1b\x3c\xee\x38\x4d\x71\x17\x5c\x6c\xe6\xa3\xe7\xb2\xf7\x0c\x4a\x4a\x0f\xba\xa8\xf0\x7a\xfc\xf6\xee" +
+	"\x00\xb1\x55\xbd\x51\xad\x15\x98\xcc\xd3\xcd\x2f\xf5\x5f\xa1\x21\x88\x89\xd5\x35\x66\xd9\xfe\xd3\x23\x75\x39\x43" +
+	"\x21\x30\x09\xc8\xa9\x05\xa5\xd5\x14\x0a\xd9\x1a\x83\xd6\x0c\x86\x67\xf6\x0b\xd3\x07\x50\xc9\x3e\x5e\xfc\x54\x91" +
+	"\x85\x8a\x5e\x90\x4a\x8e\x31\xa9\xfb\x8a\xb3\x51\xe8\x86\xb4\xde\xd4\x1a\x6e\xcb\xf5\xe9\x77\x10\x9b\x0d\x52\x38" +
+	"\x5d\x21\x10\x58\xec\xd1\xb4\x40\x8c\xbe\x14\x3b\x3c\xe3\xa7\x3c\x4c\xa0\xea\xc2\xb3\xe6\x8a\x87\x38\xd8\x67\x51" +
+	"\xd9\x6f\xac\x13\xa9\x14\x11\xea\xa9\x05\x9d\x76\x84\x09\x91\x7b\x9a\xf8\x4f\x85\xa9\x22\x51\x81\xe2\x7a\xdb\x12" +
+	"\x32\x9f\xda\x73\x0b\x7c\x32\x73\x4a\x3d\xbb\x7a\x74\x70\x9f\x89\x7b\x36\x43\xa6\xa9\xc2\xa8\x5a\x23\x56\xbf\x94" +
+	"\xf0\xe6\x8d\x1b\x3e\x06\xe3\x81\xb9\x5d\xce\x62\x5b\x51\xa7\x98\x40\xe2\x72\x3b\x03\xc7\xaf\xa3\xbc\x34\xf0\x02" +
+	"\x86\x75\x78\x9f\xe0\x7e\xff\x41\xfc\x14\x35\x28\xf4\xaf\x94\x19\x28\xd3\x66\x1a\xdf\x57\x41\x29\xfa\xf7\x83\xea" +
+	"\xfe\x17\xb0\xe8\x3c\x53\x37\xe7\xd7\x37\xc5\xff\xbb\xdf\x14\xaf\x55\xc1\xfa\xb6\xf5\xfa\xb6\xf5\xfa\xb6\xf5\xfa" +
+	"\xb6\xf5\xfa\xb6\xf5\xfa\xb6\xf5\xfa\xb6\xf5\xfa\xb6\xf5\xfa\xb6\xf5\xfa\xb6\xf5\xfa\xb6\xf5\x3f\xdc\x6d\xeb\xf5" +
+	"\xd5\xe3\xf5\xd5\xe3\xff\x9e\x57\x8f\xeb\x0b\x75\x76\xdd\x38\x56\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e" +
+	"\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf" +
+	"\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d\x5e\xdf\x3d" +
+	"\x5e\xdf\x3d\x5e\xdf\x3d\xfe\x47\xb9\x7b\xbc\xbe\x26\xbc\xbe\x26\xbc\xbe\x26\xbc\xbe\x26\xbc\xbe\x26\xbc\xbe\x26" +
+	"\xbc\xbe\x26\xbc\xbe\x26\xbc\xbe\x26\xbc\xbe\x26\xbc\xbe\x26\xbc\xbe\x26\xbc\xbe\x26\xfc\x0f\x70\x4d\x78\xab\x71" +
+	"\xc8\xd9\xfd\xf5\xb5\xe0\xf5\xb5\xe0\xf5\xb5\xe0\xf5\xb5\xe0\xf5\xb5\xe0\xf5\xb5\xe0\xf5\xb5\xe0\xf5\xb5\xe0\xf5" +
+	"\xb5\xe0\xf5\xb5\xe0\xf5\xb5\xe0\xf5\xb5\xe0\xf5\xb5\xe0\x7f\x8c\x6b\xc1\xe9\x3c\x1e\x71\xaf\x4f\x21\xc4\x2d\xf6" +
+	"\xb9\x7e\xdf\x30\x0f\x77\x96\x91\x70\x6d\xcd\x2a\x87\xdd\x4d\x43\xcc\x20\xf5\x04\x17\xfe\xe1\x58\x4d\xbb\x30\xc8" +
+	"\xe1\x50\x25\x45\xc1\xfc\xe1\xeb\xad\xaa\xca\x0b\x55\xe6\x4b\x36\xbe\x6f\xfc\xf7\x00\x70\xe6\x9d\xc4\x85\xc9\x00" +
+	"\x00")
+
+func bindataMigrations20190624090000LineagesqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190624090000Lineagesql,
+		"../migrations/20190624090000-Lineage.sql",
+	)
+}
+
+
+
+func bindataMigrations20190624090000Lineagesql() (*asset, error) {
+	bytes, err := bindataMigrations20190624090000LineagesqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190624090000-Lineage.sql",
+		size: 51589,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792403103, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190701100000Consumeraccesssql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x1c\x6b\x6f\xdb\x38\xf2\xbb\x7e\xc5\x7c\x59\xd8\xbe\xd8\x6e\xd2\xdb" +
+	"\xbd\x3b\xa4\xeb\x22\xaa\xad\xa4\x3e\x38\x52\x57\x92\xfb\x40\x60\x18\x8c\x44\x3b\x44\x65\xc9\xd5\x23\x6d\x81\xfe" +
+	"\xf8\xc3\x50\x24\x45\x3d\x9c\x74\x8b\x6d\x77\x17\x67\xb9\x40\x23\x72\x38\x1c\xce\x7b\x38\x89\x8d\xd1\x08\x4e\x76" +
+	"\x6c\x9b\x92\x9c\xc2\x72\x6f\x98\x0b\xdf\x72\xc1\x37\x5f\x2c\xac\x9b\x1d\xcd\xc9\x6a\x7c\x13\xb1\xf8\xfd\x0a\xcc" +
+	"\xd9\x0c\x6e\xc8\x36\xa5\x74\x47\xe3\x7c\xcd\xc2\x15\xdc\xdc\xb2\x2d\x8b\xf3\x15\xd8\xcb\xc5\xc2\x78\x66\x2c\x5f" +
+	"\xcd\x4c\xdf\x82\xc8\x00\x00\xcf\xf2\x41\x87\x86\x09\x84\x63\x7d\xc0\x00\xb8\x74\x9d\x6b\xc0\x4d\xc6\xb8\x05\x44" +
+	"\x43\x5c\x88\x1f\x3e\x16\xd2\x88\xdd\xd3\xf4\x33\x84\x06\xbc\x79\x69\xb9\x16\x84\x63\x8e\x27\x1a\x87\x1f\xd7\x72" +
+	"\x76\xcd\x42\xe3\x59\x49\xb5\xf1\x7a\x6e\xbd\xd1\x89\x5e\xdf\xaf\x60\x34\xfa\x62\x8c\x46\x5f\x60\xf2\xdd\x1e\x8e" +
+	"\x7e\x46\xb3\x20\x65\xfb\x9c\x25\xf1\x39\x58\x9f\x72\x9a\xc6\x24\x02\x24\x02\x42\x9a\x13\x16\x65\x90\x6c\x40\x10" +
+	"\xcd\x68\x06\x23\xc8\xef\x68\xc5\x21\x60\x19\xbc\xa7\xfb\x1c\x92\x98\x4f\xe0\xca\x21\x64\x09\x47\x5e\x7b\x42\x1a" +
+	"\x33\x1a\x42\x4a\xf3\x94\xd1\x7b\x12\x65\xd0\xff\xc8\xf2\xbb\xa4\xc8\x25\xfa\xcf\x03\x20\x29\x85\x94\x46\x24\xa7" +
+	"\x21\xe4\x49\x63\xab\x3c\x49\xbe\x37\x4b\x4c\xcf\xf0\xac\x85\x35\xf5\x21\x1a\xb3\x50\xc9\x35\x1a\x53\xc1\x9a\x75" +
+	"\x6d\xb4\x2e\x4f\x6d\xa2\xc8\x68\x5a\x1f\xc9\x72\x92\x17\x59\x7d\x2c\x48\x29\x9e\x34\xdf\xa9\xb1\xa9\x63\x2e\x2c" +
+	"\x6f\x6a\xf5\xeb\x3a\x37\x84\xa8\xf1\x3e\x3a\x1b\x80\xe9\x55\xcc\xd1\x11\x2b\x24\x05\xa7\x23\x26\x3b\x3a\x84\x9e" +
+	"\xfd\xc4\xec\x0d\x00\x17\xe1\xe0\x5a\xcd\x74\xac\x4a\x29\x89\xba\x57\xa9\x99\xd6\xaa\x70\xdc\x5a\xa1\x58\x83\x33" +
+	"\x1d\x66\x23\x71\x2c\xac\x4b\x1f\x9c\x25\xda\xef\x7f\x9d\xb9\x7d\xd0\x98\x70\x10\xc0\xb1\xa1\x7f\xc0\xa0\x06\x5f" +
+	"\x83\xf1\x06\x4f\xbe\x82\x42\x0e\x22\xbe\x42\xe2\x13\x72\x1b\x18\xcf\x8c\xa9\x6b\x99\xbe\x55\xb3\xcd\x8a\xdb\x41" +
+	"\x12\x67\xc5\x8e\xa6\x7f\x96\xa5\x4e\xc5\xfe\x19\xf4\x91\xe4\x6c\x20\xed\x8a\xc5\x5b\x08\x49\x4e\x20\xd9\x00\x89" +
+	"\x35\xe3\x41\x5b\x83\xb8\xd8\xdd\xd2\x14\xe7\xb2\x22\x08\x68\x96\x6d\x8a\xa8\x6d\xa9\x24\x0e\x3b\xac\x15\x47\xd1" +
+	"\x1e\x37\x2c\xcd\xf2\x27\x11\xc9\x72\x20\x1c\xc7\x8f\xb4\xc9\x4e\x7d\xef\x32\xb7\x6e\x1d\x8f\xc6\xdd\x5a\xec\x2d" +
+	"\xaf\xfb\x53\xd3\xb3\xd0\x5f\xdb\xba\xb1\xc2\x04\xce\xc0\xc7\xc1\x33\xb0\x16\x9e\x05\xa7\x60\xd9\x33\x6e\x7a\x8a" +
+	"\x35\xeb\x20\x29\xe2\xfc\xf7\xe0\x3a\x2d\x71\x9d\x29\x5c\x25\xb7\x1b\x88\xa6\xce\xd2\xf6\xfb\xb3\xb9\xe7\xcf\xed" +
+	"\xa9\x0f\x8f\xe0\x6c\xda\x82\x86\x5b\x8c\xd5\xb1\x5f\xcf\xed\xbe\xe6\x81\x38\x28\x17\xed\xba\x94\x6a\x05\x68\xbe" +
+	"\x7d\xec\x3c\x1a\x1e\xb5\x6d\x44\xbe\x11\xd5\x81\x63\x70\x7c\xda\x44\xcb\x9f\xac\xef\x31\x80\x5f\xb9\xce\xf2\x15" +
+	"\xbc\x78\xd7\x72\x98\x42\xf4\xda\x8f\x4a\x3b\x9a\x6a\xf1\x98\xf1\x17\x19\xd9\xd2\x3f\xd7\xf2\x79\xc0\xe6\x36\x2e" +
+	"\xa9\xca\x60\x93\xa4\xb0\x61\x71\xc8\x5d\x00\x25\x21\x6c\x28\x0d\xb3\x73\x90\xbe\x2a\x1b\x6a\xe6\x3c\xe4\x0c\xed" +
+	"\xb0\x7d\x2e\x31\x65\xec\x95\xc3\xd8\x17\xb7\x11\xcb\xee\x68\x28\x15\x0a\x93\x81\x98\xde\xd3\x54\x62\xa5\xe1\x8f" +
+	"\xf3\x04\x04\x1d\xf6\xa1\xf8\x47\x78\x28\xaa\x4f\x77\x07\xad\x60\xac\x1c\x79\x69\x1d\x70\xca\x95\xad\x31\xda\xb1" +
+	"\x4e\x71\xb2\xbe\xb0\x39\xdc\xb1\xb2\x66\xeb\x70\x7a\xd8\xfe\x83\x71\x97\x0d\xf5\x05\x07\x4a\xef\xf0\x0f\x15\xf2" +
+	"\x40\xb7\x87\x76\xd4\x04\x95\x8a\xea\x2c\x83\x09\xe7\xa4\x86\x03\x4c\x7b\x06\xd6\xdb\xb9\xe7\x7b\x6a\xab\x33\x0d" +
+	"\x33\x29\x42\x96\x43\x21\xb0\x15\x6a\x27\x99\x29\xa3\x58\xec\x19\x14\xe8\x26\xb6\xb4\x1c\xfd\xe7\x80\x1f\x52\x69" +
+	"\x50\xe3\x9c\x7f\xcb\x13\x35\x37\xb0\x1d\xff\xa1\x4d\x30\x2d\x86\x48\xec\xd1\xf2\x71\xda\x36\x0d\xa7\x58\x72\xae" +
+	"\x88\x85\x42\x0a\x15\xa9\xf9\x3e\x75\x7a\x20\x8f\xa4\x3f\x92\x30\x9d\x5f\x4a\x0a\xf0\x58\xd4\x69\xa8\x36\x3c\x87" +
+	"\xd3\xd2\xfb\x0b\xbf\xaa\x3c\xf5\x01\xe3\x91\x1f\x8c\xb5\x0d\x5c\x0f\x9a\x8e\xfc\xe0\x3a\xdd\x4e\x0e\x1b\x8e\x7c" +
+	"\x30\xdc\x68\x26\xd4\x0c\x4b\x1a\x78\x07\x37\xb5\x14\xaf\x02\x54\xd1\x45\x67\xe1\x00\x02\x9e\x94\x06\x5d\x8a\x38" +
+	"\x50\x45\xde\x2b\xd7\x99\x5a\xb3\xa5\xab\xca\xd3\x2d\xcd\xd7\x98\xaa\xfd\x39\x71\xc4\xa5\x79\x91\x96\x35\x1b\x12" +
+	"\x91\xd1\xbc\x59\x78\x49\x0d\xad\xf4\x05\x76\x24\x0f\xee\x30\xbe\x88\x65\xf4\x49\xce\xd0\xaf\xb6\xc2\x08\x1b\x53" +
+	"\x69\x48\x34\x04\x92\xf1\x7d\xb0\xac\xcb\xaa\x6a\x0f\x5e\x58\x97\x8e\x6b\xf1\xa9\x0b\x39\x88\x1c\xa1\xb0\x4f\x93" +
+	"\x7b\x16\xd2\x70\xdc\xc6\x3c\xa3\x1b\x52\x44\x79\x86\x54\x06\x45\x9a\xa2\xe2\x2b\x4a\xc6\xf3\x8d\x86\x8a\x85\x58" +
+	"\x9d\x4a\x5c\x43\x20\xf1\xe7\x0a\xb4\x8d\x19\x05\x9e\xa7\x84\x61\x2c\xc5\x3a\x34\xb9\xa7\x69\xca\xc2\x90\xc6\xe3" +
+	"\xcb\x24\x85\x99\xb5\xf0\xcd\x8a\x17\xe5\x91\x24\x05\x68\xb4\x14\xcf\x99\x6c\xda\x88\x6b\xcc\x64\x19\x6a\x7a\x91" +
+	"\xc6\x34\x84\x7e\x46\x69\xa9\x76\x5c\x17\x68\x94\x93\x75\xf6\x21\x1a\x70\x14\x66\xba\x2d\xf8\x46\xe7\x46\x9f\x6b" +
+	"\xe0\x85\x4c\x58\xc0\x7e\x6d\xba\xd3\x97\xa6\xdb\x7f\xfa\xcb\xe9\x60\x88\xea\x03\x4b\x39\x97\x6c\x20\xa5\x1f\x0a" +
+	"\x9a\xe5\x49\x6a\x5c\x70\x70\x49\x87\x7a\x3a\xd6\xdb\x62\xad\x3a\x9f\x71\xa1\x95\xbc\x72\x21\x00\xbc\x98\x5f\xcd" +
+	"\x6d\x7f\x28\x5f\x71\xef\xf9\x0c\x92\x0d\x48\x70\x64\x31\x01\x96\xd3\x5d\x9f\x8e\xb7\x63\x60\x31\x98\xe6\xb4\x8c" +
+	"\x53\x0d\x31\x6b\x74\x0c\x86\x25\xae\x19\xf2\x31\xcb\x53\x54\xb2\xfe\xbb\x77\xef\xde\x8d\xae\xaf\x47\xb3\xd9\xcd" +
+	"\xcb\x97\x4f\x7f\x3e\xbf\x9e\x9f\x7b\xde\x6a\x80\xbb\x95\xba\xa4\x99\xf0\x63\x1f\x44\xae\x84\x50\xec\xa1\x88\x73" +
+	"\x16\x35\x88\xea\x38\x28\xb4\x0f\xaa\xb0\x8c\xe0\x36\x22\xf1\xfb\x27\x78\x8b\x04\x93\xe7\x82\x26\xb8\x25\x19\x0d" +
+	"\xf1\x3e\xe4\x77\x52\x57\x67\x8e\x31\x30\x4c\x0f\x0c\xbc\x8e\xb2\x1d\xee\x94\xc1\xb1\xf9\xab\x69\x7b\xf3\xf5\x1b" +
+	"\xd3\xb5\xe7\xf6\x95\x07\xce\xe5\xa5\x81\x67\x1b\x7d\xb7\xc7\x78\x61\x5d\x89\x20\x32\xb3\xa6\x0b\xd3\xb5\xe0\x22" +
+	"\x27\xb7\x11\x5d\x67\xc1\x1d\xdd\x91\x4a\x1d\x7f\x39\x1d\x74\x81\x09\x25\x54\x60\x67\xa7\x4d\x38\xa5\x75\xa8\x6b" +
+	"\xa5\x86\xd5\x01\xb2\x0f\x51\xb5\xcd\xb5\xf9\xb6\xb1\x1e\x19\x26\x59\x09\x78\x97\xe7\xcf\xaf\xad\x3a\x88\x8c\x52" +
+	"\x5d\xd8\xb9\xe5\xc9\xe5\xf5\x6d\x0c\x29\x1d\x8f\xe6\xc5\x5e\xf9\x3d\x9e\x6c\x37\xfc\x19\x07\x45\x01\x95\xe4\x4c" +
+	"\xaa\xa4\x6f\xea\xd8\xaf\x2d\xd7\xef\x4b\xca\x86\x0d\x43\x18\xc2\xd9\x53\x34\xe4\x2b\xcb\x47\x90\xfe\x40\xed\x0b" +
+	"\x5f\x60\x9a\x44\x11\x0d\x72\xee\x27\x70\x6f\x22\xa6\x4e\xe0\x8a\xe6\xf5\x1b\x32\x3c\x9f\xd2\x3e\x71\xe9\x82\x37" +
+	"\x9a\x3c\xe7\xa9\x33\x79\x02\x2a\x49\xea\x08\x7e\x86\x96\x5f\x21\x1e\x98\x00\x77\x25\x3a\x59\x77\x34\x78\xcf\x2f" +
+	"\x85\x60\x4f\xd3\x1d\xcb\x32\x96\xc4\x59\xc5\x03\xc9\xef\x49\x89\x9a\xbf\x96\x71\xb7\xaf\x1c\xd9\xb0\x2e\xf9\x21" +
+	"\xf4\xb0\xe0\xea\x95\xc2\x9d\x5f\x56\x0c\x94\xd8\x78\x9a\x3d\x81\x53\xe0\x10\x95\x5e\x4a\x8e\x2c\x92\xe4\xbd\x90" +
+	"\x12\xae\x50\x17\x8c\x88\x56\x27\x73\x80\x5e\x29\x49\x43\x9a\x62\x18\x89\x92\x6d\x95\x7d\x00\xc9\x73\xba\xdb\x2b" +
+	"\xd7\x22\xb9\x57\x9d\x47\xcf\x2e\x2b\xde\x95\xd7\x4a\x6a\x46\x64\x93\xd2\x2b\x4f\x40\x1d\xda\x50\x30\x48\xf1\x3c" +
+	"\xce\x68\x5a\x8a\x71\x43\x58\x44\xc3\xf2\xee\x95\xc5\x3c\xa4\x14\x19\xd0\x34\x4d\x52\xb5\x64\x6e\x7b\x96\xeb\xc3" +
+	"\xdc\xf6\x1d\xb1\x2d\x82\x57\xdb\x8a\xa7\x2f\x9d\x31\x96\x45\x50\xcf\x36\x87\x32\x61\x1b\x8a\x2d\x38\x8c\x2e\x87" +
+	"\x2a\x03\x7f\x6d\x2e\x96\x96\xd7\xd7\x43\x81\xae\xbb\xfc\x4d\x61\x7b\xda\x10\xe7\xc0\xa8\x8e\xea\x9a\x73\xcf\x72" +
+	"\x5d\xc7\xed\xf7\x30\x52\xc1\xcd\x4f\xd9\x0a\xc2\x04\x4b\xc8\x24\x87\x3b\x72\x4f\x9b\x32\x42\x07\xaa\xb0\xc1\xcd" +
+	"\x4f\xf3\x7f\xfd\x1c\xae\x7a\x43\x38\x3b\x1b\xc2\xd9\x10\x0e\xe9\x50\x45\xbb\x6b\xf9\x4b\xd7\x86\xa7\x7c\xc0\xb2" +
+	"\x67\x52\x77\x4f\xc0\x77\x66\xce\x39\x70\x72\x46\x2f\x4d\x7b\xb6\x98\xdb\x57\x72\xf6\x8b\xb2\xaa\x6b\xf3\xed\xda" +
+	"\xbc\xb2\xd6\x33\xf3\x9d\x87\x4a\x91\xb2\xdb\x42\x1a\x3e\xdb\xb1\x5c\xa6\x41\x95\xe6\xdc\x92\x80\x4b\x8e\xa7\x17" +
+	"\x35\x07\xb3\x23\x9f\xd6\x58\x3c\x84\xe4\x73\x06\x2d\xf7\xa3\xcf\xae\x83\xb6\x3b\x95\x2a\xd8\x80\x9b\xc0\x3d\x89" +
+	"\x0a\x6a\x34\x35\xb1\x62\x87\xa2\x5a\xe6\xb0\xa5\x56\xea\xfc\x42\xcb\xd6\xdf\x25\xfb\xb0\xa6\xa9\x96\x0b\x1d\xee" +
+	"\xe9\x3c\xe9\x49\x96\xc1\x09\x78\x14\xd3\x3a\x9e\x98\xc1\xbf\x2b\x1f\xa0\x13\x0c\x13\x31\xc3\x17\x38\x65\x6e\x45" +
+	"\x01\x2d\x14\x48\x90\x17\x24\x2a\x8f\x03\x6c\x83\x3f\xb0\x10\x2f\x2b\x69\xca\x02\xe9\x0c\xf8\xd9\x02\xf4\x3a\x6b" +
+	"\x31\xd3\x6f\x70\x04\xdd\xa8\xf2\x10\x5d\x04\x4c\x4d\xcf\x6f\x2e\x02\xd3\x43\x89\x94\x9c\xb6\xde\x5a\x53\x59\x6f" +
+	"\xde\x16\x5b\xb8\xb8\xc0\xac\x7d\x3e\x1b\xd6\x51\x71\x58\x71\x98\x52\x61\xae\xcd\xb7\x55\x76\xc1\x42\x7e\xac\x9b" +
+	"\x32\x0e\x8c\x1a\x64\xfc\x3a\x81\xea\xb6\xec\xd7\x09\x70\xa8\x15\x1e\x3b\x4e\x20\xdb\xd3\x80\x6d\x58\x50\x61\xd7" +
+	"\xb1\x6a\xd9\xac\x98\x3f\x01\xdb\xf1\xad\x73\xf0\xef\xa8\xc8\x41\x93\x4d\x3b\x53\x55\x86\x46\xb6\x14\x9b\x33\x3c" +
+	"\x13\xa6\x24\x8d\x18\x4d\xab\x0d\x3e\xb2\x28\x82\x30\xa9\xc4\x57\x86\xc5\x49\x47\x86\xda\xaf\xe9\xcc\x90\xf7\xd1" +
+	"\x3a\xbc\xb6\x44\xad\x79\x6e\xa9\x5f\x4a\xa9\x35\x18\x98\xf0\xcb\x46\xdd\x88\x75\xbd\x96\x90\xd5\xe4\xd7\xab\xb3" +
+	"\x54\xe9\xbe\x70\x79\xc8\x73\x78\x61\xf9\x6f\x2c\xcb\x86\x6e\x39\x21\x38\x9f\xd1\x71\xf0\x8f\xe3\xd6\xf1\x48\x21" +
+	"\xf2\x02\x5e\x70\x6d\xee\xa1\x64\x4a\xc6\x0c\x8c\xc7\x94\xab\x45\xf2\x03\xb0\x1a\xc3\x74\x3d\x2c\xd3\x81\x94\xee" +
+	"\x13\xe0\xc9\x59\x3b\xfe\xd7\x72\xb6\x09\xe8\xaf\xb5\xd2\xb9\x9e\xb5\x49\x40\x85\xae\xdb\xd5\x94\x97\x23\x25\xe8" +
+	"\x37\x39\x9b\x06\x75\x3d\x3c\x89\xee\x62\x1e\x72\xda\x55\x2a\x14\x24\x51\xb1\x8b\xcb\xbb\x50\x7c\xaf\xf8\x51\x73" +
+	"\xb9\x29\x0d\x60\xba\x74\x3d\xc7\x85\x4b\xc7\xd5\xb9\x54\x22\xa8\x5f\x17\xe2\x07\x13\xaf\x75\xfe\x79\x5f\x1f\x0d" +
+	"\xee\x48\x4a\x82\x9c\xa6\xeb\x1d\xf9\xc4\x76\xc5\x6e\x1d\xd1\x78\x9b\xdf\xd5\x80\x84\xb7\x5a\xef\x53\x1a\x30\xcc" +
+	"\x92\x3a\x67\xb3\x80\x44\x6d\x06\x0b\x72\x76\x64\xbf\x67\xf1\xb6\xce\xd9\x06\xc7\x84\xd8\xca\xf7\x36\x67\x65\x1a" +
+	"\xd2\x94\xa6\xe3\xce\x2c\x17\x2f\xcc\x93\x34\x64\x18\xe2\xf7\x49\xc6\xf0\x8a\xa0\x62\xae\xb3\xa7\x31\x04\x45\x9a" +
+	"\x89\x44\xc4\x79\xc5\xaf\x83\x82\x0a\xe2\x55\x4a\xf7\x58\x32\xf7\x43\xb2\xc9\xe1\xda\x03\xef\xb7\xc5\x00\xee\x49" +
+	"\xca\x70\xb7\xac\x1e\xee\x34\x16\x57\xb1\xee\xec\xe9\x7f\xda\x39\x7d\xc9\x71\x71\x94\xea\xf3\xc0\xa2\x43\x02\x81" +
+	"\x76\xd4\x6d\x89\x05\xfc\xb9\xfd\xee\x20\x18\x97\x8f\xa4\x40\xe4\x62\x0d\xd0\x20\xd1\x8a\x94\x5f\xb0\xc8\x11\x9a" +
+	"\xe5\x97\x05\x8c\x08\x40\x3d\xa1\x6a\x3d\x0c\x3d\x0a\x1e\x8b\x1a\xb1\x80\x47\x48\xc1\xd2\x3a\x47\xa3\x24\xd9\x73" +
+	"\x90\x4b\xcb\x9f\xbe\x04\xdb\x7a\xeb\x97\xca\x92\x52\x11\x27\x31\x31\xd4\x39\x3c\xd4\x18\x39\x3c\xcc\x9f\x61\x07" +
+	"\x3f\xb4\x31\x7e\x78\x49\xdc\x17\x58\x24\xc9\x9e\x5f\x8f\x28\x7b\x63\xb1\xee\x7a\xd0\xfa\x6a\xde\xfa\xb0\x3b\xeb" +
+	"\x55\xb8\x22\x96\xe5\x90\x6c\x24\xce\x1e\x5f\xf9\xe6\xe5\x7c\x61\xc1\xc5\x05\x3f\xf0\xda\xf3\x4d\x7f\xe9\xa9\x38" +
+	"\xd2\x2c\x00\xbe\xc0\xb5\xf9\xea\xbc\xaa\xbb\x79\x0e\x80\x52\x99\xa8\x21\xfc\x87\x2d\xa8\xda\x80\x5c\x5d\x13\x06" +
+	"\x4c\x9e\x57\x03\x3f\x9f\x9e\x9e\x0e\x20\x2c\x28\xd6\x0b\x57\xcb\xf9\x13\xd3\x9c\x42\x40\xe2\x32\x7b\x8d\xc3\x88" +
+	"\x62\xe8\x6a\x21\xe5\x17\xa7\x9a\x26\x4f\xa0\x17\xdf\x93\x14\xa5\xd0\xe3\x5e\xef\xa0\x40\x60\x02\x23\xd1\x12\xeb" +
+	"\x95\x5a\x03\x27\x75\xd3\x39\xe9\x52\x20\x68\x82\x75\x9e\xf3\xd7\x24\xbf\xc3\xd2\xe7\xf3\x9e\x66\xcf\xf1\x9c\x23" +
+	"\xb0\x13\x10\x4e\x06\x46\xad\x35\xbc\x5b\x79\x10\xab\xca\xad\xa5\x37\x70\xe9\x9e\x92\xbc\xae\xb9\x1b\x9a\x07\x77" +
+	"\x12\xea\x90\xf6\xfe\x10\x05\x7e\x38\xba\x06\x49\x24\xa1\x34\xbb\xe5\xff\x9d\x88\xfc\x11\x15\xaa\x6d\xb9\x62\x11" +
+	"\x66\xaa\x6d\x6d\x3d\x84\xab\x37\xec\x72\x02\x35\xa6\x3e\x60\x3a\xd3\x28\xc9\xa8\xe8\xd6\x93\x28\x4a\x02\x4c\x46" +
+	"\x4a\x3f\x5d\x1a\xcf\x74\xe1\x78\x96\xe2\xee\xcc\x32\x17\x0b\x67\x8a\xbf\xe9\xa4\x9c\xb7\x32\x69\xad\x0e\xc5\x8a" +
+	"\xd2\xf8\xca\x62\xf3\x8f\x28\x34\xcb\x22\x13\xbe\xb2\xca\x3c\x6b\x57\x99\xf2\x0c\x97\x2c\x66\xd9\x1d\x67\x08\xfd" +
+	"\x44\x03\xac\xd6\xbc\xdf\x16\x7c\x73\x0e\x0d\x49\x91\xef\x8b\x9c\x57\x6e\x12\xbb\xf0\x58\x7d\x75\xc1\xcb\xa1\x07" +
+	"\xdf\x92\x04\xeb\x14\xab\x64\xb8\x9d\x0e\x1a\xf0\xb0\x7e\xf5\x4a\x9b\xe8\x32\xee\x13\x41\x8e\x2e\x81\xda\xa3\x50" +
+	"\xa8\xa8\x5e\x17\x09\x98\xde\x94\x8b\x29\x4d\x3e\x8a\xd7\x83\x0a\xb8\xf0\xac\xaf\x24\xf5\x86\x3b\x1d\x3d\xfd\x80" +
+	"\x13\xe8\xad\xc6\xfa\xb8\x74\x59\x2b\xe8\x3d\x40\x7d\x4f\xb4\xf4\xea\x44\x4f\xb8\x57\xe3\x27\xd3\x79\xac\x13\x3e" +
+	"\x38\x70\x8a\xae\xa7\x93\x49\x0f\x33\xe4\x51\x53\xb4\x84\xbe\x7d\x28\xb0\x92\xca\x13\x71\xc7\x8f\xe5\xda\x1e\x6b" +
+	"\xac\xb2\xc3\xd2\x7b\x04\x0d\xf2\xb6\x02\xc9\xf6\x6b\xa1\xc6\xc8\x79\x3e\x87\x5e\xe1\x3b\x37\x8a\x9e\x19\xb5\xdf" +
+	"\xac\x9c\x25\x1f\xe3\x63\x03\xeb\xd8\xc0\x3a\x36\xb0\x8e\x0d\xac\x63\x03\xeb\xd8\xc0\x3a\x36\xb0\x8e\x0d\xac\xbf" +
+	"\x60\x03\xeb\x5b\x5b\x56\xc7\x1e\xd5\xb1\x47\x75\xec\x51\x1d\x7b\x54\xc7\x1e\xd5\xb1\x47\x75\xec\x51\x1d\x7b\x54" +
+	"\xc7\x1e\xd5\xb1\x47\x75\xec\x51\x1d\x7b\x54\xc7\x1e\xd5\xff\x6f\x8f\xea\x9b\xba\x52\xc7\x36\xd4\xb1\x0d\x75\x6c" +
+	"\x43\xfd\x91\x6d\xa8\x99\xeb\xbc\x2a\x2f\x5c\x64\xbf\xa9\xfd\x17\xb8\x8f\x80\x55\x7f\xc2\xb5\xfa\x3b\x7e\xd7\xc6" +
+	"\x8f\xfb\x73\xda\xbf\xd8\x97\x5d\x1c\xbf\xdc\xe2\x7b\x7f\xb9\x85\xf6\x6d\x39\xca\x6e\x90\xee\x15\x70\xb3\x9b\x3a" +
+	"\x8b\xe5\xb5\xdd\xf8\xda\x1c\xe3\x99\xf1\xbf\x01\x00\x1c\x41\x18\x3d\x75\x47\x00\x00")
+
+func bindataMigrations20190701100000ConsumeraccesssqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190701100000Consumeraccesssql,
+		"../migrations/20190701100000-Consumer_access.sql",
+	)
+}
+
+
+
+func bindataMigrations20190701100000Consumeraccesssql() (*asset, error) {
+	bytes, err := bindataMigrations20190701100000ConsumeraccesssqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190701100000-Consumer_access.sql",
+		size: 18293,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792397150, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190708090000Dataasofsql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x6f\x6f\xdb\xb6\xf3\x7f\xae\x57\x71\x4f\x06\xdb\x88\xed\x25\xc1" +
+	"\xfa\xeb\x0f\x69\x3d\x44\xb5\x99\xc4\x80\x23\x75\x92\xd2\x34\x08\x0c\x83\x91\x18\x47\xa8\x2c\xb9\x12\x95\x36\x40" +
+	"\x5e\xfc\x17\x47\x51\x14\x25\xd9\x4e\xd7\xad\xed\x1e\xd8\x19\xb0\x49\x3c\x9e\x8e\xf7\xe7\x73\xc7\x23\x67\x0c\x06" +
+	"\x70\xb0\x0a\x97\x29\xe5\x0c\xae\xd6\xc6\xd8\x21\xa6\x47\x8c\xb3\x2b\x6b\xec\x4d\x6d\x0b\x6e\x57\x8c\xd3\xf9\xf0" +
+	"\x76\xc9\xf8\x62\x45\xbf\x2e\xe8\x92\x2d\x02\xfa\x94\xcd\x61\x30\x78\x36\x06\x83\x67\x18\xfd\xb0\x9f\x60\x3f\x61" +
+	"\x99\x9f\x86\x6b\x1e\x26\xf1\x09\x38\x8c\xe7\x69\x0c\xfc\x81\xc1\xa5\xf9\x71\x61\x9e\x93\xc5\xc4\xbc\x71\x81\x72" +
+	"\x9e\x86\x77\x39\x67\x90\xdc\x03\x8d\x81\x2e\x53\xc6\x56\x2c\xe6\x7d\x08\x87\x6c\x88\xf4\x71\xbe\xba\x63\x29\x8e" +
+	"\xa3\xf8\x82\x75\xed\x77\x47\xfd\x4f\x10\xc6\xc0\xc3\x15\x03\x0a\x01\x8b\xc2\x47\x96\x3e\x41\x98\xc1\x3d\xe3\xfe" +
+	"\x03\x0b\xe0\xee\x09\x50\x0d\x01\xe5\x14\x06\xf0\x1a\xc2\x7b\x88\x13\x0e\x14\x1e\x69\x14\x06\xf2\x0b\x82\xb3\x99" +
+	"\x2e\x73\xfc\x7c\x76\x62\x74\x0d\x64\x7f\xaa\x24\x5a\x84\x01\xbc\x9b\x9e\x4f\x2d\x0f\x50\x85\x30\x9d\xa0\x4c\x6a" +
+	"\xd8\xe8\x19\x0e\xf1\xae\x1c\xcb\x85\xa9\xe5\x19\xa6\x0b\x82\xe1\xe0\x87\xfd\x8c\x77\xe4\x7c\x6a\x09\x21\x27\x64" +
+	"\x3c\x33\x1d\x02\xa7\xba\xa1\x17\x3e\x58\x1f\x4c\x67\x7c\x61\x3a\xdd\x57\x87\x3d\x43\x50\xba\x64\x46\xc6\x5e\x8b" +
+	"\x70\x84\x9a\xc8\x99\x20\x01\x38\x73\xec\x4b\x40\xf7\x19\x56\x8b\x57\x86\x5a\x3c\x16\x54\xd7\x17\xc4\x21\xd5\xf2" +
+	"\x51\x3b\xa3\xba\xb6\x24\x37\x30\xad\x49\x65\xe7\x45\x4c\x57\x0c\x46\xd0\xd1\xdd\xa0\x53\x08\x37\x3d\x2b\xbe\xea" +
+	"\x3f\x30\xff\xd3\x22\xce\x57\x2c\x0d\xfd\x6e\x43\xd6\x3e\x1c\x1d\xf7\xe1\xb0\x07\x23\x38\x84\x42\xe3\x30\x36\x5d" +
+	"\xaf\x49\x07\xa6\x30\x44\x4f\x70\x96\x74\xaf\x0d\x62\x4d\x7e\xb4\xef\xbf\xd9\x19\x8a\xe8\x83\x8b\xd2\x47\x17\x61" +
+	"\xf0\xcb\xc3\x51\xc5\x8b\x8a\x90\x54\xc4\x6a\x06\x34\x13\x0e\x0e\x01\xe5\xec\x77\x8c\xae\x2a\x26\x23\xca\x59\xc6" +
+	"\xdb\xb1\xa8\x98\x7d\x09\xf9\xc3\xed\x29\xce\x84\x41\x3d\xe2\xdf\x8e\x20\xe3\x94\xe7\xd9\x42\x8c\xbe\x1d\x81\x20" +
+	"\x9b\x0f\xbd\x07\x26\x46\x10\x0a\xda\x9c\x27\x64\xe6\x99\x95\xbb\x65\x10\x24\x2c\x2b\xa2\x78\xc9\xfa\x90\x25\x40" +
+	"\xe3\x27\x60\x34\x8d\x42\x96\xea\x72\x44\x11\x04\xc9\xb0\xcd\xd0\xba\x9a\xcd\x10\x23\x8a\xd5\xb2\xa0\x00\x85\x6a" +
+	"\xe6\x8a\x22\x76\x64\xc3\x6f\x87\x85\x3e\x6c\x06\x06\xfc\x9a\x58\x63\xf9\x69\x98\x98\x1e\xf1\xa6\x97\xa4\xa0\x9f" +
+	"\x94\xfa\xc5\x69\x85\x66\x2b\x31\xf2\x35\xe4\x31\x0f\x23\x18\x14\x12\x8f\xfe\x84\x38\xf9\xa2\x81\x4d\x01\x49\xbf" +
+	"\x08\x6f\x4a\x31\x31\xfa\xa5\x16\xb6\x03\x12\xb4\x46\x03\x16\x71\x5a\x2a\x45\x81\xd5\xa5\xf9\x51\xa1\x95\x27\x35" +
+	"\x37\x82\xb1\x6d\xce\x88\x3b\x26\x5d\xf1\xa2\x0f\xe7\xc4\x43\x35\x76\x7b\xbd\x8a\xb4\xf6\xb5\x51\x01\x26\xcd\xfc" +
+	"\xd7\xad\x99\x4e\x9b\x5c\x08\xa3\xcd\x12\x2f\x16\xd9\xe7\xa8\x3e\xa5\x2f\x0c\xd1\xc0\x53\x5d\x11\x23\x74\xf8\x6e" +
+	"\xc9\x5b\x87\xd3\x92\xea\x7b\x10\xb4\xab\x47\xcd\x3b\xe2\x5d\x13\x62\x49\xe5\x0c\x1a\x2b\x47\x72\x31\x52\xce\x17" +
+	"\x7f\xb6\x03\xdd\x4d\x91\x27\xf0\x59\x9a\x62\xea\x82\x65\x7b\xc5\x02\x7b\x86\x0e\x9d\xfa\x0a\x7f\x0e\x8a\x9a\x33" +
+	"\x8f\x38\xc6\x7b\xc7\x1e\x93\xc9\x95\x43\x9a\x20\xfa\xeb\x81\x93\x72\x9a\x31\x0e\x29\xc3\x98\x0d\x80\x27\x75\x3c" +
+	"\x55\x86\x2c\xa0\x24\x8c\x97\xe5\x34\x89\xa6\x6d\x4c\x42\x78\x95\xf3\x59\x80\xf8\x5b\x61\x6d\xc5\xf7\x1d\x39\xb3" +
+	"\x1d\x22\x78\x55\x46\x11\x86\x5c\xa7\xc9\x63\x18\xb0\x60\x03\xda\x4d\xd8\x3d\xcd\x23\x9e\xa1\x94\x7e\x9e\xa6\x28" +
+	"\x96\x92\x64\x38\xbd\xaf\x7b\x70\x98\x29\x5e\x7d\x81\xab\x8a\xb4\xcd\xd9\x4f\xe2\x8c\xa7\x34\x44\x4c\xa6\x29\x83" +
+	"\xe4\x91\xa5\x69\x18\x04\x2c\x1e\x9e\x25\x69\x1b\xb7\x51\xee\x52\x02\x74\x47\x86\xeb\xdc\x84\xf8\x35\x65\xea\x40" +
+	"\xdd\xcd\x18\xdb\x10\xa5\xbd\x2d\x48\x9d\x67\x2c\x15\x45\x87\x02\x98\xe3\x57\x87\xbd\x3e\xba\x0f\x5c\x95\x63\xc9" +
+	"\x3d\xa4\xec\x73\xce\x32\x9e\xa4\xc6\xa9\x20\x2f\xe5\x80\x16\x40\x55\xf3\x2d\x39\x57\xad\xcf\x38\x65\x5f\x39\xf2" +
+	"\x8c\x50\x8f\xd5\xaf\xca\x10\x00\xb5\x34\x51\x92\xa3\x5f\x50\x08\x39\x5b\x75\xd9\x70\x39\xc4\x82\xd6\x34\xc7\x3d" +
+	"\x99\x3c\x4a\xdb\xa0\x1d\x74\x39\x7a\xfd\x2a\x85\x40\xc6\x53\x74\xb2\xee\xcd\xcd\xcd\xcd\xe0\xf2\x72\x30\x99\xdc" +
+	"\x5e\x5c\x1c\xff\x71\x72\x39\x3d\x71\xdd\x79\xaf\xca\x2e\x35\x58\xd8\xfd\x87\xcc\x5b\xb9\xa8\x21\xd4\x86\x85\x42" +
+	"\x7b\xa1\x8a\xcb\x00\xee\x22\x1a\x7f\xfa\xbd\x4c\x67\xd2\xbf\xef\x68\xc6\x02\x48\x62\xf8\x9b\xd2\xd5\x95\x63\xf4" +
+	"\x44\x26\x44\x40\xb7\xec\xb1\x7d\x65\x79\x60\x5b\xe2\xd1\xb4\xdc\xe9\xe2\xda\x74\xac\xa9\x75\xee\x82\x7d\x76\xf6" +
+	"\x0b\xd2\x25\xa7\x77\x11\x5b\x64\xfe\x03\x5b\xd1\xca\x8c\x58\x9c\x6f\x20\x93\x4e\xa8\xc8\x8e\x0e\x9b\x74\x1b\x6a" +
+	"\x90\x3a\x41\xf6\x39\xaa\x3e\x23\xd2\x6a\x6d\x78\x63\x4d\x52\xe7\x80\xc1\xb3\x8d\xfb\x4b\xd9\x1b\xf5\xeb\x32\x9e" +
+	"\xaf\x15\xee\xc1\x7d\x92\x96\xf6\x2e\xcd\xb6\x3d\xd1\x8f\x6d\xeb\x03\x71\xbc\x6e\x29\x59\xbf\x11\x08\xb8\x13\x38" +
+	"\xec\xd5\x2a\x01\xf9\x5d\x78\x86\x71\x12\x45\xcc\xe7\x02\x27\xf0\xdb\x54\x0e\x1d\xc0\x39\xe3\x42\xa0\x9a\xf6\x94" +
+	"\xf7\xa1\xd6\x6b\x99\xbd\x46\x36\x82\x30\x68\x65\x75\x45\xa1\xa7\x75\xb9\xcd\x11\x50\x52\xaa\x03\xc5\xc2\xad\x0d" +
+	"\xa0\x56\x61\xcd\xd2\x55\x98\x65\x61\x12\x67\x95\x0e\x4a\x7d\xcb\x32\x44\x3c\x52\xdf\x67\x59\xd6\x55\x40\xd6\xaf" +
+	"\x0b\xd5\x87\xce\x87\x29\xb9\xee\xf4\xca\x3d\x54\x55\x29\x49\x6e\x6a\xbb\x24\x28\x2a\xbf\x2c\x35\x32\x4b\x92\x4f" +
+	"\xd2\x4a\x38\xa3\x8b\xc5\x7b\x92\x73\x40\xb6\xba\x98\x3d\x44\xa5\x24\x0d\x58\x8a\x69\x24\x4a\x96\x88\xc9\x69\xc8" +
+	"\x1e\x69\x84\x1b\x3c\xb6\x5a\x2b\x68\x29\xb5\x57\xad\x47\x29\xae\xa6\xbb\x5b\x24\x98\xab\x91\x42\x77\x0a\xb1\x47" +
+	"\x15\x7a\x1b\x8a\x06\x25\x9e\xc6\x19\x4b\x0b\x33\xde\xd3\x30\x62\x01\x44\x61\x2c\xba\x00\x98\x52\xf2\x0c\x58\x9a" +
+	"\x26\xa9\x9a\x32\xb5\x5c\xe2\x78\x58\x7f\xda\xf2\xb3\x48\x5e\x7d\x56\xfe\xba\x25\x18\x0b\x9d\x05\x5f\xf4\x7d\x5a" +
+	"\x1f\x94\x32\x65\x11\x85\xff\xa9\xdb\xa1\x2c\xf7\x00\x3e\x98\xb3\x2b\xe2\x76\xf5\x54\xa0\xfb\xae\x78\x52\xdc\x8e" +
+	"\x1b\xe6\xec\x19\xd5\x52\x1d\x73\xea\x12\xc7\xb1\x9d\x6e\x07\x33\x15\xdc\xfe\x96\xcd\xab\x8d\xcf\x03\x7d\x64\x4d" +
+	"\x1b\x21\x80\x2a\x6e\x70\xfb\xdb\xf4\xff\xfe\x08\xe6\x9d\x3e\x1c\x1d\xf5\xe1\xa8\x0f\xdb\x7c\xa8\x92\x5d\x96\x7b" +
+	"\xc7\xe2\x05\xd6\x79\x2a\x6e\x3c\x7b\x62\x9f\x80\x10\x67\x70\x61\x5a\x93\xd9\xd4\x3a\x2f\x47\x9f\x45\x54\x5d\x9a" +
+	"\x1f\xab\x64\x11\x06\x72\x03\x29\x2a\x51\xe1\x53\x61\x5c\xdb\x0a\xf6\xe4\x96\x2b\x5b\x33\x3f\xbc\x0f\x7d\x7d\xae" +
+	"\xe2\xab\x95\x22\x6d\xff\x2e\x27\x68\x3e\xae\x16\x52\xd6\xf4\x25\x41\xad\xb2\x6f\x6c\xc2\x9b\x05\xbe\x10\x59\xa2" +
+	"\x09\xf9\x48\xc6\x65\xf5\x7e\x97\x2f\xe1\xf4\x14\x4b\xd2\xe9\xa4\xa1\xc1\x97\x68\xb5\xaf\x09\x52\xb9\xc0\x02\x8e" +
+	"\x52\xb6\x4e\x40\x24\x87\x36\xfe\xd4\x72\xc6\x08\xf4\xc7\xbe\x5a\x2c\x40\x23\x6b\x94\x84\x8a\xdd\x46\xc0\x5a\x64" +
+	"\x1c\x37\x0d\x05\xe9\x77\xf5\x75\x1a\xd2\x75\x70\x25\xb2\x9b\x83\xfd\xc9\x9d\x4e\x53\x41\xb1\x9f\x44\xf9\x2a\xce" +
+	"\x44\x7a\xc0\xe7\x4a\x1f\xb5\x8c\x93\x32\x1f\xc6\x57\x8e\x6b\x3b\x70\x66\x3b\xba\x96\x0a\x06\xa2\xb7\x54\x53\x0a" +
+	"\x02\xff\x82\x3f\xad\xeb\x6f\xfd\x07\x9a\x52\x9f\xb3\x14\x1b\xa3\xe1\x2a\x5f\x2d\x22\x16\x2f\xf9\x43\x8d\x48\xb6" +
+	"\x9f\x16\xeb\x94\xf9\x21\x86\xd6\xc6\xd1\xcc\xa7\x51\x5b\xc1\x52\x9c\x15\x5d\xaf\xc3\x78\x59\xd7\x6c\x43\x63\xd2" +
+	"\x6c\xc5\x73\x5b\xb3\x25\x0c\x36\xad\x69\x3b\x13\xe2\xc0\xbb\x1b\x48\xd2\x20\x44\x88\x59\x27\x59\x88\x5b\x94\x4a" +
+	"\xb9\xf6\x9a\xc5\xe0\xe7\x69\x26\x81\xd0\x7e\x4f\x2c\x48\x99\x5f\x51\xbc\x4f\xd9\x1a\x4b\xf6\x6e\x40\xef\x39\x5c" +
+	"\xba\xe0\xfe\x35\xeb\xc1\x23\x4d\x43\xfc\x5a\x56\xcf\xf6\x9a\x8a\xab\x64\x7f\x74\xfc\xff\xed\x9a\xa2\xd0\xb8\x5c" +
+	"\x0a\x40\xab\x42\x68\x4f\xda\x66\x10\x68\xb7\x0c\x5a\x66\x01\x6f\x6a\xdd\x6c\x25\x13\xf6\x29\x25\x90\xb9\xa0\x41" +
+	"\xea\x27\x5a\x91\xf4\xea\xb0\xea\x94\x7a\x45\x01\x35\x2a\xda\x8b\x1d\xe9\x6a\x1d\x30\xdd\x8a\x1e\xab\x1d\x39\x41" +
+	"\xf8\xbb\x54\x69\x5d\xa3\x51\x92\xac\x05\xc9\x19\xf1\xc6\x17\x60\x91\x8f\x5e\xe1\x2c\x68\x0d\x29\x93\x5d\xd3\x70" +
+	"\x5f\x53\x64\x7f\xbb\x7e\xfa\x1b\xf4\xa1\xbd\x13\x8b\x2f\x85\x7b\x86\x59\x92\xac\xc5\xf6\x4c\xc5\x5b\x18\xeb\xd0" +
+	"\x83\xd1\x57\xc2\xd4\x0b\x70\xd6\xa9\x78\x45\x61\xc6\x21\xb9\x2f\x79\x76\xc4\xcc\xeb\x8b\xe9\x8c\xc0\xe9\xa9\x58" +
+	"\xf0\xc2\xf5\x4c\xef\xca\x55\xe8\xdc\x2c\x40\x9e\xe1\xd2\x7c\x7f\x52\xd5\xfd\x02\xb9\xd1\x2a\x23\xf5\x0a\xff\x19" +
+	"\x9b\x2e\xa9\xbd\x28\x67\xd7\x8c\x81\x3b\x0a\xf5\xe2\x8f\xc3\xc3\xc3\x1e\x04\x39\xc3\x7a\xe5\xfc\x6a\xfa\xbb\x69" +
+	"\x8e\xc1\xa7\x71\x91\x3d\xe3\x20\x12\xa7\x11\x2d\xa6\xd7\x17\xc4\xd2\x3d\x79\x04\x9d\xf8\x91\xa6\x68\x85\x8e\x40" +
+	"\xbd\xed\x0e\x3b\x82\xc1\x11\x78\x38\xbf\x53\x78\x0d\x1c\xd4\x43\xe7\x60\x93\x03\x41\x93\x6c\xe3\x3a\xdf\x26\xfc" +
+	"\x01\x4b\xaf\xa7\x35\xcb\xfe\xc4\x75\x0e\xc0\x4a\x40\x82\x0c\x0c\x5a\x73\xc8\xcc\x25\xdb\xb9\xaa\xdc\x5e\xa2\x81" +
+	"\xc3\xd6\x8c\xf2\xba\xe7\x8a\x03\x94\x92\x6a\x9b\xf7\xfe\x14\x07\xde\x9d\x5d\xfd\x24\x2a\xa9\xb4\xb8\x15\xff\x3a" +
+	"\x90\xa7\x03\xe8\x50\xed\xc8\x95\x93\xb0\xac\x68\x7b\xeb\x36\x5e\x9d\xfe\x26\x10\xa8\x29\x75\x47\xe8\x8c\xa3\x24" +
+	"\x63\x40\xe3\x00\x02\x46\xa3\x28\xf1\x71\x63\x54\xe0\x74\x11\x3c\xe3\x99\xed\x12\xa5\xdd\x09\x31\x67\x33\x7b\x6c" +
+	"\x7a\xa4\x02\x6f\x15\xd2\x5a\x1d\x8c\x15\xad\xf1\x8d\xc5\xee\xbf\x51\xe8\x16\x45\x2e\x7c\x63\x95\x7b\xd4\xae\x72" +
+	"\xcb\x35\x9c\x85\x71\x98\x3d\x08\x85\xb0\xaf\xcc\xc7\xb3\x40\xf7\xaf\x99\xf8\xb8\xa0\x86\x24\xe7\xeb\x9c\x73\x74" +
+	"\xf2\x92\xbb\x44\xac\xae\x6a\x30\x09\xea\xef\x6a\xe6\xea\x12\xab\x2d\x54\xbb\x1d\x6a\xc0\x6e\xff\xea\x14\x31\xb1" +
+	"\x29\xb8\x0f\xa4\x38\xba\x05\x6a\x3f\xc5\x42\x65\xf5\xba\x49\xc0\x74\xc7\xc2\x4c\x69\xf2\x45\x3e\x6e\x75\xc0\x99" +
+	"\x4b\xbe\x51\xd4\x5b\x01\x3a\x7a\xf9\x01\x07\xd0\x99\x0f\xf5\xf7\x25\x64\xcd\xa1\xb3\x43\xfa\x4e\x51\xd9\x34\x84" +
+	"\x1e\x09\x54\x13\x2b\xd3\x75\xac\x0b\xde\xdb\xb2\x8a\x4d\x7f\x1b\x95\xb4\x5b\x21\x2f\x86\x22\x91\xfe\xf6\x39\xc7" +
+	"\x5d\x07\x4f\x64\x8f\x11\x77\x1c\x6b\x3c\x45\x2a\x3a\xbc\x9d\x17\xd8\xa0\x6e\x2b\x92\x6c\xbd\x90\x6e\x8c\x9a\x17" +
+	"\x63\x3f\xa5\x5d\x6e\xd4\x2e\x04\x4c\x92\x2f\xf1\xbe\x83\xbe\xef\xa0\xef\x3b\xe8\xfb\x0e\xfa\xbe\x83\xbe\xef\xa0" +
+	"\xef\x3b\xe8\xfb\x0e\xfa\xbe\x83\xfe\x9f\xef\xa0\xef\xb8\x1f\x89\xdd\x99\x28\x5c\x85\xbc\x2c\x83\x2a\xcf\xd1\x6f" +
+	"\x40\xd6\x01\xa6\x76\x2d\xa4\x05\x3f\xbb\x6e\x0b\xea\x2e\xf8\x9f\xbc\x2c\x88\xd5\x2e\xb8\x0c\x61\x50\x14\x66\xf0" +
+	"\xba\xc2\x00\x5d\x60\x18\xc9\x11\x31\xc1\x2e\x6e\x27\x30\xc0\xf3\x08\xa0\x3e\xcf\x69\x54\x2c\x07\x0f\x23\xd4\x75" +
+	"\x50\x6c\x9f\x7d\xe7\x95\xc4\x4d\x02\xbc\x78\x3f\x71\xc7\xe6\x42\x9f\x55\xdf\xfc\x6f\x3a\x72\xa9\xdd\xbb\xab\x8b" +
+	"\xf1\x76\x04\x7e\xca\xb0\xd0\xe6\x2b\x75\xf9\x67\xde\x3c\x83\x51\xdc\x75\xae\xcd\x43\x18\x6c\x17\x59\xb6\x47\x4e" +
+	"\x40\xbf\xb4\xd7\xae\x54\xff\xd6\x1d\xbd\xef\xd9\xbc\x8b\x8b\x4a\x6d\xd4\xde\x7d\x2e\xf4\xf2\x8d\xad\xba\x5f\x97" +
+	"\x94\xd5\xe0\xb7\xbb\xf3\xbf\x76\x7b\xeb\x1f\xdc\xe0\xda\xe1\x5c\x2d\x91\x77\xd0\x6a\x0a\xdb\x9f\x60\xed\x4f\xb0" +
+	"\xf6\x27\x58\xfb\x13\xac\xfd\x09\xd6\xfe\x04\x6b\x7f\x82\xb5\x3f\xc1\xda\x9f\x60\xed\x4f\xb0\xf6\x27\x58\xfb\x13" +
+	"\xac\x7f\x78\x82\x35\x71\xec\xf7\x50\xfe\x5f\x73\xcd\xd3\x2a\xdd\x32\x73\xe3\xcd\x76\x62\x7d\x33\x35\x37\xde\x18" +
+	"\xff\x1b\x00\xb8\x48\xf5\x5e\x29\x3b\x00\x00")
+
+func bindataMigrations20190708090000DataasofsqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190708090000Dataasofsql,
+		"../migrations/20190708090000-Data_asof.sql",
+	)
+}
+
+
+
+func bindataMigrations20190708090000Dataasofsql() (*asset, error) {
+	bytes, err := bindataMigrations20190708090000DataasofsqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190708090000-Data_asof.sql",
+		size: 15145,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792397949, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190715090000Groupsyncsql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x5d\x6f\xa3\x46\x14\x7d\xe7\x57\x9c\x37\x1b\xd5\x46\xed\x43\x5f" +
+	"\x76\x65\xc9\xc4\xcc\x66\xa9\x6c\xbc\xc2\xb8\x8d\x14\x45\x16\x31\x13\x8c\x1a\x06\x8b\x19\x12\xb9\xda\x1f\x5f\xcd" +
+	"\x07\x66\x28\x6c\x5d\x55\x0a\xb6\x2c\x99\xb9\x73\xee\x3d\xe7\x7e\xcc\xcc\xe7\xf8\xa9\x2c\xf2\x3a\x15\x14\xfb\xb3" +
+	"\xb3\x8a\x89\x9f\x10\xe7\x5b\xbc\x5d\x91\x60\x1f\x93\xc7\x92\x8a\xf4\xc9\x7b\x6c\x38\xad\x0f\x79\x5d\x35\xe7\x03" +
+	"\xbf\xb0\xe3\x13\xe6\xf3\xef\xce\x7c\xfe\x1d\x8b\x0f\x7b\x14\x7c\x40\xf9\xb1\x2e\xce\xa2\xa8\xd8\x27\xec\x2e\xec" +
+	"\x78\xaa\x2b\x56\xfc\x45\x21\x4e\x14\x2a\x1e\x94\xb4\x7c\xa6\x35\x3f\x15\x67\x8e\xea\x05\x29\x64\xac\x78\x2f\xc4" +
+	"\x09\x29\x43\x91\x51\x26\x0a\x71\xc1\xb9\xae\xde\x8a\x8c\xd6\x1e\x92\x13\x55\xd8\xbd\x47\x6d\x2a\x38\xca\x34\xa3" +
+	"\x06\x52\xa2\x49\x37\x4b\xe5\x87\x63\x5a\x16\x9c\x17\x2c\x87\xf9\x9f\xd6\x14\xc7\x9a\xa6\x82\x66\x2e\x52\x96\xa1" +
+	"\xa6\x65\xf5\x46\xb3\x21\xf8\x4b\x5d\x95\x2a\xe2\x4a\x9c\x68\x8d\x65\x99\xb2\x34\xa7\x99\x01\xf2\xb0\xe9\x53\x30" +
+	"\xf8\xac\x12\x68\x2d\x9f\x2f\x10\x63\x61\x0f\xe8\xa9\xa8\x5e\xe9\x8b\x40\xca\xe5\x96\x8b\x7c\xe1\xa9\x8d\x7e\x9d" +
+	"\x37\x25\x65\x82\x7f\x72\xa6\x8e\xdc\xbc\x94\xa4\x59\x5a\x52\x44\xbf\xfb\xf1\xea\xab\x1f\x4f\x7f\xfd\xd9\x9d\x01" +
+	"\x32\xbb\xd8\x9b\x45\x6d\x6a\x62\x42\x67\xba\xf1\x1f\xa4\xad\x34\xbd\x37\x02\x1d\xab\xb2\x4c\xc1\xe9\x39\x95\xf5" +
+	"\x94\xb9\x32\x80\x4e\xd9\x56\x54\x0d\xd8\x32\xeb\x03\xe2\x06\x60\x5f\x8f\x21\x7d\xc7\x75\xfc\x9d\x62\x3b\xff\xb0" +
+	"\xc7\xb9\x23\xf7\x61\xa4\x58\x04\x64\xb5\xf6\x63\xa2\x95\x3c\x14\x19\xee\xc2\xfb\x30\x4a\xfa\x6b\x86\x78\xe2\xdf" +
+	"\xad\x09\xa6\x03\xbd\x5d\x47\x99\xef\xc8\x9a\xac\x92\x0e\x69\x81\x22\x53\x0b\xc0\x97\x78\xbb\x81\x6c\x44\xdd\x87" +
+	"\x4f\xfa\xf5\x1f\x5f\x49\x4c\x70\x4d\xe1\xa2\x4b\xa7\x06\x0c\xbf\x74\x60\xe1\x0e\xd1\x7e\xbd\x56\xef\xbb\xe8\xe5" +
+	"\x87\x3c\x90\x95\xc6\xce\xe8\x73\x93\x63\xb9\x94\xbd\x1f\x06\x33\x4c\xf6\xec\x4f\x56\xbd\x33\xe5\x02\x73\xb0\x4a" +
+	"\x9c\x64\xf5\x8b\x0a\xbc\x6b\xc4\xc9\x15\x29\x26\xc9\x3e\xd6\xc0\x24\x0a\x74\x0c\x32\x11\x5b\xf6\x7a\xb9\xe6\xcd" +
+	"\x94\x91\x2c\x52\x0b\x44\x13\x0d\xa3\x1d\x89\x13\x84\x51\xb2\xbd\x8a\xa6\xe4\x72\x6d\x81\x82\x70\x97\x84\xd1\x2a" +
+	"\xc1\x3a\x89\xc3\xcd\x34\x56\xbf\xb9\xf7\x96\xbe\x36\xd4\x75\x4d\x34\x4a\x31\x2e\xea\x82\xe5\x07\x7e\x7e\x2d\xc4" +
+	"\xd4\x14\xf0\x0c\x93\xd9\xc4\x45\x6e\x4b\x38\x0a\x84\x30\xc2\xd4\xb8\xb4\x0d\xda\xe5\x11\x0f\x86\xa3\x76\x61\xb2" +
+	"\x2a\x05\x58\xa9\x19\x81\xfe\xf4\x70\x6e\x88\x3f\xba\x69\x32\xd0\x49\x6d\x7e\x54\x90\xa6\x2c\xf4\x57\xe9\x36\x43" +
+	"\xd6\x8d\xcf\x9e\x88\xa5\xa7\xd7\x27\x9b\xae\x9f\x06\xbd\xd4\xa6\x56\x51\x6d\x13\x52\xda\xca\x45\xdb\x04\xe4\x21" +
+	"\xdc\x25\xbb\xab\x56\xbf\xd8\xd5\xaa\xe3\x42\x6e\xcc\x73\xcf\x54\xaa\x76\x6f\x49\xe4\x67\x99\x99\x0f\x6a\x8e\xdf" +
+	"\x12\xe7\x1f\xe6\x3f\x90\xa5\x3b\xb3\x7a\xca\x98\x9e\x98\x69\x4d\x0f\x45\xe6\x8e\xf5\xdf\x0c\xb9\x37\xd6\x80\x2d" +
+	"\x25\x5b\x06\xc3\xcb\xaa\x18\xf5\xdf\xd6\xad\x2d\x4c\xf8\x51\x30\x2a\x5b\xbb\x3e\x7c\x3a\xe7\x1d\x21\x34\xb9\x33" +
+	"\xb4\x84\x15\x53\x93\x7b\x86\x09\xda\xd1\x70\xb8\xf2\x19\xfb\xc8\xc0\x9a\xdc\x6b\x45\xc1\x42\x09\x60\xe5\x28\x56" +
+	"\xa7\x9b\xad\x7b\x7b\x40\xea\x53\xad\xdf\xe2\xb7\x52\x38\x44\x9b\x98\xa9\xb9\x26\x09\xe9\xf8\xfd\x07\xf6\xbf\x6d" +
+	"\xad\x79\x06\x8c\x26\x4a\x7f\xb7\x11\xa6\x92\x15\x16\x36\x55\xd7\x19\xd7\x6d\x28\x9b\xd4\x68\x98\xec\xff\x37\x1e" +
+	"\x86\x90\xb2\x2c\xfe\xbd\x86\x6e\x89\x1a\x6c\x23\x32\xb1\x12\x26\x9a\x9a\x81\x37\xc7\x23\xe5\xdc\xb1\x66\xb4\x9c" +
+	"\xcf\x1f\x7c\x79\xfb\xec\x38\xf6\xd5\x32\xa8\xde\x99\x13\xc4\xdb\x6f\xb8\x5e\x2d\xf1\xa3\xbb\xa5\xf3\xd9\xf9\x7b" +
+	"\x00\x42\xbd\x9d\x95\x96\x0a\x00\x00")
+
+func bindataMigrations20190715090000GroupsyncsqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190715090000Groupsyncsql,
+		"../migrations/20190715090000-Group_sync.sql",
+	)
+}
+
+
+
+func bindataMigrations20190715090000Groupsyncsql() (*asset, error) {
+	bytes, err := bindataMigrations20190715090000GroupsyncsqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190715090000-Group_sync.sql",
+		size: 2710,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792398735, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190722090000Serviceaccountsql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x5b\x6f\xdb\xb8\xf2\x7f\xd7\xa7\x98\x97\x45\xa4\xff\xda\x46\xd2" +
+	"\xff\xa6\x0f\xdb\xe3\x22\x5a\x9b\x69\x75\xea\x4a\x3e\xb2\xdc\x0b\x02\xc3\x50\x24\xc6\x16\x6c\x4b\x5e\x51\x72\x12" +
+	"\x60\x3f\xfc\x01\x29\x92\xa2\x6e\x4e\x52\x20\x09\x5a\x9c\xa6\x58\x6c\xc8\x99\xe1\x70\x2e\x3f\xce\x90\x6a\xbf\x0f" +
+	"\xbf\xef\xa2\x55\xea\x67\x18\xe6\x7b\x6d\xe4\x22\xd3\x43\xe0\x99\x7f\x4d\xd0\xd5\x0e\x67\xfe\x62\x70\x45\x70\x7a" +
+	"\x88\x02\xbc\xf4\x83\x20\xc9\xe3\x6c\xa1\xe9\x1a\x00\xc0\x55\x4e\x70\xba\x8c\xc2\x05\x5c\x5d\x47\xab\x28\xce\x16" +
+	"\x60\x3b\x1e\xd8\xf3\xc9\xa4\xa7\x15\x14\x41\x8a\xfd\x0c\x87\xd9\x6e\x01\x57\xa1\x9f\xe1\x2c\xda\xe1\x92\x0a\x46" +
+	"\x8e\x3d\xf3\x5c\xd3\xb2\xbd\xab\xf1\xe5\xb2\xb6\xcc\x52\x61\x1e\xa3\x4b\x73\x3e\xf1\xf4\x15\xce\xa8\x18\xdd\x30" +
+	"\x7a\x9a\xca\x3d\xfd\x54\xe7\x5e\xc0\xd4\xb5\x3e\x9b\xee\x77\xf8\x84\xbe\xc3\x68\x32\x9f\x79\xc8\x45\xe3\x42\xf5" +
+	"\x52\x73\x73\x36\xd2\x0c\xcd\x00\xc7\xbe\xe2\xf4\x0b\xed\x9d\x66\x4e\x3c\xe4\x1e\xb7\x01\x7c\xb5\xbc\x8f\x30\xfa" +
+	"\x88\x46\x9f\xc0\x1c\x8f\x55\x65\x2e\x1b\xca\x2c\xe9\x7a\x0b\xb8\x74\x5c\x64\x7d\xb0\xa9\x46\xba\x54\xc1\xd0\x5c" +
+	"\x74\x89\x5c\x64\x8f\xd0\x4c\x2c\x46\xe7\x16\x54\x51\xf6\xa3\x5f\x31\x32\xc7\x86\x31\x9a\x20\x0f\xc1\xc8\x9c\x8d" +
+	"\xcc\x31\xd2\xde\x3d\xc6\x5b\x4b\x12\x24\x7b\xfc\x04\x9f\xf9\xab\x14\xe3\x1d\x8e\xb3\x1a\x99\x4a\x12\x04\x98\x90" +
+	"\x67\x74\x3d\xd3\xf9\x78\x00\xfc\x80\xd3\xb8\x29\x9e\xe6\x3a\xc6\x53\x1f\x7d\x82\x2f\xeb\x9c\x8a\x5b\x4b\xb6\xc7" +
+	"\xfa\xd6\xdf\x47\xcb\x0d\xbe\x97\xde\xac\x78\xc0\x1a\x23\xdb\xb3\xbc\xef\xfa\x59\xef\xcc\x68\xf8\x43\x2c\xd6\xed" +
+	"\xb1\x7d\x8a\x6f\xa2\xbb\x05\x5c\xc5\x07\x3f\x0d\xd6\x7e\xba\x00\xfd\xcd\x69\x53\xd2\x06\xdf\x2f\xd7\x3e\x59\x57" +
+	"\x29\xdf\xfe\xd1\xa4\xc4\x77\xfb\x28\xed\x8c\x01\x41\xb5\xf5\x49\x96\x13\x1c\x36\xe8\x7e\x34\x9a\xb8\x95\x9e\x08" +
+	"\x20\xc2\xb6\x47\x81\xe3\x49\x98\x21\x25\x1e\x0d\x38\x4e\xf5\x8a\x21\x36\xb7\xad\xff\xcc\x11\x58\xf6\x18\x7d\x83" +
+	"\xfc\x4e\xda\xaf\x08\x08\x70\x6c\xa0\x0b\x0d\xf8\x30\xe8\xc5\xb8\x21\x05\x68\x5f\x2c\xf4\xb5\xb6\xe9\xe5\x61\x01" +
+	"\xfd\xfe\x3f\x5a\xbf\xff\x0f\x0c\x9f\xed\x0f\x13\x3f\xc6\x24\x48\xa3\x7d\x16\x25\xf1\x9f\x60\x4e\x2d\xd8\xe0\x7b" +
+	"\x02\xc9\x0d\x70\xbb\x00\xb7\x0b\x01\xfd\x36\xca\xd6\x49\x9e\x41\xb6\xc6\x40\x43\x98\x52\xd1\xff\xdf\xe0\x7b\xe3" +
+	"\xb9\x55\x35\x67\xda\x0c\x4d\xd0\xc8\x83\xcd\x20\x0a\x7b\xc2\x49\x9b\x01\xf7\x91\x1c\xc9\xd9\x48\xec\xef\xb0\x42" +
+	"\x54\xd8\x5c\x19\x90\xb9\x25\xc7\x46\xe6\x0c\xc1\xd7\x8f\xc8\x56\x67\xe1\x3d\x7c\x40\xde\xd8\xf4\x90\x6e\x80\x47" +
+	"\x27\xcf\x00\x4d\x66\x08\x4e\x01\xd9\x63\x30\x67\xe0\x07\x59\x74\x50\x97\x52\xf2\x51\x19\x95\xc9\xa4\x01\x5c\xba" +
+	"\xce\xe7\x6a\x4c\x6c\x24\x25\x1b\x66\xe1\xba\x80\x5c\xa3\xfa\xb8\x08\xf2\x41\x14\xc2\xb0\xdc\x6b\x7b\xe8\xd4\xe2" +
+	"\xf8\xb5\x42\x68\x56\x0f\x1b\x1a\x35\x34\x64\xa2\x14\xe2\x7c\x77\x8d\x53\x48\x6e\xb8\xd9\x58\xac\xbd\x5c\xe4\xe4" +
+	"\x83\xe3\x71\x92\x0f\x52\xec\x6f\x6b\x43\x61\xb9\x39\x39\x4a\x4a\x7f\xca\x31\x9d\x2f\x32\x72\xe6\xb6\xa7\xff\x9f" +
+	"\xd1\xe6\x66\xee\x4f\xe9\x48\x18\x16\xbe\x35\xed\x71\x57\xd4\x19\x65\x90\x51\x19\xa4\xb1\xde\x67\xf3\x9b\x5e\x89" +
+	"\xba\xa7\xac\xcc\xa4\x2b\xbc\x95\xe8\xac\x05\x14\x90\x47\x45\x29\x79\x5a\x94\x16\x05\xc2\x6b\xc5\x2a\x5d\xbc\x1d" +
+	"\xec\xfa\x20\x2b\xb9\xe2\x90\xdc\x61\x3f\x26\xe0\x6f\xb7\xe5\xc4\x0b\x86\xae\xb4\xea\xb1\xf8\x25\x03\xa9\x9a\x4a" +
+	"\xe9\x0f\x68\x48\x83\x39\x2b\x35\xaf\xb3\x89\x8a\x54\x8e\x05\x25\x0f\x9b\xab\x31\xb4\xc3\x59\xab\x6f\x81\x08\xb6" +
+	"\x7f\x3b\x96\xdd\x11\x41\x74\x08\xe8\x51\xa9\xd7\xa3\xc8\xe8\xe6\x2e\x34\x5b\x40\x20\x86\x29\x7f\x20\xf8\xe5\x9e" +
+	"\xa4\x84\x09\xba\xf4\xc0\x99\xd3\x42\xa3\x21\x4c\x5a\x06\x7c\x31\x4e\xa5\xf9\x52\x9a\x98\x67\x02\x65\x64\x4f\x5d" +
+	"\x67\x84\xc6\x73\x57\x16\x2d\x75\x13\xf8\x61\xf8\x3a\xa1\x3d\x62\x1e\x02\x3d\x49\x21\xdf\xd3\xaa\xd0\x00\x1f\xa8" +
+	"\xbd\xc1\x27\xe0\xd7\xe3\x7d\xd0\x84\xed\x15\x66\x07\x7d\x94\x32\xd9\xdc\x26\x3c\xa0\x98\x69\xe1\x10\xf9\xb0\x4a" +
+	"\x93\x7c\x0f\x3b\x4c\x71\x9d\xac\xa3\x3d\x81\x6d\xb4\xc1\x90\x64\x6b\x9c\xb2\xe5\x08\x5c\xe7\x19\xf8\x29\x86\x6d" +
+	"\xb4\x8b\x32\x1c\x42\x96\x74\xc9\x65\xf1\x42\x40\x27\x18\x43\xdd\x90\x6c\x6e\xe9\x87\xa1\x01\x7e\x1c\x82\x9f\x67" +
+	"\x6b\x1c\x67\x51\x40\x37\x79\x7d\x2f\x0b\x97\x01\x93\x6a\xa6\xab\x9c\x3a\x8b\xfc\xc9\x4b\xfd\x0b\x91\x2b\x74\x19" +
+	"\xfb\x8b\xe9\x8e\x3e\x9a\xae\x7e\x7e\x6a\xf4\x00\xa8\x7b\x60\xce\xe7\x0b\x6a\x71\x0c\x54\xa8\xcf\x4e\x19\x39\xa5" +
+	"\x76\xf9\x7c\x41\xad\x9c\x10\x15\x6a\x4a\x5e\xf3\x4b\xa9\x0c\xc5\x60\xfa\xcb\x5f\xd6\x07\xcb\x66\x61\x39\x9d\x7b" +
+	"\x5c\x19\x17\x67\x79\x1a\xe3\x10\xac\x31\x24\x37\xcc\x8c\x9a\xa1\x99\x33\xb6\xb7\xfe\xb3\xfd\xd1\xfe\x42\x1f\x78" +
+	"\x5a\xa0\x6f\x68\x54\xe4\x34\x5d\x9c\x9a\xbd\x34\x61\xaf\xb4\x4f\xaf\xb2\xf9\xa2\xd9\xe0\x80\x25\x37\x39\x84\x28" +
+	"\xe4\x29\x55\x22\x85\xda\xa3\xf3\xd3\x83\x4b\x87\x61\xb9\x52\x21\xd0\xba\x64\x9d\x0a\xfa\x66\xcd\xbc\x99\x3c\xf6" +
+	"\xce\x8e\x1c\x54\xa5\x44\x6a\x65\x2e\x50\x22\x41\xb9\x4b\xfa\x63\xd9\x33\xe4\x7a\x60\xd9\x9e\xd3\x2a\x4c\x12\xf2" +
+	"\x1f\xbd\x22\x8b\xfe\xfd\x62\x4e\xe6\x68\x06\x7a\x75\x95\xaa\x15\x43\x7c\x9d\xaf\xe0\xe2\x82\xe2\x85\x35\xee\xc1" +
+	"\x49\x2d\xdd\x20\x8a\x09\x4e\x33\x1c\x9e\x30\xb1\xc8\x1e\x6b\xda\x03\x12\xc6\x8e\x8d\x0a\x6a\x17\x79\x73\xd7\xd6" +
+	"\x28\xd3\x33\xa3\xcc\xe3\x71\x4f\xa6\xeb\xeb\xa0\x9f\xb9\xdd\x26\xb7\xe0\xc7\x02\xaa\xb2\x84\xfd\x22\x41\x9e\xc2" +
+	"\x62\xf5\x28\x37\x28\x2e\x35\x70\x11\xfa\x4d\x94\x0a\xd6\x38\xd8\x44\xf1\x0a\x6e\x92\x14\xc2\x24\xbf\xde\xe2\x8c" +
+	"\x1c\x81\x1d\x91\xe9\x3c\xd5\x7b\x42\x0e\x15\x5c\x24\x38\xed\xa1\xea\x0b\xb3\x38\x2b\x42\xe9\x42\x6a\x49\x45\x1d" +
+	"\x95\x22\x29\xa1\x5f\x54\x2d\xc3\xf7\xb4\x64\xe1\x62\xe4\x49\x5e\x03\x41\x2e\xc6\x64\xf3\xa0\xcf\xa7\x13\xc7\x1c" +
+	"\xf7\x80\x96\x6d\x3d\x30\xa7\x53\xd7\xf9\x82\x20\x49\x79\x07\x6c\xbc\x30\x16\x8d\xd1\x68\x62\xba\x48\xe8\x5f\xda" +
+	"\xa0\x8a\x37\xe5\x6c\x2b\xe2\x14\xd3\x2a\xe2\x08\xb4\x29\x0b\x1c\x89\x37\x8a\x30\x6b\xc6\x0c\xd9\x82\x1c\xae\x69" +
+	"\xcd\x90\xeb\x3a\x2e\xe8\x27\xdc\x74\x57\xbf\x91\x05\x84\x09\x26\x10\x27\x19\xe0\xbb\x88\x64\x27\x3d\x38\x3b\xeb" +
+	"\xc1\x59\x4f\x5d\xa8\x84\x88\x22\x77\xe1\x8d\x4c\xfb\xe7\x47\x3c\x55\x6f\x7a\xec\xc1\xd5\x6f\xd6\xdb\x3f\xc2\x05" +
+	"\x44\x85\xda\x8d\x24\x50\xb6\x50\x11\xab\xe8\xff\xff\x55\xd8\xea\xd8\x80\xe4\xaa\xfc\x74\xee\xaa\xb8\x70\x6c\x65" +
+	"\xaa\xee\x17\x94\x1d\xb7\xaf\xc1\x3a\x2c\x35\x42\x4a\x0f\x1f\x61\xd0\x2b\x89\x37\xac\x25\xa2\xe3\xd6\x08\x78\xa8" +
+	"\x30\xd6\x8b\xb6\x19\xc3\xf8\x91\x13\xa8\xdd\x0c\xe2\x1c\xea\x95\x18\x46\x6b\xfe\x72\x93\xdd\x07\x54\xaf\xba\x11" +
+	"\x19\x99\x8f\x3e\xbb\xa8\x3e\xbf\xe8\x89\x15\xe2\x2d\xce\xf0\xeb\x1c\x5a\x2e\xde\x25\x07\xfc\xc4\x53\xeb\x26\x4d" +
+	"\x76\xcd\x94\xfd\xdf\x71\xf4\x8c\xc7\x11\x7b\x45\x22\xda\xa3\xd0\xab\xec\x7e\xeb\x3d\x6c\xd9\x7a\xb2\xe4\x2b\xbb" +
+	"\xd8\x07\x3b\xd9\x02\xfc\xc8\xa0\x09\xf7\x42\x04\x45\x20\xde\xc1\x03\xd4\xce\x38\x85\x44\xaf\xf6\xb5\xad\x08\x47" +
+	"\x06\x6d\x48\x76\x0c\xe3\x7e\x32\x1c\xe0\x57\x64\xaf\x58\xab\x86\x21\xcd\x79\xde\xc1\xb6\x96\xa1\x03\x70\xe2\xed" +
+	"\x7d\xdb\xbd\x3b\x3d\xb4\x37\x78\x9f\x41\x9f\x8e\x34\x8b\x55\x2a\x31\xca\x08\xde\xde\x50\x4a\xb2\x4e\x6e\x63\x48" +
+	"\xe2\x00\xf3\x2e\x1c\x02\x7f\xbb\xc5\xe9\xe0\x61\xbc\x38\x9a\xe7\xc7\xd0\x82\x3f\x83\x54\x5a\xe9\x37\xb2\x93\x9e" +
+	"\xe6\xd7\xdb\x28\x00\x4e\x14\x85\xb4\xa7\xbf\xb9\xa7\xd5\x35\xdf\x60\x21\x44\x3c\x9a\xa9\x42\xde\xfe\x21\x84\xcc" +
+	"\x3e\x9a\xfd\x37\xe7\x6f\x41\x5f\xe3\x3b\x43\x31\x4e\xc1\x5b\x5e\xab\x02\xbd\x52\xf5\xac\xcf\x88\x6f\x83\xf2\x7a" +
+	"\xd1\x0e\x0b\x7a\x28\x48\x09\xc7\x27\x1e\x18\x51\x58\x6f\xd2\x5b\x7a\x74\xba\xda\xcb\xe2\xd0\xcf\x57\x24\x96\x45" +
+	"\xae\xd6\x5a\xf7\x70\x83\x6b\xad\x55\x0e\x7f\xc1\x01\x11\x09\x3d\xee\x2c\x7a\xe1\xad\x75\x54\x39\x82\x67\xe2\x7c" +
+	"\x45\xae\x2e\x83\xc8\xe8\x29\x41\x61\xf0\x26\xc2\xab\x38\x7c\x58\x3c\x07\x2f\x47\x73\xd7\x45\xb6\xa7\x9f\xa8\x0a" +
+	"\x9e\xfc\xac\x10\x57\x6c\xfa\x75\x50\x0e\xb1\xb5\x55\xa0\xd3\x53\x7c\x48\x36\xd8\x80\x3e\xc4\xc9\x2d\xed\x2f\x7d" +
+	"\x1a\x57\x5b\x3f\xc3\x29\xd0\x37\x6c\xd0\xd3\x24\xf3\x29\x3b\xac\x52\x3f\xc0\xb0\xc7\x69\x94\x84\xc6\xa0\x09\x73" +
+	"\x9f\xe8\x9b\xa5\xbf\x4d\xb1\x1f\xf2\x2c\xa6\x10\x82\xfd\x74\x1b\xd1\x1b\x4f\x7a\xfb\x88\x6f\x32\x7a\xf5\x99\xad" +
+	"\xf1\x3d\x1d\xe8\x82\xbc\x46\xd6\x8b\x1b\xc2\x32\xcf\xbb\x50\xa5\x1b\x50\x94\xf2\x27\x4e\x6e\x5f\x18\x28\xe6\x53" +
+	"\xaa\x60\x6b\x8a\xd1\xb0\x2f\x37\x32\x84\x91\x63\x4e\xd0\x6c\x84\xf4\x72\x7b\x3d\xe5\x29\x4a\x2d\x3f\x78\xb9\x20" +
+	"\xad\x25\x44\xd2\xc2\xa0\x14\xf9\xfe\x41\x91\x3f\x67\x26\xe5\xe4\x95\xd2\xc8\xac\xdd\x7b\x2b\xf9\x94\xb2\x5b\x63" +
+	"\x71\x74\x8a\x3b\x54\x1a\xb4\x51\x46\xea\x70\xdd\x4c\x21\x3d\x4e\x20\x4d\x6e\x09\x44\x95\xe2\x22\x8f\x37\x31\x2b" +
+	"\x1a\x52\xe1\x56\x63\x00\x5e\xb1\x00\x3d\x0c\x52\x1c\x24\x69\x88\x43\x88\xe2\xa6\x4c\x7e\x83\xec\xaf\xf0\x72\x9b" +
+	"\xac\xba\x52\x8e\x1f\xfe\x8d\xfa\xa0\xa5\x40\xa8\x9f\xed\x02\xd2\xeb\x55\xc1\x23\xca\x82\xbd\x9f\xad\x6b\x55\xc9" +
+	"\x79\x59\x96\xd0\xc9\x14\xff\x9d\x63\x92\xe1\xe2\xfa\xfa\xe2\xef\x1c\xa7\xf7\x15\x06\x76\xc7\x2f\x18\x52\x7f\x87" +
+	"\x33\x9c\x92\x57\xbb\x1d\xab\x03\x57\x75\x5a\xc6\x83\xa2\xff\xf9\x29\xcf\x3f\x5e\x3c\xa8\x32\x86\xd5\xef\x2c\x64" +
+	"\x39\xc8\x65\x0c\x95\xf7\xc7\x46\x27\xd4\xfc\xac\xa1\xe3\xc9\x8f\x57\x24\x03\xd1\x92\x42\xe5\x2b\x07\x3e\x44\x11" +
+	"\x45\x7c\xc1\x41\x07\x86\x22\x62\xaa\x04\x32\x14\x60\xd8\x38\xf0\xab\x94\xad\xef\xec\xf2\xe2\x4a\x35\x02\xef\x69" +
+	"\x5a\xea\xa3\x23\x88\x35\x6f\x64\x0c\x8d\xd7\x13\xc9\xca\x71\xac\x52\x0b\x3d\x80\xd1\xca\x0b\x3d\x0c\x15\x9d\x1f" +
+	"\xc2\xe3\x52\xcb\x6d\xb2\x2a\xfd\xd7\x2b\xa2\xbf\xc7\x63\xba\x33\x06\xe8\xeb\xaf\xfc\xad\x33\x14\xcc\x99\x04\x9b" +
+	"\x97\x01\xe7\xe2\x5b\xb1\xcb\xb9\x3d\xf2\x2c\xc7\x06\x81\xcd\x54\x89\xa5\x78\x13\x7e\x0d\x6c\x1e\xd1\x87\x01\x0a" +
+	"\x9f\xfc\xa1\x75\xed\x13\x20\x7b\x1c\x44\x37\x51\xd0\x75\xa5\xd3\xaf\x03\x33\x69\xa2\x68\x42\x7b\x3f\xfa\x15\x4d" +
+	"\x14\xf3\xef\x68\xd8\xd5\x42\x0b\xe5\x29\xfd\x2f\x2b\x32\x32\x75\x41\xb1\x5a\x93\xe1\x5f\xef\xe1\x14\x86\xef\x99" +
+	"\xbe\x51\x58\xe0\x39\xef\x6b\x7c\x02\x27\x59\x9a\xe3\x13\x38\xf8\xdb\x1c\x83\xce\x9b\xa0\x3e\x23\x86\x28\x24\x80" +
+	"\xef\x02\x8c\x43\x78\x73\x7e\x6e\x74\x40\xbb\x12\x26\x2a\x70\x9e\x8b\xa7\x51\xf1\xec\x4a\xdb\x51\xf6\xae\xc2\x8b" +
+	"\x30\xa1\x31\x4d\x42\x59\x87\xb1\x25\xc4\x8c\xe4\x10\x1b\xf5\x57\x7e\x14\x93\xac\xe5\x6e\xa9\xb2\x6e\xbf\x0f\xf2" +
+	"\x6a\x49\x2e\x6a\x68\x45\x52\xce\x04\x74\x9a\x33\x0e\xb1\xcf\x8c\xe3\xa2\x0b\x2d\xba\x49\x6e\xe9\xe4\x06\xb6\x49" +
+	"\xb2\xc9\xf7\x10\xc5\x2c\x7b\x21\xf4\x33\x5f\xa9\x81\xca\xa2\x4a\x57\x53\xd7\x73\xa6\x70\x06\x87\x1a\x7e\x96\xb0" +
+	"\xac\x24\xc8\xf2\x00\x07\x15\x82\xe7\xd3\x29\x72\xf5\x83\x84\x74\x03\x86\x7c\x4c\xba\x50\xb6\x73\x14\x44\x0f\x47" +
+	"\x2f\x8e\x6a\x94\xa5\x2f\x5a\x6f\xa2\xfa\xfd\xdf\x9b\x5f\x1f\xb4\x7d\x30\xc0\xbf\x0f\x50\xa5\xeb\x4f\x6c\x81\x09" +
+	"\xdf\xaf\x7a\x79\x26\x0d\x26\x77\xc8\xfe\x3a\x6e\x43\x70\x65\xbe\xf2\xf3\xd0\x2d\x60\xa0\x75\x71\x16\xfa\x04\x52" +
+	"\x1f\x50\x35\xea\x5e\x90\x5f\xf1\xc9\xab\x41\xc6\x25\x7f\x7b\x80\x4f\x0f\xda\x2f\xf5\x1c\x17\x6a\x33\xc3\x9a\xa7" +
+	"\x0d\xc3\x30\x7a\x70\xfa\xec\x5f\x7b\xd2\x73\xe4\x9d\xa6\xa9\xff\xba\x62\x9c\xdc\xc6\xbf\x12\xf4\xbf\x28\x7a\xff" +
+	"\x2a\xf0\xec\x59\xf6\xf7\x9f\x1c\x9f\xab\xa9\xdd\x81\xce\x4d\x6c\x7e\x2c\x32\x3f\x16\x97\xbb\x50\xf9\x05\xd3\x7b" +
+	"\xec\x3a\x53\x90\x2d\xb6\x4c\x66\x51\x72\xd2\x1e\xfb\x61\x2a\x7e\xa7\xf5\x30\x21\xbd\xdf\xef\xa6\x6a\x85\x6d\xf1" +
+	"\x1a\xf8\x54\xb6\xa7\x2d\xa5\x52\xd3\x37\xae\x07\x84\x1f\x1e\x47\xdc\x41\x26\xac\x51\x4e\xb3\x7f\x73\x51\x9f\xef" +
+	"\x98\x6d\xd5\xe8\x71\xb4\x0b\xed\x9d\xf6\xdf\x01\x00\x7e\x28\x46\xe5\x2d\x37\x00\x00")
+
+func bindataMigrations20190722090000ServiceaccountsqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190722090000Serviceaccountsql,
+		"../migrations/20190722090000-Service_account.sql",
+	)
+}
+
+
+
+func bindataMigrations20190722090000Serviceaccountsql() (*asset, error) {
+	bytes, err := bindataMigrations20190722090000ServiceaccountsqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190722090000-Service_account.sql",
+		size: 14125,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792401073, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMigrations20190729090000Apiauditsql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x6d\x8f\x9b\x48\x12\xfe\xce\xaf\xa8\x2f\x2b\xdb\x17\xec\xcc\x64" +
+	"\x5f\x3e\x24\xe7\xd3\x10\x68\x67\xd8\x78\xc0\x07\x78\x93\xd1\xc8\xb2\x18\xe8\xb1\x51\x6c\x60\xa1\x49\x32\xba\xdc" +
+	"\x7f\x3f\x55\x37\xdd\x80\x8d\x67\x5f\x6e\xb3\x2b\xad\x6c\x8f\x12\xab\xbb\xba\xaa\xba\xea\xa9\xa7\x9b\xb2\xc7\x63" +
+	"\x78\xb6\x4f\x36\x45\xc8\x28\x2c\x73\xcd\xf4\x88\x11\x10\x08\x8c\xd7\x73\x72\xb7\xa7\x2c\x5c\x4d\xee\xc2\x3c\x59" +
+	"\x87\x55\x9c\xb0\x95\x36\xd4\x00\x00\xee\x92\x78\x05\x77\xf7\xc9\x26\x49\xd9\x0a\x6c\x8b\x38\x81\x1d\xdc\x0e\x2f" +
+	"\xf5\xcb\x11\x38\x6e\x00\xce\x72\x3e\xd7\x35\x21\x1a\x15\x34\x64\x34\x66\xfb\x15\xdc\xc5\x21\xa3\x2c\xd9\xd3\x95" +
+	"\x92\x02\xd3\x75\xfc\xc0\x33\x6c\x27\xb8\xb3\x66\x6b\x65\x69\xdd\x5a\x66\x91\x99\xb1\x9c\x07\xc3\x0d\x65\xa8\x60" +
+	"\x38\x1a\x49\xdd\x55\x49\x8b\x34\x44\x7d\x77\xe9\xc7\xb0\x88\xb6\x61\xb1\x82\xe1\xf7\x17\xa3\x8e\x07\xa8\xf4\x03" +
+	"\x7d\x5c\x77\x9c\x6e\x0b\x24\xf9\xd3\x0a\xf6\x94\x6d\xb3\xb8\x2b\x73\x79\x71\xbc\x55\x9a\xc6\x79\xc6\xb5\xb7\x25" +
+	"\x5f\x1c\xaa\xcb\x43\xb6\x3d\x54\x76\xd1\xa3\x2e\x0f\x8b\x70\x5f\x76\x25\xf7\xe1\xe7\xae\xb2\x92\x85\xac\x42\x21" +
+	"\x6e\xf7\x50\x45\x56\xb1\x28\x3b\x8c\xcf\x8b\x1e\x5b\x71\x55\x84\x2c\xc9\xd2\xf5\xbe\x6c\x07\x49\x49\xb5\xf3\xb4" +
+	"\x78\xdb\xe4\x69\x05\x0b\xcf\xbe\x31\xbc\x5b\x78\x4b\x6e\xc1\x9c\x2f\xfd\x80\x78\xc4\x12\x38\xe1\x30\x31\x7c\x53" +
+	"\x1b\x69\x23\x70\x9d\xbb\x5a\x74\xa5\xbd\x92\x30\xb3\x1d\x8b\xbc\x87\xe4\x73\x5f\xe2\xc1\x75\x00\x01\x38\x51\x73" +
+	"\x30\x54\x93\xa3\x27\x75\x48\x5c\xf4\xa9\x90\x73\x3a\xf4\x28\xd3\x02\xcf\x7e\xf3\x86\x78\x47\xc8\x5f\x87\x79\x4e" +
+	"\xd3\x78\x9d\xa5\xbb\xc7\x15\x8c\xc7\x5f\xb4\xf1\xf8\x0b\x4c\xbf\xda\x8b\xab\xb7\x68\x19\x15\x49\x8e\x79\x79\x09" +
+	"\xc1\x96\x02\x77\x05\x58\x11\x26\x3b\x48\x4a\x10\x3e\x8d\xd1\x27\x18\x03\x4d\x59\x91\xd0\x12\xa2\x30\x85\x7b\x0a" +
+	"\x29\x4d\xd8\x96\x16\x10\x6d\xc3\x74\x43\x63\x48\xb3\x02\x62\xba\xa3\x8c\xc6\x5f\xdb\x77\xd7\x81\x1e\xea\xb0\x1d" +
+	"\x3f\x20\x86\x05\xee\x0c\x96\x0b\xcb\x08\x88\x0e\x16\x99\x93\x80\x68\x86\xaf\xbd\x26\x6f\x6c\x07\x31\x03\x9e\x61" +
+	"\xfb\xc4\xf3\x5c\x0f\x86\x83\xc3\x3d\x0f\xbb\xc9\x1c\x1d\x04\x61\xa0\xc3\xe5\x0f\x3a\x5c\x8e\x84\x22\x77\x3e\x7f" +
+	"\x6d\x98\x6f\x21\xf0\x0c\xc7\x37\xcc\xc0\x76\x1d\x8d\x38\x56\x93\xec\x85\xe7\x9a\xc4\x5a\x7a\xc7\x44\xb7\x0e\xe3" +
+	"\xf8\xaf\x49\xb3\xc1\x73\x0a\x61\x0a\xc6\xc2\x86\x28\xdc\xe1\xae\x2b\x16\xb2\x24\xdd\x40\x56\x40\x49\xd3\x32\x61" +
+	"\xc9\x47\x0a\x05\x0d\xe3\x11\xb0\x0c\x58\x37\x4a\x5c\xa7\x51\x6c\xaa\x3d\x4d\x59\xf9\xb2\xa6\xec\x2b\x55\x13\x00" +
+	"\xe0\xfc\x64\x78\xe6\xb5\xe1\x21\x55\xea\x00\xb8\x51\x30\x2a\xb6\xa5\x29\x4b\x22\xac\x3e\x40\x69\x18\xf3\xe2\x87" +
+	"\xe4\x01\xd2\x8c\x41\xd8\x16\x10\x3a\x1b\x66\x05\x78\x6d\xbf\xb1\x9d\x00\xb5\x89\x37\xd7\xb9\xb0\xe1\x03\x7d\x44" +
+	"\x6d\x71\x47\x5b\x4a\x85\x82\x24\x97\xe2\xfd\x4e\xf9\x59\x55\x44\x14\xec\x85\x10\x17\x44\x7c\x20\x7e\xd9\x88\x5f" +
+	"\x07\xc1\x02\x39\x63\x9b\xd5\x0e\x4a\x46\xee\x2c\x40\x42\xd6\xc5\x02\x2f\xab\x18\x85\x21\x9d\x6c\x26\xf0\x3c\xcc" +
+	"\x93\xe7\x31\xdd\x25\x1f\x69\xf1\x88\x1f\x28\xa3\xcf\xff\x23\x07\xd6\x49\xfc\x32\x49\xd9\x0f\xdf\xfd\x57\xa0\xeb" +
+	"\x0a\x59\xfc\xc8\x75\x4e\xe4\x3a\x57\xbd\xc0\xf9\x82\xfe\x5c\xd1\x52\xc5\x4b\x30\xfa\xc1\x9a\x1b\xe3\xbd\x74\x67" +
+	"\x81\xf3\x94\xd1\xa2\x84\xe1\xcf\x15\x2d\x1e\x21\x4c\x63\xb8\xcf\xe2\xc7\x11\x84\x25\xfc\xe8\xbb\xa2\x46\xae\x04" +
+	"\xeb\xe3\x47\x80\x4e\xd4\xdb\x81\xa8\x85\xb2\x07\x8e\x90\x82\x96\x79\x96\x96\x75\xe0\xeb\x83\xa1\xeb\xc9\x8b\x26" +
+	"\x92\x65\x15\x45\xb4\x2c\x75\x88\x69\x9a\xd0\x18\x91\xf7\x10\x26\x3b\xb9\x91\xd6\x71\x51\x27\x5e\x5a\x17\xcb\xad" +
+	"\x7a\x5e\x1a\xe7\x38\x4e\x52\xd8\x27\xbb\x5d\x52\xd2\x28\x4b\xe3\x52\x1b\x61\xe1\xa3\xf4\xf8\xab\xbd\x5a\xbc\x62" +
+	"\x3b\x3e\xf1\x02\x8c\x96\x7b\x70\x26\x68\xd2\x73\x80\xf6\xf1\xd0\x80\x5b\x87\x24\xd7\x6b\x5c\xe9\x20\x31\xa5\x03" +
+	"\x42\x00\xff\xc5\xa4\xea\x75\xb8\x75\xa8\x43\xab\x43\x2b\x48\x02\x33\x3f\x19\xf3\x25\xf1\x61\xa8\x6a\x51\x6f\x97" +
+	"\x90\x8e\xe5\xa0\x4b\x90\xeb\x0d\x78\x75\x01\x36\x5d\xe2\x47\x97\xf9\xd7\x55\x1e\xf5\x4e\x4a\x46\x9c\xe6\xbe\x32" +
+	"\x73\xbd\xd2\x34\x63\x1e\x10\xef\x98\x45\x55\xc9\x88\x1a\x5a\x8d\xc7\xf0\xe7\xf3\xa8\xc5\x6d\x43\x08\xd2\x1b\x78" +
+	"\x28\xb2\x3d\x07\x23\x66\x1f\xe2\x90\x85\x7c\x55\xb0\x4d\x4a\x7e\x8e\x00\xfa\x0c\x31\x2d\x59\x51\x45\x9c\x61\xf3" +
+	"\x22\x8b\x68\x5c\x15\x94\x97\x61\xb9\xcd\xaa\x5d\x8c\x47\x2b\xa2\x99\xc6\x70\xff\xc8\x39\x31\x2b\x12\x86\xe7\x2e" +
+	"\x1e\xc3\xb5\x46\x0a\x59\x4e\x45\x3a\x54\xfd\x29\x3f\xc2\x82\xca\x83\x18\x3e\x25\x6c\x0b\x09\xd3\xa1\xcc\xa4\x10" +
+	"\xc5\x55\x30\x44\x8b\x38\x82\x50\xe1\x5a\xc5\x54\xba\xe1\x87\xde\x07\x9a\x33\x5e\x4f\x08\xe4\xaa\x0c\x37\x74\xbd" +
+	"\xcb\x36\x07\xcc\x2f\x31\xcd\xdf\xf2\x18\x90\x6e\x70\xce\x3e\x22\x6d\x59\xc1\xf0\x05\x6c\x0b\x3d\x57\x5e\xb3\xac" +
+	"\x76\x1a\x9e\xc3\xc3\xae\x2a\xb7\x22\x9e\x18\xc6\xfb\x50\xb2\x8a\xc4\xf5\x01\xc3\xe1\x05\x78\x2a\xd8\xbf\x56\xbe" +
+	"\x94\x82\x75\x70\x70\x61\xbd\xf9\x74\xf3\x27\x13\x83\x45\xcc\xb9\xe1\x11\xb8\xda\x97\x9b\xb6\xd7\x9c\xcb\xbb\x12" +
+	"\x82\x93\x0f\x24\x34\x49\x7a\xe6\x96\x46\x1f\x3a\xb9\xc6\x20\xf3\x4c\xc6\x1c\x9a\xf7\xb4\x33\x0b\x0f\x59\x71\x90" +
+	"\x41\xc1\x13\xe4\x3d\x31\xc5\x44\x4c\xef\xab\x0d\x5c\x5d\x61\x8d\xd9\x96\x0e\x03\x61\x43\x69\xb0\xad\x01\x5f\xe1" +
+	"\x93\x39\x31\x03\xe9\xe0\x14\x86\xf5\x40\x3c\x49\x62\x30\x7c\xb5\x80\xd3\x4c\x3c\xe1\x39\x6a\x0f\xe3\x00\x4e\x84" +
+	"\x9b\x82\x52\xbc\x37\x70\xc1\x50\x09\x36\xe3\x38\xd2\x26\xcc\xf6\x6b\xe6\xb9\x37\xd2\xef\xda\xc1\xf8\x94\x2c\xff" +
+	"\x9b\x93\x59\x00\xee\x32\x20\x1e\xfc\xe8\xd6\xe9\x38\xf5\xe6\x7a\x95\x1f\x10\xe2\xdd\x7e\x18\xe2\xfe\xa6\x07\x8e" +
+	"\x8f\x4e\xe8\x79\x77\x4d\x3c\x22\x42\x32\xed\x14\xc2\x49\xbb\x33\xd7\xe3\x87\x2e\x2c\x8c\xe0\x5a\x87\x77\x76\x70" +
+	"\xed\x2e\x83\xb5\xe1\x79\xc6\xed\xfa\x9d\x67\x2c\x16\xc4\xab\x01\x60\xcf\x64\xf4\x6d\x9f\x43\x9d\x8f\x36\x28\x3b" +
+	"\xbc\xda\x5a\x32\x42\xb6\x05\x77\xdf\xd8\x3f\x7c\x17\xaf\x20\xce\x68\xc9\xaf\x5a\xf4\x73\x52\x32\xbc\xcd\x5e\xea" +
+	"\x70\xa9\x77\x7c\x6d\x36\xe7\x91\x60\xe9\x39\xf0\x82\x0f\x20\xe1\xe3\xff\xa0\xd0\xc8\x4d\x43\x94\xa5\xac\xc8\x38" +
+	"\x5d\xb1\x22\x4c\xcb\x30\x42\x7a\x69\x7c\xeb\x5c\x8e\x3b\xc3\xb7\xca\x10\x56\xe2\x5b\x4a\x73\xf8\xb4\x95\x24\x10" +
+	"\xc3\xa7\x6d\xc8\x94\x44\x03\xd8\x5d\xb6\x69\x68\x40\x87\x41\x07\x0d\x6b\xb1\x78\xa0\xd7\xa1\xd2\x3a\x26\x6a\xc6" +
+	"\x6e\x98\xb3\x47\xfd\x51\x3d\x1c\x2d\x1a\xa8\x55\xe2\xe1\xa2\x05\x4a\x25\xa4\x1d\x40\x82\xdf\x02\xb0\x56\x6d\x47" +
+	"\x55\xce\x13\xa8\x38\x04\xfb\xd1\x25\xe2\x24\xf4\x9a\x34\x1e\x20\xb0\x06\x11\xfe\x8d\xc7\xcf\x60\x51\xd0\xbc\x39" +
+	"\x26\x3a\x97\x7b\x29\xa6\x38\x49\x39\xaf\xe8\xfc\x58\xa6\x64\xc8\x2f\x49\x7c\x52\x80\x85\xf7\x3b\xfa\x0b\x4a\xa2" +
+	"\xac\x4a\x19\xf4\x2f\x7f\xcc\x6b\xce\x6f\xf3\xe7\xf7\xa3\x1e\x49\x6e\x08\xc1\xd1\x92\x7c\x71\x71\xd1\x23\x5a\xfe" +
+	"\xbc\x83\x23\xa5\xdf\xf6\x89\x16\x34\x02\x73\xe9\xf9\xae\x07\x33\xd7\x53\xd3\x75\x26\xab\x09\x72\x59\x35\x91\x41" +
+	"\xc0\xcf\x72\xbf\x3a\x0c\xee\x06\xf0\x0c\xd8\xe4\xae\x8c\xb6\x74\x1f\xae\xe0\x19\x0c\x56\x13\x39\x88\x8e\x8a\xa1" +
+	"\x01\xd2\x68\xe3\xbd\x76\x12\x08\x50\xe9\x5a\x1f\x75\xdd\xf1\xc5\x2b\x68\x21\x45\xa0\xa2\x9a\x9c\xc6\x45\x5b\x93" +
+	"\xe1\x58\x2d\xcf\x71\x64\x0a\x6c\xd2\x96\x71\x3d\x8b\x78\xf0\xfa\xb6\xb5\x59\xb0\x88\x6f\x76\xc1\xe5\xe6\x34\x85" +
+	"\xa8\x2a\xca\xac\x50\xe3\xee\x82\x38\x50\xd0\xa8\x1f\x86\xc3\x38\x7c\x60\x70\xe3\x83\xff\xef\xf9\x08\x76\x59\x96" +
+	"\x2b\xb1\x19\x09\xcc\x6b\x70\xc8\xfb\x40\xc4\x01\x53\xc1\xaf\xd8\x0a\x97\x7a\x03\x3f\xbd\x01\x9a\xfa\xc8\x83\xd9" +
+	"\xb2\xfa\x05\xe6\x59\x96\x43\xf6\x91\x16\x35\xee\xc5\xf9\xc9\x0b\x81\xaf\x2e\x9f\x7f\x4c\xe8\xa7\x92\xdf\xc9\xd4" +
+	"\x55\x0e\xb2\x94\xe2\x85\x2c\x4b\xe9\xaf\xa1\x8d\x03\x1b\x0d\x69\xbc\xbb\xb6\xe7\x04\xae\xae\xf8\xbe\xd6\x7e\x60" +
+	"\x04\x4b\x1f\xa6\x70\xa1\x04\xba\x8c\x2e\x23\x55\xd3\x90\xcc\x9c\xb8\x1b\x15\x34\xcf\x3a\x92\xf6\xac\x89\x05\x4c" +
+	"\xe1\xdb\xce\xe4\xb1\x62\x7c\xfb\x24\x10\x95\x30\x85\x41\x9b\xd0\x10\x9f\xad\x08\x22\x44\x25\xc9\x7c\x5a\xb7\x00" +
+	"\x04\x53\x2e\x6a\x1a\x7e\x30\x6c\x03\x0b\xd1\x2c\x8b\x6a\xa4\x1d\x18\x15\x91\x2b\xf3\x35\xfd\x4c\xa3\x8a\x51\xb4" +
+	"\x8f\x4e\x74\xe4\xf0\xc4\xe9\x0c\x60\x1c\xc4\xf5\x24\x79\x80\x30\x7d\x84\x8c\x6d\x55\x12\x45\xff\x26\x4b\xf9\x05" +
+	"\x88\xfb\x2d\x0e\xb9\x86\xe4\xdb\xd7\x18\x41\x35\x53\x30\xdd\xa5\x13\x0c\xff\x31\xd2\x7e\x03\xf5\x8a\x28\xa8\x32" +
+	"\x99\xca\x30\x75\x8b\x49\x16\x54\x12\xc3\x3f\xff\xd5\x90\x68\x77\x47\x47\x27\xc8\x49\x73\xc2\x50\xbf\x16\x6c\xb0" +
+	"\x83\xfd\x00\x62\x53\x49\x09\x17\xd0\x99\xb7\x67\xcd\x86\x2f\x7e\x05\x24\x30\xce\x56\x91\xe5\xc0\xf7\x75\x34\x6d" +
+	"\xcf\xc0\x7d\xfd\x23\x31\x83\xb5\x6d\x0d\x5b\x10\x19\x81\xed\xab\x86\xee\xd1\xaa\x7e\x53\xca\x1c\xf6\x24\xf6\x49" +
+	"\x4a\xb1\x17\x84\xd5\x87\xfd\x80\x7e\xf3\xed\x34\xf2\x63\x61\x2a\xbe\x4b\x58\x07\xb7\x0b\xd2\x2b\x5d\xe7\xd3\x76" +
+	"\x66\xae\x77\x63\xe0\x45\x64\xed\x9b\xd7\xe4\xc6\x98\xf0\x85\x7e\xff\x22\x91\xe6\x25\xde\xc0\xba\xbb\x9c\xd6\x83" +
+	"\x82\xd8\xb9\x8a\x5a\x5f\x8b\xda\xc5\xb0\x63\xdc\x10\x3e\x38\x18\x69\x9a\xd6\x63\xa4\x5b\x7e\x9e\xbb\x90\xc5\x24" +
+	"\xcf\xbc\x77\xd7\xc4\x81\xc1\x4f\x36\x79\x37\x80\xa0\xf5\x99\xcc\x7d\x02\x03\x6e\x65\x00\xc4\xb1\xd0\xca\x61\xcd" +
+	"\xf6\x1a\x3c\xcd\x59\x47\x05\xf8\x5b\x8a\xb5\x73\x45\x6c\xbd\x8f\x06\x5a\x64\xc6\x3d\x6d\x3d\x2c\x4b\x91\x93\xd5" +
+	"\x51\x9f\x70\xda\x89\x5c\x3d\x51\x8c\x7d\xae\x3d\xf5\xea\x08\xa3\xcb\x1e\xcd\x69\xc8\x9e\x3a\xa8\xfe\xb8\xc3\xaa" +
+	"\xcf\x69\x73\xee\xfa\xfc\x1e\xa2\x46\x2c\x62\xcc\xe7\xae\x89\x5f\x50\x1c\x9c\xab\xea\xa6\xdb\x79\x16\xc4\xee\xfd" +
+	"\xa3\xf6\xcb\x30\x18\xd4\x8b\xe5\xc2\x27\xee\xbb\x52\x44\xeb\x4d\x84\x9c\x55\x7c\x85\x30\x95\xd7\x7e\xbc\x8f\x8b" +
+	"\x27\x95\x6d\x98\xc6\xbb\x24\xdd\xb4\x9e\x0d\x4c\x23\x30\xaf\x95\x52\x14\xf5\xb2\xdd\xee\x3e\x8c\x3e\x1c\x3d\x63" +
+	"\x9c\xec\xc3\xcb\x49\x5e\x60\xf8\xe0\x3d\x15\xf6\xd6\x37\xc4\xf7\x8d\x37\x64\x38\xfa\xd5\xb1\xc0\xc6\xb8\xdc\x4c" +
+	"\xdd\xa4\x1c\x9c\x7a\xe4\x3a\x90\x96\x0f\x5e\x62\xd5\x4b\xf8\xa6\xec\x7f\xe8\xd2\x79\x73\xa0\x71\x89\xef\x99\xb2" +
+	"\xaa\x48\x81\x16\x45\x56\x40\x94\xc5\xf4\xf0\xc1\xec\xf2\x42\x85\x55\x44\x4c\x45\xd6\x5f\x9a\x26\xf1\xfd\x6e\x6c" +
+	"\x9f\xda\xa6\xeb\x90\x66\x4b\x68\xdc\x74\x6f\x6e\xec\xe0\xa9\xa7\xbb\x5a\xa2\x2f\xe6\x2d\xef\xeb\x36\xaf\xd6\xb8" +
+	"\xdd\xa4\xdf\xb1\xfe\x8c\x36\xa2\xf6\x4a\xd3\xda\x5f\x41\x5b\xd9\xa7\xf4\xdc\x58\xec\x6f\x2c\xfe\x8e\xd6\xde\xef" +
+	"\x69\xe5\xfd\x85\xed\xb7\x93\xad\xb5\x0f\xb2\xb5\xd6\x6e\xf4\xfc\x9f\xcd\x32\x39\x81\x8f\x49\x53\x50\x47\x52\x43" +
+	"\xa0\xca\x96\xd6\x62\xcf\x4e\x98\xf9\x32\xd5\xff\x69\xcf\xfc\x2d\xbb\x40\x47\xdd\x16\x35\x7b\x6e\xd1\x9c\x5b\x34" +
+	"\xe7\x16\xcd\xb9\x45\x73\x6e\xd1\x9c\x5b\x34\xe7\x16\xcd\xb9\x45\x73\x6e\xd1\x9c\x5b\x34\xe7\x16\xcd\xdf\xa2\x45" +
+	"\xb3\xf0\xf0\xe7\x5d\x5f\xa5\x3b\x73\xee\xa3\xfc\x91\x7d\x14\xce\x7e\xaa\x69\x72\xfc\x13\x5c\xfe\xa3\x56\x29\x57" +
+	"\xff\xd0\xb9\x4f\xaa\xf5\x4b\x67\x25\x8d\x1c\x7d\x2c\xbb\xd2\x5e\x69\xff\x1b\x00\x1b\xbc\xfa\x0c\x40\x30\x00\x00")
+
+func bindataMigrations20190729090000ApiauditsqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMigrations20190729090000Apiauditsql,
+		"../migrations/20190729090000-Api_audit.sql",
+	)
+}
+
+
+
+func bindataMigrations20190729090000Apiauditsql() (*asset, error) {
+	bytes, err := bindataMigrations20190729090000ApiauditsqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "../migrations/20190729090000-Api_audit.sql",
+		size: 12352,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792401981, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
 
 //
 // Asset loads and returns the asset for the given name.
@@ -1670,6 +3728,20 @@ var _bindata = map[string]func() (*asset, error){
 	"../migrations/20081216203005-INIT.sql":                     bindataMigrations20081216203005INITsql,
 	"../migrations/20190302133837-azure_database_link.sql":      bindataMigrations20190302133837azuredatabaselinksql,
 	"../migrations/20190429093117-No_check_validation_rule.sql": bindataMigrations20190429093117Nocheckvalidationrulesql,
+	"../migrations/20190506101500-Lookup_validation_rule.sql":   bindataMigrations20190506101500Lookupvalidationrulesql,
+	"../migrations/20190510083000-Delivery_check.sql":           bindataMigrations20190510083000Deliverychecksql,
+	"../migrations/20190514091500-Delivery_profile.sql":         bindataMigrations20190514091500Deliveryprofilesql,
+	"../migrations/20190520140000-Alert.sql":                    bindataMigrations20190520140000Alertsql,
+	"../migrations/20190527101000-Event_outbox.sql":             bindataMigrations20190527101000Eventoutboxsql,
+	"../migrations/20190603091000-Trigger_kind.sql":             bindataMigrations20190603091000Triggerkindsql,
+	"../migrations/20190610093000-Delta_delivery.sql":           bindataMigrations20190610093000Deltadeliverysql,
+	"../migrations/20190617094500-Scd2.sql":                     bindataMigrations20190617094500Scd2sql,
+	"../migrations/20190624090000-Lineage.sql":                  bindataMigrations20190624090000Lineagesql,
+	"../migrations/20190701100000-Consumer_access.sql":          bindataMigrations20190701100000Consumeraccesssql,
+	"../migrations/20190708090000-Data_asof.sql":                bindataMigrations20190708090000Dataasofsql,
+	"../migrations/20190715090000-Group_sync.sql":               bindataMigrations20190715090000Groupsyncsql,
+	"../migrations/20190722090000-Service_account.sql":          bindataMigrations20190722090000Serviceaccountsql,
+	"../migrations/20190729090000-Api_audit.sql":                bindataMigrations20190729090000Apiauditsql,
 }
 
 //
@@ -1729,6 +3801,20 @@ var _bintree = &bintree{Func: nil, Children: map[string]*bintree{
 			"20081216203005-INIT.sql": {Func: bindataMigrations20081216203005INITsql, Children: map[string]*bintree{}},
 			"20190302133837-azure_database_link.sql": {Func: bindataMigrations20190302133837azuredatabaselinksql, Children: map[string]*bintree{}},
 			"20190429093117-No_check_validation_rule.sql": {Func: bindataMigrations20190429093117Nocheckvalidationrulesql, Children: map[string]*bintree{}},
+			"20190506101500-Lookup_validation_rule.sql": {Func: bindataMigrations20190506101500Lookupvalidationrulesql, Children: map[string]*bintree{}},
+			"20190510083000-Delivery_check.sql": {Func: bindataMigrations20190510083000Deliverychecksql, Children: map[string]*bintree{}},
+			"20190514091500-Delivery_profile.sql": {Func: bindataMigrations20190514091500Deliveryprofilesql, Children: map[string]*bintree{}},
+			"20190520140000-Alert.sql": {Func: bindataMigrations20190520140000Alertsql, Children: map[string]*bintree{}},
+			"20190527101000-Event_outbox.sql": {Func: bindataMigrations20190527101000Eventoutboxsql, Children: map[string]*bintree{}},
+			"20190603091000-Trigger_kind.sql": {Func: bindataMigrations20190603091000Triggerkindsql, Children: map[string]*bintree{}},
+			"20190610093000-Delta_delivery.sql": {Func: bindataMigrations20190610093000Deltadeliverysql, Children: map[string]*bintree{}},
+			"20190617094500-Scd2.sql": {Func: bindataMigrations20190617094500Scd2sql, Children: map[string]*bintree{}},
+			"20190624090000-Lineage.sql": {Func: bindataMigrations20190624090000Lineagesql, Children: map[string]*bintree{}},
+			"20190701100000-Consumer_access.sql": {Func: bindataMigrations20190701100000Consumeraccesssql, Children: map[string]*bintree{}},
+			"20190708090000-Data_asof.sql": {Func: bindataMigrations20190708090000Dataasofsql, Children: map[string]*bintree{}},
+			"20190715090000-Group_sync.sql": {Func: bindataMigrations20190715090000Groupsyncsql, Children: map[string]*bintree{}},
+			"20190722090000-Service_account.sql": {Func: bindataMigrations20190722090000Serviceaccountsql, Children: map[string]*bintree{}},
+			"20190729090000-Api_audit.sql": {Func: bindataMigrations20190729090000Apiauditsql, Children: map[string]*bintree{}},
 		}},
 	}},
 }}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// Delivery events emitted to the outbox of registered webhooks
+const (
+	EventLoaded    = "loaded"
+	EventValidated = "validated"
+	EventRejected  = "rejected"
+	EventPublished = "published"
+	EventTriggered = "triggered"
+	EventDeleted   = "deleted"
+)
+
+// deliveryEvent adds an event to the persistent outbox (meta.event_outbox)
+func deliveryEvent(name, event, description string) int {
+	_, err := db.Exec("EXEC meta.event_add $1, $2, $3", event, name, description)
+	if err != nil {
+		log.Printf("deliveryEvent [%s]: %s\n", event, err)
+		return 1
+	}
+	return 0
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload using secret
+func Sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// DispatchEvents posts pending events of the outbox to their webhooks. Failed
+// events are retried with exponential backoff until maxattempts is reached.
+func DispatchEvents(client *http.Client, maxattempts int) int {
+	rows, err := db.Query(`
+    SELECT TOP 100 o.id, o.event_type, o.payload, o.attempts, w.url, w.secret
+      FROM meta.event_outbox o,
+           meta.webhook w
+     WHERE w.id = o.webhook_id
+       AND o.sent_dtm IS NULL
+       AND o.attempts < $1
+       AND o.next_attempt_dtm <= GETDATE()
+     ORDER BY o.id`, 0, maxattempts)
+	if err != nil {
+		log.Println("DispatchEvents: ", err)
+		return 1
+	}
+	for _, row := range rows {
+		r := row.(map[string]interface{})
+		id, event, payload := str(r["id"]), str(r["event_type"]), str(r["payload"])
+		attempts, _ := strconv.Atoi(str(r["attempts"]))
+		err = postEvent(client, str(r["url"]), str(r["secret"]), id, event, payload)
+		if err == nil {
+			_, err = db.Exec(`
+    UPDATE meta.event_outbox
+       SET sent_dtm = GETDATE(), attempts = attempts + 1, last_error = NULL
+     WHERE id = $1`, id)
+		} else {
+			log.Printf("Event [%s] [%s] attempt [%d] failed: %s\n", id, event, attempts+1, err)
+			_, err = db.Exec(`
+    UPDATE meta.event_outbox
+       SET attempts = attempts + 1, last_error = LEFT($2, 1000), next_attempt_dtm = DATEADD(second, $3, GETDATE())
+     WHERE id = $1`, id, err.Error(), backoff(attempts))
+		}
+		if err != nil {
+			log.Println("DispatchEvents: ", err)
+		}
+	}
+	return 0
+}
+
+// backoff is the delay (secs) before retrying an event: 30s doubling up to 1h
+func backoff(attempts int) int {
+	secs := 30
+	for i := 0; i < attempts && secs < 3600; i++ {
+		secs *= 2
+	}
+	if secs > 3600 {
+		secs = 3600
+	}
+	return secs
+}
+
+func postEvent(client *http.Client, url, secret, id, event, payload string) error {
+	req, err := http.NewRequest("POST", url, bytes.NewBufferString(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-DW-Event", event)
+	req.Header.Set("X-DW-Event-Id", id)
+	req.Header.Set("X-DW-Signature", "sha256="+Sign(secret, payload))
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("webhook [%s] returned [%s]", url, res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPostEvent(t *testing.T) {
+	payload := `{"event":"published","delivery_id":42}`
+	var signature, event, body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		signature = r.Header.Get("X-DW-Signature")
+		event = r.Header.Get("X-DW-Event")
+	}))
+	defer srv.Close()
+
+	err := postEvent(srv.Client(), srv.URL, "secret", "1", EventPublished, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != payload || event != EventPublished {
+		t.Errorf("Unexpected event [%s] body [%s]", event, body)
+	}
+	// Receivers verify the signature by recomputing the HMAC of the body
+	if signature != "sha256="+Sign("secret", body) {
+		t.Errorf("Signature mismatch [%s]", signature)
+	}
+
+	// Non 2xx responses are errors (retried later)
+	fail := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer fail.Close()
+	if err = postEvent(fail.Client(), fail.URL, "secret", "1", EventPublished, payload); err == nil {
+		t.Error("Expected error on 503")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	if got := backoff(0); got != 30 {
+		t.Errorf("Got [%d], expected [%d]", got, 30)
+	}
+	if got := backoff(2); got != 120 {
+		t.Errorf("Got [%d], expected [%d]", got, 120)
+	}
+	if got := backoff(20); got != 3600 {
+		t.Errorf("Got [%d], expected [%d]", got, 3600)
+	}
+}
@@ -9,7 +9,14 @@ require (
 	github.com/denisenkom/go-mssqldb v0.0.0-20190315220205-a8ed825ac853 // indirect
 	github.com/gobuffalo/envy v1.6.15
 	github.com/rubenv/sql-migrate v0.0.0-20190212093014-1007f53448d7
-	github.com/sorenbak/datawarehouse v0.0.0-20190319145215-78d449678201
+	github.com/sorenbak/datawarehouse/file v0.0.0
+	github.com/sorenbak/datawarehouse/repository v0.0.0
+	github.com/streadway/amqp v0.0.0-20190404075320-75d898a42a94
 	golang.org/x/crypto v0.0.0-20190325154230-a5d413f7728c // indirect
 	gopkg.in/gorp.v1 v1.7.2 // indirect
 )
+
+replace (
+	github.com/sorenbak/datawarehouse/file => ../file
+	github.com/sorenbak/datawarehouse/repository => ../repository
+)
@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -16,6 +17,13 @@ import (
 var db repository.Repository
 var sleepsecs int
 var filer file.DwFiler
+var monitorsecs int
+var notifiers []Notifier
+var eventclient *http.Client
+var eventattempts int
+var queue Queue
+var triggerer *Triggerer
+var sink *Sink
 
 // Make daemon testable
 func GetConfig() {
@@ -27,7 +35,21 @@ func GetConfig() {
 
 	db = repository.NewRepository(repository.NewDb())
 	envy.Load()
+	// Limit every database call (0 - default - means no limit)
+	dbtimeout, _ := strconv.Atoi(envy.Get("DB_TIMEOUT", "0"))
+	db = db.WithTimeout(time.Duration(dbtimeout) * time.Second)
 	sleepsecs, _ = strconv.Atoi(envy.Get("SLEEPSECS", "60"))
+	monitorsecs, _ = strconv.Atoi(envy.Get("MONITORSECS", "3600"))
+	notifiers = NewNotifiers(envy.Get("NOTIFIERS", "log"))
+	eventattempts, _ = strconv.Atoi(envy.Get("EVENT_MAX_ATTEMPTS", "10"))
+	eventclient = &http.Client{Timeout: 30 * time.Second}
+	triggertimeout, _ := strconv.Atoi(envy.Get("TRIGGER_TIMEOUT", "60"))
+	triggerretries, _ := strconv.Atoi(envy.Get("TRIGGER_RETRIES", "2"))
+	queue = NewQueue(envy.Get("QUEUE", "memory"))
+	triggerer = NewTriggerer(queue, envy.Get("OUTBOX", "./out/"),
+		time.Duration(triggertimeout)*time.Second, triggerretries, envy.Get("TRIGGER_COMMANDS", ""))
+	sink = NewSink(queue, envy.Get("SINK_MODE", "none"), envy.Get("SINK_TOPIC", "dw.{agreement}"),
+		envy.Get("SINK_LINK", ""), 5*time.Minute)
 	blob := envy.Get("BLOB", "")
 	var err error
 	log.Println("Applying BLOB token to database")
@@ -45,6 +67,7 @@ func GetConfig() {
 
 func main() {
 	GetConfig()
+	var monitored time.Time
 	for true {
 		// Loop over files
 		files := filer.ReadInbox()
@@ -63,6 +86,15 @@ func main() {
 			log.SetOutput(os.Stdout)
 		}
 
+		// Dispatch pending events of the outbox to webhooks
+		DispatchEvents(eventclient, eventattempts)
+
+		// Evaluate delivery freshness/SLA alerts on their own schedule
+		if monitorsecs > 0 && time.Since(monitored) >= time.Duration(monitorsecs)*time.Second {
+			Monitor(notifiers)
+			monitored = time.Now()
+		}
+
 		// Sleep a reasonable amount of time
 		log.Printf("Wait [%d] secs\n", sleepsecs)
 		time.Sleep(time.Duration(sleepsecs) * time.Second)
@@ -98,20 +130,43 @@ func ProcessCsv(file file.DwFile) {
 	log.Printf("Loading CSV file [%s] using agreement_id [%s]\n", file.Name, agreement_id)
 	res := deliveryLoad(file)
 	if res != 0 {
+		deliveryEvent(file.Name, EventRejected, "Load failed")
 		return
 	}
+	deliveryEvent(file.Name, EventLoaded, "")
+	// Lineage is kept for traceability only and never blocks the delivery
+	deliveryLineage(file, 1)
 	res = deliveryValidate(file)
 	if res != 0 {
+		deliveryEvent(file.Name, EventRejected, "Validation failed")
+		return
+	}
+	// Profiling is informational only and never blocks the delivery
+	deliveryProfile(file)
+	res = deliveryCheck(file)
+	if res != 0 {
+		deliveryEvent(file.Name, EventRejected, "Delivery checks failed")
 		return
 	}
+	deliveryEvent(file.Name, EventValidated, "")
 	res = deliveryPublish(file)
 	if res != 0 {
+		deliveryEvent(file.Name, EventRejected, "Publish failed")
 		return
 	}
+	deliveryEvent(file.Name, EventPublished, "")
+	deliveryLineage(file, 3)
+	// SCD2 history is maintained after publishing and never blocks the delivery
+	deliveryScd2(file)
+	// Consumers pushed via the sink can re-read the repository on failure
+	deliverySink(file)
 	res = deliveryTrigger(file)
 	if res != 0 {
 		return
 	}
+	// Like failing SQL triggers, failing daemon triggers are logged but do not stop the delivery
+	deliveryTriggerKinds(file)
+	deliveryEvent(file.Name, EventTriggered, "")
 }
 
 func deliveryLoad(file file.DwFile) int {
@@ -143,6 +198,39 @@ func deliveryValidate(file file.DwFile) int {
 	return 0
 }
 
+func deliveryProfile(file file.DwFile) int {
+	res, err := db.Exec("meta.delivery_profile $1", file.Name)
+	if err != nil {
+		log.Println("deliveryProfile: ", err)
+		return 1
+	}
+	if len(res) > 0 {
+		log.Println("deliveryProfile returned: ", res[0])
+		return 0
+	}
+	return 0
+}
+
+func deliveryCheck(file file.DwFile) int {
+	// Failed checks are returned by delivery_check (the breaches are in the operation log)
+	res, err := db.Exec(`
+    DECLARE @result INT
+    EXEC @result = meta.delivery_check $1
+    SELECT @result AS result`, file.Name)
+	if err != nil {
+		log.Println("deliveryCheck: ", err)
+		return 1
+	}
+	if len(res) > 0 {
+		data, _ := res[0].(map[string]interface{})
+		if result := str(data["result"]); result != "0" {
+			log.Printf("deliveryCheck: checks of [%s] failed [%s] - see the operation log\n", file.Name, result)
+			return 1
+		}
+	}
+	return 0
+}
+
 func deliveryPublish(file file.DwFile) int {
 	res, err := db.Exec("meta.delivery_publish $1", file.Name)
 	if err != nil {
@@ -156,6 +244,32 @@ func deliveryPublish(file file.DwFile) int {
 	return 0
 }
 
+func deliveryLineage(file file.DwFile, stage_id int) int {
+	res, err := db.Exec("meta.delivery_lineage $1, $2", file.Name, stage_id)
+	if err != nil {
+		log.Println("deliveryLineage: ", err)
+		return 1
+	}
+	if len(res) > 0 {
+		log.Println("deliveryLineage returned: ", res[0])
+		return 0
+	}
+	return 0
+}
+
+func deliveryScd2(file file.DwFile) int {
+	res, err := db.Exec("meta.delivery_scd2 $1", file.Name)
+	if err != nil {
+		log.Println("deliveryScd2: ", err)
+		return 1
+	}
+	if len(res) > 0 {
+		log.Println("deliveryScd2 returned: ", res[0])
+		return 0
+	}
+	return 0
+}
+
 func deliveryTrigger(file file.DwFile) int {
 	res, err := db.Exec("meta.delivery_trigger $1", file.Name)
 	if err != nil {
@@ -185,6 +299,6 @@ func agreementFind(file file.DwFile) (agreement_id string) {
 		log.Printf("Agreement not found for file [%s]\n", file.Name)
 		return ""
 	}
-	data := res[0].(map[string]interface{})
-	return data["agreement_id"].(string)
+	data, _ := res[0].(map[string]interface{})
+	return str(data["agreement_id"])
 }
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// Monitor evaluates the alert conditions of meta.alert_condition_v (late
+// deliveries, failed validation and failed triggers) and notifies when a
+// condition is raised and when it recovers. Active alerts are kept in
+// meta.alert, so notifications are de-duplicated across daemon restarts.
+func Monitor(notifiers []Notifier) int {
+	rows, err := db.Query(`
+    SELECT alert_key, alert_type, agreement_id, agreement_name, delivery_id, message
+      FROM meta.alert_condition_v`, 0)
+	if err != nil {
+		log.Println("Monitor: ", err)
+		return 1
+	}
+	current := rows2alerts(rows)
+
+	rows, err = db.Query(`
+    SELECT l.alert_key, l.alert_type, l.agreement_id, a.name AS agreement_name, l.delivery_id, l.message
+      FROM meta.alert l
+           LEFT OUTER JOIN
+           meta.agreement a ON (a.id = l.agreement_id)
+     WHERE l.resolvedtm IS NULL`, 0)
+	if err != nil {
+		log.Println("Monitor: ", err)
+		return 1
+	}
+	active := rows2alerts(rows)
+
+	raised, recovered := diffAlerts(current, active)
+	log.Printf("Monitor found [%d] conditions: [%d] raised, [%d] recovered\n", len(current), len(raised), len(recovered))
+	for _, alert := range raised {
+		_, err = db.Exec(`
+    INSERT INTO meta.alert
+           (alert_key, alert_type, agreement_id, delivery_id, message)
+    VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5)`,
+			alert.Key, alert.Type, alert.AgreementId, alert.DeliveryId, alert.Message)
+		if err != nil {
+			log.Println("Monitor raise: ", err)
+			continue
+		}
+		notify(notifiers, alert)
+	}
+	for _, alert := range recovered {
+		_, err = db.Exec(`
+    UPDATE meta.alert
+       SET resolvedtm = GETDATE()
+     WHERE alert_key = $1
+       AND resolvedtm IS NULL`, alert.Key)
+		if err != nil {
+			log.Println("Monitor recover: ", err)
+			continue
+		}
+		notify(notifiers, alert)
+	}
+	return 0
+}
+
+// diffAlerts returns the current conditions not yet active (raised) and the
+// active alerts no longer present (recovered)
+func diffAlerts(current, active []Alert) (raised, recovered []Alert) {
+	seen := make(map[string]bool)
+	for _, a := range active {
+		seen[a.Key] = true
+	}
+	now := make(map[string]bool)
+	for _, c := range current {
+		now[c.Key] = true
+		if !seen[c.Key] {
+			raised = append(raised, c)
+			seen[c.Key] = true
+		}
+	}
+	for _, a := range active {
+		if !now[a.Key] {
+			a.Recovered = true
+			a.Message = "Recovered: " + a.Message
+			recovered = append(recovered, a)
+			now[a.Key] = true
+		}
+	}
+	return raised, recovered
+}
+
+// notify sends the alert to every notifier - a failing notifier does not
+// prevent the others from being notified
+func notify(notifiers []Notifier, alert Alert) {
+	for _, n := range notifiers {
+		if err := n.Notify(alert); err != nil {
+			log.Printf("Notify [%s] failed: [%s]\n", alert.Key, err)
+		}
+	}
+}
+
+func rows2alerts(rows []interface{}) (alerts []Alert) {
+	for _, row := range rows {
+		r := row.(map[string]interface{})
+		alerts = append(alerts, Alert{
+			Key:           str(r["alert_key"]),
+			Type:          str(r["alert_type"]),
+			AgreementId:   str(r["agreement_id"]),
+			AgreementName: str(r["agreement_name"]),
+			DeliveryId:    str(r["delivery_id"]),
+			Message:       str(r["message"]),
+		})
+	}
+	return alerts
+}
+
+// str renders a column value as text - NULL is empty
+func str(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case time.Time:
+		return t.Format("2006-01-02 15:04:05")
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/gobuffalo/envy"
+)
+
+// Alert raised (or recovered) by the monitor
+type Alert struct {
+	Key           string `json:"key"`
+	Type          string `json:"type"`
+	AgreementId   string `json:"agreement_id"`
+	AgreementName string `json:"agreement_name"`
+	DeliveryId    string `json:"delivery_id"`
+	Message       string `json:"message"`
+	Recovered     bool   `json:"recovered"`
+}
+
+// Subject is a one line summary of the alert
+func (a Alert) Subject() string {
+	if a.Recovered {
+		return fmt.Sprintf("RECOVERED [%s] %s", a.Type, a.AgreementName)
+	}
+	return fmt.Sprintf("ALERT [%s] %s", a.Type, a.AgreementName)
+}
+
+// Notifier delivers alerts to the outside world
+type Notifier interface {
+	Notify(alert Alert) error
+}
+
+// NewNotifiers creates the notifiers listed (comma separated) in names:
+// log, smtp (SMTP_ADDR, SMTP_FROM, SMTP_TO, SMTP_USERNAME, SMTP_PASSWORD) and
+// webhook (WEBHOOK_URL)
+func NewNotifiers(names string) (notifiers []Notifier) {
+	for _, name := range strings.Split(names, ",") {
+		switch strings.TrimSpace(strings.ToLower(name)) {
+		case "":
+		case "log":
+			notifiers = append(notifiers, &LogNotifier{})
+		case "smtp":
+			notifiers = append(notifiers, NewSmtpNotifier(
+				envy.Get("SMTP_ADDR", "localhost:25"),
+				envy.Get("SMTP_FROM", "datawarehouse@localhost"),
+				strings.Split(envy.Get("SMTP_TO", ""), ","),
+				envy.Get("SMTP_USERNAME", ""),
+				envy.Get("SMTP_PASSWORD", "")))
+		case "webhook":
+			notifiers = append(notifiers, NewWebhookNotifier(envy.Get("WEBHOOK_URL", "")))
+		default:
+			log.Printf("Unknown notifier [%s] ignored\n", name)
+		}
+	}
+	return notifiers
+}
+
+// LogNotifier writes alerts to the daemon log
+type LogNotifier struct{}
+
+func (n *LogNotifier) Notify(alert Alert) error {
+	log.Printf("%s: %s\n", alert.Subject(), alert.Message)
+	return nil
+}
+
+// SmtpNotifier mails alerts to a list of recipients
+type SmtpNotifier struct {
+	addr string
+	from string
+	to   []string
+	auth smtp.Auth
+}
+
+func NewSmtpNotifier(addr, from string, to []string, username, password string) *SmtpNotifier {
+	n := &SmtpNotifier{addr: addr, from: from}
+	for _, t := range to {
+		if t = strings.TrimSpace(t); t != "" {
+			n.to = append(n.to, t)
+		}
+	}
+	if username != "" {
+		n.auth = smtp.PlainAuth("", username, password, strings.Split(addr, ":")[0])
+	}
+	return n
+}
+
+func (n *SmtpNotifier) Notify(alert Alert) error {
+	if len(n.to) == 0 {
+		return fmt.Errorf("smtp notifier has no recipients")
+	}
+	msg := "From: " + n.from + "\r\n" +
+		"To: " + strings.Join(n.to, ", ") + "\r\n" +
+		"Subject: " + alert.Subject() + "\r\n" +
+		"\r\n" + alert.Message + "\r\n"
+	return smtp.SendMail(n.addr, n.auth, n.from, n.to, []byte(msg))
+}
+
+// WebhookNotifier posts alerts as JSON to a URL
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (n *WebhookNotifier) Notify(alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	res, err := n.client.Post(n.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("webhook [%s] returned [%s]", n.url, res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDiffAlerts(t *testing.T) {
+	current := []Alert{{Key: "LATE:1"}, {Key: "TRIGGER:2"}}
+	active := []Alert{{Key: "LATE:1"}, {Key: "VALIDATION:3", Message: "failed"}}
+
+	raised, recovered := diffAlerts(current, active)
+	if len(raised) != 1 || raised[0].Key != "TRIGGER:2" {
+		t.Errorf("Expected TRIGGER:2 raised, got %v", raised)
+	}
+	if len(recovered) != 1 || recovered[0].Key != "VALIDATION:3" || !recovered[0].Recovered {
+		t.Errorf("Expected VALIDATION:3 recovered, got %v", recovered)
+	}
+
+	// Nothing changes when conditions are unchanged (de-duplication)
+	raised, recovered = diffAlerts(active, active)
+	if len(raised) != 0 || len(recovered) != 0 {
+		t.Errorf("Expected no changes, got [%d] raised and [%d] recovered", len(raised), len(recovered))
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var got Alert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	err := NewWebhookNotifier(srv.URL).Notify(Alert{Key: "LATE:1", Type: "LATE", Message: "late"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Key != "LATE:1" || got.Message != "late" {
+		t.Errorf("Unexpected alert posted [%v]", got)
+	}
+}
+
+func TestSmtpNotifier(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// Minimal SMTP server capturing the DATA section
+	data := make(chan string, 1)
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		r := bufio.NewReader(c)
+		c.Write([]byte("220 localhost\r\n"))
+		var body []string
+		indata := false
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			if indata {
+				if line == "." {
+					indata = false
+					data <- strings.Join(body, "\n")
+					c.Write([]byte("250 OK\r\n"))
+				} else {
+					body = append(body, line)
+				}
+				continue
+			}
+			switch strings.ToUpper(strings.SplitN(line, " ", 2)[0]) {
+			case "DATA":
+				indata = true
+				c.Write([]byte("354 Go ahead\r\n"))
+			case "QUIT":
+				c.Write([]byte("221 Bye\r\n"))
+				return
+			default:
+				c.Write([]byte("250 OK\r\n"))
+			}
+		}
+	}()
+
+	n := NewSmtpNotifier(l.Addr().String(), "dw@localhost", []string{"ops@localhost"}, "", "")
+	err = n.Notify(Alert{Type: "TRIGGER", AgreementName: "sales", Message: "trigger failed", Recovered: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := <-data
+	if !strings.Contains(msg, "Subject: RECOVERED [TRIGGER] sales") || !strings.Contains(msg, "trigger failed") {
+		t.Errorf("Unexpected mail [%s]", msg)
+	}
+}
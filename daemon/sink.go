@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/sorenbak/datawarehouse/file"
+	"github.com/sorenbak/datawarehouse/repository"
+)
+
+// Sink modes for pushing deliveries published to the repository
+const (
+	SinkNone  = "none"
+	SinkEvent = "event"
+	SinkRows  = "rows"
+)
+
+// SinkDelivery is the delivery-ready event published in event mode
+type SinkDelivery struct {
+	Event         string `json:"event"`
+	DeliveryId    string `json:"dw_delivery_id"`
+	DeliveryName  string `json:"delivery_name"`
+	AgreementName string `json:"agreement_name"`
+	StatusDate    string `json:"status_date"`
+	Link          string `json:"link,omitempty"`
+}
+
+// Sink publishes deliveries to a queue - either a delivery-ready event with a download
+// link or every row of the delivery (carrying dw_delivery_id and dw_row_id for lineage).
+// Rows are published as they are read and the timeout applies to each message.
+type Sink struct {
+	queue   Queue
+	mode    string
+	topic   string
+	link    string
+	timeout time.Duration
+	rows    func(ctx context.Context, d SinkDelivery) (repository.Rows, error)
+}
+
+// NewSink publishes to the topic (and link) templates where {agreement} and {delivery_id}
+// are replaced by the agreement name and delivery id
+func NewSink(queue Queue, mode, topic, link string, timeout time.Duration) *Sink {
+	return &Sink{
+		queue:   queue,
+		mode:    strings.TrimSpace(strings.ToLower(mode)),
+		topic:   topic,
+		link:    link,
+		timeout: timeout,
+		rows:    sinkRows,
+	}
+}
+
+// deliverySink pushes the delivery just published to the repository to the sink
+func deliverySink(file file.DwFile) int {
+	if sink.mode == SinkNone || sink.mode == "" {
+		return 0
+	}
+	res, err := db.Query(`
+    SELECT d.id AS delivery_id, d.name AS delivery_name, a.name AS agreement_name,
+           CONVERT(NVARCHAR(10), d.status_date, 120) AS status_date
+      FROM meta.delivery d,
+           meta.agreement a
+     WHERE d.id = (SELECT MAX(id) FROM meta.delivery WHERE name = $1)
+       AND a.id = d.agreement_id`, 0, file.Name)
+	if err != nil {
+		log.Println("deliverySink: ", err)
+		return 1
+	}
+	if len(res) == 0 {
+		log.Printf("deliverySink: delivery [%s] not found\n", file.Name)
+		return 1
+	}
+	r := res[0].(map[string]interface{})
+	count, err := sink.Publish(SinkDelivery{
+		DeliveryId:    str(r["delivery_id"]),
+		DeliveryName:  str(r["delivery_name"]),
+		AgreementName: str(r["agreement_name"]),
+		StatusDate:    str(r["status_date"]),
+	})
+	if err != nil {
+		log.Println("deliverySink: ", err)
+		return 1
+	}
+	log.Printf("deliverySink published [%d] message(s) for [%s]\n", count, file.Name)
+	return 0
+}
+
+// Publish sends the delivery according to the sink mode and returns the number of messages
+func (s *Sink) Publish(d SinkDelivery) (count int, err error) {
+	topic := s.expand(s.topic, d)
+	switch s.mode {
+	case SinkEvent:
+		d.Event = EventPublished
+		d.Link = s.expand(s.link, d)
+		body, err := json.Marshal(d)
+		if err != nil {
+			return 0, err
+		}
+		return 1, s.publish(topic, body)
+	case SinkRows:
+		// The query ends when publishing fails
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		rows, err := s.rows(ctx, d)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		cols := rows.Columns()
+		for rows.Next() {
+			row := make(map[string]interface{}, len(cols))
+			for i, v := range rows.Values() {
+				row[cols[i]] = v
+			}
+			body, err := json.Marshal(row)
+			if err != nil {
+				return count, err
+			}
+			if err = s.publish(topic, body); err != nil {
+				return count, err
+			}
+			count++
+		}
+		return count, rows.Err()
+	default:
+		return 0, fmt.Errorf("sink mode [%s] is not supported", s.mode)
+	}
+}
+
+// publish sends one message within the timeout
+func (s *Sink) publish(topic string, body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return s.queue.Publish(ctx, topic, body)
+}
+
+func (s *Sink) expand(template string, d SinkDelivery) string {
+	return strings.NewReplacer("{agreement}", d.AgreementName, "{delivery_id}", d.DeliveryId).Replace(template)
+}
+
+// sinkRows streams the repository rows of the delivery - including dw_delivery_id and dw_row_id
+func sinkRows(ctx context.Context, d SinkDelivery) (repository.Rows, error) {
+	return db.WithContext(ctx).Rows("EXEC meta.get_data 'system', $1, NULL, NULL, $2", d.AgreementName, d.DeliveryId)
+}
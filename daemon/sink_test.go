@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sorenbak/datawarehouse/repository"
+	"github.com/streadway/amqp"
+)
+
+// fakeChannel is an in-memory stand-in for an AMQP broker channel
+type fakeChannel struct {
+	published []amqp.Publishing
+	keys      []string
+	fail      bool
+	closed    bool
+}
+
+func (c *fakeChannel) Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+	if c.fail {
+		return errors.New("channel closed")
+	}
+	c.keys = append(c.keys, key)
+	c.published = append(c.published, msg)
+	return nil
+}
+
+func (c *fakeChannel) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestSinkEvent(t *testing.T) {
+	q := NewMemoryQueue()
+	s := NewSink(q, SinkEvent, "dw.{agreement}", "https://dw/api/delivery/download/json/{agreement}/{delivery_id}", time.Second)
+	count, err := s.Publish(SinkDelivery{DeliveryId: "42", AgreementName: "sales"})
+	if err != nil || count != 1 {
+		t.Fatalf("Expected 1 message, got [%d] [%v]", count, err)
+	}
+	var got SinkDelivery
+	msgs := q.Messages("dw.sales")
+	if len(msgs) != 1 || json.Unmarshal(msgs[0], &got) != nil {
+		t.Fatalf("Expected 1 message on dw.sales, got [%d]", len(msgs))
+	}
+	if got.DeliveryId != "42" || got.Link != "https://dw/api/delivery/download/json/sales/42" {
+		t.Errorf("Unexpected event [%v]", got)
+	}
+}
+
+func TestSinkRowsAmqp(t *testing.T) {
+	ch := &fakeChannel{}
+	dials := 0
+	q := &AmqpQueue{exchange: "dw", dial: func() (AmqpChannel, error) { dials++; return ch, nil }}
+	s := NewSink(q, SinkRows, "dw.{agreement}", "", time.Second)
+	s.rows = func(ctx context.Context, d SinkDelivery) (repository.Rows, error) {
+		return repository.NewSliceRows(ctx, []interface{}{
+			map[string]interface{}{"dw_delivery_id": d.DeliveryId, "dw_row_id": "1"},
+			map[string]interface{}{"dw_delivery_id": d.DeliveryId, "dw_row_id": "2"},
+		}), nil
+	}
+	count, err := s.Publish(SinkDelivery{DeliveryId: "42", AgreementName: "sales"})
+	if err != nil || count != 2 {
+		t.Fatalf("Expected 2 rows, got [%d] [%v]", count, err)
+	}
+	if dials != 1 || len(ch.published) != 2 || ch.keys[1] != "dw.sales" {
+		t.Errorf("Unexpected publishing [%d] dials [%v]", dials, ch.keys)
+	}
+	if ch.published[1].DeliveryMode != amqp.Persistent || string(ch.published[1].Body) != `{"dw_delivery_id":"42","dw_row_id":"2"}` {
+		t.Errorf("Unexpected message [%s]", ch.published[1].Body)
+	}
+
+	// A failing channel is closed and re-dialed on next publish
+	ch.fail = true
+	if _, err = s.Publish(SinkDelivery{DeliveryId: "43", AgreementName: "sales"}); err == nil || !ch.closed {
+		t.Errorf("Expected failure closing the channel, got [%v]", err)
+	}
+	ch.fail = false
+	if _, err = s.Publish(SinkDelivery{DeliveryId: "44", AgreementName: "sales"}); err != nil || dials != 2 {
+		t.Errorf("Expected reconnect, got [%d] dials [%v]", dials, err)
+	}
+}
+
+// slowQueue takes delay to publish a message - failing if the context ends first
+type slowQueue struct {
+	delay time.Duration
+	count int
+}
+
+func (q *slowQueue) Publish(ctx context.Context, queue string, body []byte) error {
+	select {
+	case <-time.After(q.delay):
+		q.count++
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestSinkRowsTimeout(t *testing.T) {
+	// The timeout applies to each message - not to the delivery
+	q := &slowQueue{delay: 20 * time.Millisecond}
+	s := NewSink(q, SinkRows, "dw.{agreement}", "", 50*time.Millisecond)
+	s.rows = func(ctx context.Context, d SinkDelivery) (repository.Rows, error) {
+		var rows []interface{}
+		for i := 0; i < 5; i++ {
+			rows = append(rows, map[string]interface{}{"dw_delivery_id": d.DeliveryId, "dw_row_id": i})
+		}
+		return repository.NewSliceRows(ctx, rows), nil
+	}
+	if count, err := s.Publish(SinkDelivery{DeliveryId: "42", AgreementName: "sales"}); err != nil || count != 5 {
+		t.Errorf("Expected 5 rows, got [%d] [%v]", count, err)
+	}
+
+	q.delay = time.Second
+	if count, err := s.Publish(SinkDelivery{DeliveryId: "43", AgreementName: "sales"}); err == nil || count != 0 {
+		t.Errorf("Expected a timeout, got [%d] [%v]", count, err)
+	}
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sorenbak/datawarehouse/file"
+)
+
+// Trigger kinds executed by the daemon - SQL triggers are executed by meta.delivery_trigger
+const (
+	TriggerHttp    = "HTTP"
+	TriggerExport  = "EXPORT"
+	TriggerQueue   = "QUEUE"
+	TriggerCommand = "COMMAND"
+)
+
+// Trigger of an agreement executed for a delivery
+type Trigger struct {
+	Id            string        `json:"trigger_id"`
+	Kind          string        `json:"kind"`
+	Text          string        `json:"-"`
+	DeliveryId    string        `json:"delivery_id"`
+	DeliveryName  string        `json:"delivery_name"`
+	AgreementName string        `json:"agreement_name"`
+	Timeout       time.Duration `json:"-"`
+	Retries       int           `json:"-"`
+}
+
+// TriggerResult of executing a trigger (including retries)
+type TriggerResult struct {
+	Trigger  Trigger
+	Attempts int
+	Err      error
+}
+
+// Description is the human readable result logged as operation (max 250 chars)
+func (r TriggerResult) Description() string {
+	msg := fmt.Sprintf("%s trigger [%s] OK after [%d] attempt(s)", r.Trigger.Kind, r.Trigger.Id, r.Attempts)
+	if r.Err != nil {
+		msg = fmt.Sprintf("%s trigger [%s] failed after [%d] attempt(s): %s", r.Trigger.Kind, r.Trigger.Id, r.Attempts, r.Err)
+	}
+	if len(msg) > 250 {
+		msg = msg[:250]
+	}
+	return msg
+}
+
+// Triggerer executes the daemon trigger kinds
+type Triggerer struct {
+	client  *http.Client
+	queue   Queue
+	outbox  string
+	timeout time.Duration
+	retries int
+	delay   time.Duration
+	export  func(ctx context.Context, t Trigger) ([]interface{}, error)
+	// Programs COMMAND triggers may run
+	commands map[string]bool
+}
+
+// NewTriggerer uses timeout and retries for triggers not defining their own. COMMAND triggers
+// may only run the programs of commands (comma separated, e.g. TRIGGER_COMMANDS) - none if empty.
+func NewTriggerer(queue Queue, outbox string, timeout time.Duration, retries int, commands string) *Triggerer {
+	tr := &Triggerer{
+		client:   &http.Client{},
+		queue:    queue,
+		outbox:   outbox,
+		timeout:  timeout,
+		retries:  retries,
+		delay:    5 * time.Second,
+		export:   exportData,
+		commands: make(map[string]bool),
+	}
+	for _, c := range strings.Split(commands, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			tr.commands[c] = true
+		}
+	}
+	return tr
+}
+
+// deliveryTriggerKinds executes the non SQL triggers of the delivery agreement and logs the
+// results as operations on the repository audit of the delivery
+func deliveryTriggerKinds(file file.DwFile) int {
+	rows, err := db.Query(`
+    SELECT t.trigger_id, t.kind,
+           REPLACE(t.trigger_text, '@delivery_id', CAST(d.id AS NVARCHAR)) AS trigger_text,
+           t.timeout_secs, t.retries, d.id AS delivery_id, d.name AS delivery_name, a.name AS agreement_name
+      FROM meta.delivery d,
+           meta.agreement a,
+           meta.agreement_trigger t
+     WHERE d.id = (SELECT MAX(id) FROM meta.delivery WHERE name = $1)
+       AND a.id = d.agreement_id
+       AND t.agreement_id = d.agreement_id
+       AND t.kind <> 'SQL'
+     ORDER BY t.trigger_id`, 0, file.Name)
+	if err != nil {
+		log.Println("deliveryTriggerKinds: ", err)
+		return 1
+	}
+	var triggers []Trigger
+	for _, row := range rows {
+		r := row.(map[string]interface{})
+		t := Trigger{
+			Id:            str(r["trigger_id"]),
+			Kind:          str(r["kind"]),
+			Text:          str(r["trigger_text"]),
+			DeliveryId:    str(r["delivery_id"]),
+			DeliveryName:  str(r["delivery_name"]),
+			AgreementName: str(r["agreement_name"]),
+		}
+		if secs, err := strconv.Atoi(str(r["timeout_secs"])); err == nil {
+			t.Timeout = time.Duration(secs) * time.Second
+		}
+		if retries, err := strconv.Atoi(str(r["retries"])); err == nil {
+			t.Retries = retries
+		} else {
+			t.Retries = -1
+		}
+		triggers = append(triggers, t)
+	}
+	results := triggerer.RunAll(triggers)
+	failed := 0
+	for _, res := range results {
+		status := 1
+		if res.Err != nil {
+			status = 3
+			failed++
+		}
+		log.Println(res.Description())
+		_, err = db.Exec("EXEC meta.trigger_result_add $1, $2, $3, $4",
+			res.Trigger.DeliveryId, res.Trigger.Id, status, res.Description())
+		if err != nil {
+			log.Println("deliveryTriggerKinds: ", err)
+		}
+	}
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// RunAll executes the triggers in order. Failed results are returned last, so they are
+// logged as the most recent trigger operations of the delivery (and raise alerts)
+func (tr *Triggerer) RunAll(triggers []Trigger) (results []TriggerResult) {
+	for _, t := range triggers {
+		results = append(results, tr.Run(t))
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Err == nil && results[j].Err != nil
+	})
+	return results
+}
+
+// Run executes the trigger with its timeout per attempt, retrying failed attempts
+func (tr *Triggerer) Run(t Trigger) (res TriggerResult) {
+	res.Trigger = t
+	timeout, retries := t.Timeout, t.Retries
+	if timeout <= 0 {
+		timeout = tr.timeout
+	}
+	if retries < 0 {
+		retries = tr.retries
+	}
+	for res.Attempts = 1; ; res.Attempts++ {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		res.Err = tr.execute(ctx, t)
+		cancel()
+		if res.Err == nil || res.Attempts > retries {
+			return res
+		}
+		log.Printf("%s trigger [%s] attempt [%d] failed: %s\n", t.Kind, t.Id, res.Attempts, res.Err)
+		time.Sleep(tr.delay * time.Duration(res.Attempts))
+	}
+}
+
+func (tr *Triggerer) execute(ctx context.Context, t Trigger) error {
+	switch t.Kind {
+	case TriggerHttp:
+		return tr.post(ctx, t)
+	case TriggerExport:
+		return tr.exportFile(ctx, t)
+	case TriggerQueue:
+		body, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+		return tr.queue.Publish(ctx, t.Text, body)
+	case TriggerCommand:
+		return tr.command(ctx, t)
+	default:
+		return fmt.Errorf("trigger kind [%s] is not supported", t.Kind)
+	}
+}
+
+// post sends the delivery as JSON to the URL of the trigger
+func (tr *Triggerer) post(ctx context.Context, t Trigger) error {
+	body, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", t.Text, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := tr.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("trigger [%s] returned [%s]", t.Text, res.Status)
+	}
+	return nil
+}
+
+// exportFile writes the delivery data as JSON to the file of the trigger (relative to outbox)
+func (tr *Triggerer) exportFile(ctx context.Context, t Trigger) error {
+	rows, err := tr.export(ctx, t)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(tr.outbox, filepath.Clean("/"+t.Text))
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	// Write to temp file first, so consumers never see a partial export
+	tmp := path + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	if err = ctx.Err(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func exportData(ctx context.Context, t Trigger) ([]interface{}, error) {
+	return db.WithContext(ctx).Query("EXEC meta.get_data 'system', $1, NULL, NULL, $2", 0, t.AgreementName, t.DeliveryId)
+}
+
+// command runs the command line of the trigger with the delivery in the environment - if its
+// program is one of the commands allowed by the daemon
+func (tr *Triggerer) command(ctx context.Context, t Trigger) error {
+	args := strings.Fields(t.Text)
+	if len(args) == 0 {
+		return fmt.Errorf("trigger [%s] has no command", t.Id)
+	}
+	if !tr.commands[args[0]] {
+		return fmt.Errorf("command [%s] is not allowed (TRIGGER_COMMANDS)", args[0])
+	}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Env = append(os.Environ(),
+		"DW_DELIVERY_ID="+t.DeliveryId,
+		"DW_DELIVERY_NAME="+t.DeliveryName,
+		"DW_AGREEMENT_NAME="+t.AgreementName)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		return fmt.Errorf("%s: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
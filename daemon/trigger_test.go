@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testTriggerer(t *testing.T) *Triggerer {
+	dir, err := ioutil.TempDir("", "trigger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := NewTriggerer(NewMemoryQueue(), dir, time.Second, 0, "true, false")
+	tr.delay = time.Millisecond
+	return tr
+}
+
+func TestHttpTriggerRetries(t *testing.T) {
+	calls := 0
+	var got Trigger
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	res := testTriggerer(t).Run(Trigger{Id: "1", Kind: TriggerHttp, Text: srv.URL, DeliveryId: "42", Retries: 1})
+	if res.Err != nil || res.Attempts != 2 {
+		t.Errorf("Expected success after 2 attempts, got [%d] [%v]", res.Attempts, res.Err)
+	}
+	if got.DeliveryId != "42" {
+		t.Errorf("Unexpected delivery posted [%v]", got)
+	}
+}
+
+func TestHttpTriggerTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	res := testTriggerer(t).Run(Trigger{Id: "1", Kind: TriggerHttp, Text: srv.URL, Timeout: 20 * time.Millisecond})
+	if res.Err == nil || res.Attempts != 1 {
+		t.Errorf("Expected timeout after 1 attempt, got [%d] [%v]", res.Attempts, res.Err)
+	}
+}
+
+func TestQueueTrigger(t *testing.T) {
+	tr := testTriggerer(t)
+	res := tr.Run(Trigger{Id: "2", Kind: TriggerQueue, Text: "deliveries", DeliveryId: "42"})
+	if res.Err != nil {
+		t.Fatal(res.Err)
+	}
+	if msgs := tr.queue.(*MemoryQueue).Messages("deliveries"); len(msgs) != 1 {
+		t.Errorf("Expected 1 message, got [%d]", len(msgs))
+	}
+}
+
+func TestExportTrigger(t *testing.T) {
+	tr := testTriggerer(t)
+	defer os.RemoveAll(tr.outbox)
+	tr.export = func(ctx context.Context, t Trigger) ([]interface{}, error) {
+		return []interface{}{map[string]interface{}{"id": t.DeliveryId}}, nil
+	}
+	res := tr.Run(Trigger{Id: "3", Kind: TriggerExport, Text: "../export/42.json", DeliveryId: "42"})
+	if res.Err != nil {
+		t.Fatal(res.Err)
+	}
+	// Paths can not escape the outbox
+	data, err := ioutil.ReadFile(filepath.Join(tr.outbox, "export", "42.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `[{"id":"42"}]` {
+		t.Errorf("Unexpected export [%s]", data)
+	}
+}
+
+func TestCommandTrigger(t *testing.T) {
+	tr := testTriggerer(t)
+	if res := tr.Run(Trigger{Id: "4", Kind: TriggerCommand, Text: "true"}); res.Err != nil {
+		t.Error(res.Err)
+	}
+	if res := tr.Run(Trigger{Id: "5", Kind: TriggerCommand, Text: "false", Retries: 2}); res.Err == nil || res.Attempts != 3 {
+		t.Errorf("Expected failure after 3 attempts, got [%d] [%v]", res.Attempts, res.Err)
+	}
+	// Only the programs of TRIGGER_COMMANDS are run
+	if res := tr.Run(Trigger{Id: "6", Kind: TriggerCommand, Text: "touch /tmp/x"}); res.Err == nil || !strings.Contains(res.Err.Error(), "not allowed") {
+		t.Errorf("Expected command to be rejected, got [%v]", res.Err)
+	}
+	if res := tr.Run(Trigger{Id: "7", Kind: TriggerCommand, Text: "/bin/true"}); res.Err == nil {
+		t.Error("Expected path of allowed program to be rejected")
+	}
+}
+
+func TestRunAllFailuresLast(t *testing.T) {
+	results := testTriggerer(t).RunAll([]Trigger{
+		{Id: "1", Kind: "UNKNOWN"},
+		{Id: "2", Kind: TriggerQueue, Text: "q"},
+	})
+	if len(results) != 2 || results[0].Trigger.Id != "2" || results[1].Err == nil {
+		t.Errorf("Expected failed trigger last, got %v", results)
+	}
+}
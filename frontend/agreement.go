@@ -49,7 +49,7 @@ func AgreementAttribute(c iris.Context, rep repository.Repository, agreement_id
 	//          createdtm:
 	//            description: Creation Date/Time
 	//            type: timestamp
-	res, err := rep.QueryJson(`SELECT * FROM meta.agreement_attribute_v WHERE agreement_id = $1`, 0, agreement_id)
+	res, err := rep.QueryJson(`SELECT * FROM meta.agreement_attribute_v WHERE agreement_id = $1 AND meta.user_access($2, agreement_id, 'VIEW') > 0`, 0, agreement_id, GetUsername(c))
 	if err != nil {
 		return err.Error()
 	}
@@ -136,13 +136,23 @@ func AgreementDeliveryCount(c iris.Context, rep repository.Repository, agreement
 	//          repo_count:
 	//            description: Number of deliveries in repo
 	//            type: integer
-	res, err := rep.QueryJson(`SELECT * FROM meta.agreement_delivery_count_v WHERE id = $1`, 0, agreement_id)
+	res, err := rep.QueryJson(`SELECT * FROM meta.agreement_delivery_count_v WHERE id = $1 AND meta.user_access($2, id, 'VIEW') > 0`, 0, agreement_id, GetUsername(c))
 	if err != nil {
 		return err.Error()
 	}
 	return res
 }
 
+var agreementListSpec = repository.ListSpec{
+	From:        "meta.get_agreements($1)",
+	Where:       `meta.user_access($2, id, 'VIEW') > 0`,
+	Key:         "id",
+	Sort:        []string{"name", "createdtm", "modifydtm", "group_name", "user_realname", "status_date", "diff_pct", "ok", "err"},
+	DefaultSort: "name",
+	Filters:     map[string]string{"group": "group_name", "owner": "user_realname", "type": "type_name"},
+	Date:        "status_date",
+}
+
 func AgreementList(c iris.Context, rep repository.Repository, date string) (string, error) {
 	// swagger:operation GET /api/agreement/{date} Agreement AgreementList
 	// List agreements and number of deliveries along with some state
@@ -154,73 +164,140 @@ func AgreementList(c iris.Context, rep repository.Repository, date string) (stri
 	//   type: string
 	//   in: path
 	//   required: true
+	// - name: limit
+	//   description: Number of rows per page (default 100 - max 1000)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: offset
+	//   description: Number of rows to skip (default 0)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: cursor
+	//   description: Cursor of the next page from a previous page (replaces offset)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: sort
+	//   description: Column to sort on - prefix with - for descending (id, name (default), createdtm, modifydtm, group_name, user_realname, status_date, diff_pct, ok, err)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: group
+	//   description: Filter on owning group name(s) - comma separated
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: owner
+	//   description: Filter on owning user real name(s) - comma separated
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: type
+	//   description: Filter on agreement type name(s) - comma separated
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: from
+	//   description: Status date from date (YYYY-MM-DD)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: to
+	//   description: Status date to date (YYYY-MM-DD - inclusive)
+	//   type: string
+	//   in: query
+	//   required: false
 	// responses:
 	//   '200':
 	//     description: OK
 	//     schema:
-	//      type: array
-	//      items:
-	//        type: object
-	//        title: Agreements
-	//        properties:
-	//          id:
-	//            description: ID of agreement
-	//            type: integer
-	//          type_id:
-	//            description: ID of agreement type (how to BULK INSERT)
-	//            type: integer
-	//          group_id:
-	//            description: ID of owning group
-	//            type: integer
-	//          user_id:
-	//            description: ID of owning user
-	//            type: integer
-	//          name:
-	//            description: Name of agreement
-	//            type: string
-	//          pattern:
-	//            description: Pattern for picking up dataset from inbox
-	//            type: string
-	//          createdtm:
-	//            description: Date of creation
-	//            type: string
-	//          modifydtm:
-	//            description: Date of last modification
-	//            type: string
-	//          frequecy:
-	//            description: expected frequency of deliveries
-	//            type: integer
-	//          description:
-	//            description: Description of agreement
-	//            type: string
-	//          file2temp:
-	//            description: Procedure for moving deliveries from file to temp
-	//            type: string
-	//          temp2stag:
-	//            description: Procedure for moving deliveries from temp to stag
-	//            type: string
-	//          stag2repo:
-	//            description: Procedure for moving deliveries from stag to repo
-	//            type: string
-	//          user_realname:
-	//            description: Real name of owning user
-	//            type: string
-	//          group_name:
-	//            description: Name of owning group
-	//            type: string
-	//          err:
-	//            description: Number of deliveries on error (NULL if none)
-	//            type: integer
-	//          ok:
-	//            description: Number of deliveries in state OK
-	//            type: integer
-	//          status_date:
-	//            description: Date of last identified pattern (YYYYMMDD) in a delivery
-	//            type: string
-	//          status_date:
-	//            description: Pct difference from allowed distance to latest delivery versus frequency
-	//            type: float
-	return rep.QueryJson(`SELECT * FROM meta.get_agreements($1) ORDER BY name`, 0, date)
+	//      type: object
+	//      title: AgreementList
+	//      properties:
+	//        total:
+	//          description: Count of rows matching the filters
+	//          type: integer
+	//        limit:
+	//          description: Number of rows per page
+	//          type: integer
+	//        offset:
+	//          description: Number of rows skipped
+	//          type: integer
+	//        sort:
+	//          description: Sort applied
+	//          type: string
+	//        cursor:
+	//          description: Cursor of the next page
+	//          type: string
+	//        next:
+	//          description: Link to the next page (empty on last page)
+	//          type: string
+	//        data:
+	//          type: array
+	//          items:
+	//            type: object
+	//            title: Agreements
+	//            properties:
+	//              id:
+	//                description: ID of agreement
+	//                type: integer
+	//              type_id:
+	//                description: ID of agreement type (how to BULK INSERT)
+	//                type: integer
+	//              group_id:
+	//                description: ID of owning group
+	//                type: integer
+	//              user_id:
+	//                description: ID of owning user
+	//                type: integer
+	//              name:
+	//                description: Name of agreement
+	//                type: string
+	//              pattern:
+	//                description: Pattern for picking up dataset from inbox
+	//                type: string
+	//              createdtm:
+	//                description: Date of creation
+	//                type: string
+	//              modifydtm:
+	//                description: Date of last modification
+	//                type: string
+	//              frequecy:
+	//                description: expected frequency of deliveries
+	//                type: integer
+	//              description:
+	//                description: Description of agreement
+	//                type: string
+	//              file2temp:
+	//                description: Procedure for moving deliveries from file to temp
+	//                type: string
+	//              temp2stag:
+	//                description: Procedure for moving deliveries from temp to stag
+	//                type: string
+	//              stag2repo:
+	//                description: Procedure for moving deliveries from stag to repo
+	//                type: string
+	//              user_realname:
+	//                description: Real name of owning user
+	//                type: string
+	//              group_name:
+	//                description: Name of owning group
+	//                type: string
+	//              err:
+	//                description: Number of deliveries on error (NULL if none)
+	//                type: integer
+	//              ok:
+	//                description: Number of deliveries in state OK
+	//                type: integer
+	//              status_date:
+	//                description: Date of last identified pattern (YYYYMMDD) in a delivery
+	//                type: string
+	//              status_date:
+	//                description: Pct difference from allowed distance to latest delivery versus frequency
+	//                type: float
+	return listJson(c, rep, agreementListSpec, date, GetUsername(c))
 }
 
 func AgreementColumn(c iris.Context, rep repository.Repository, agreement_id int64) string {
@@ -285,7 +362,7 @@ func AgreementColumn(c iris.Context, rep repository.Repository, agreement_id int
      WHERE table_schema = 'init'
        AND agreement_id = $1
        AND meta.user_access($2, agreement_id, 'VIEW') > 0
-     ORDER BY ordinal_position`, 0, agreement_id, "system")
+     ORDER BY ordinal_position`, 0, agreement_id, GetUsername(c))
 	if err != nil {
 		return err.Error()
 	}
@@ -326,7 +403,131 @@ func AgreementRule(c iris.Context, rep repository.Repository, agreement_id int64
       FROM meta.agreement_rule
      WHERE agreement_id = $1
        AND meta.user_access($2, agreement_id, 'VIEW') > 0
-     ORDER BY rule_id`, 0, agreement_id, "system")
+     ORDER BY rule_id`, 0, agreement_id, GetUsername(c))
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func AgreementLookup(c iris.Context, rep repository.Repository, agreement_id int64) string {
+	// swagger:operation GET /api/agreement/lookup/{agreement_id} Agreement AgreementLookup
+	// List agreement lookup rules validating against reference data of other agreements
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: agreement_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: AgreementLookup
+	//        properties:
+	//          agreement_id:
+	//            description: ID of agreement
+	//            type: integer
+	//          rule_id:
+	//            description: ID of rule within agreement
+	//            type: integer
+	//          column_name:
+	//            description: Column of agreement being validated
+	//            type: string
+	//          lookup_agreement_id:
+	//            description: ID of agreement holding the reference data
+	//            type: integer
+	//          lookup_agreement_name:
+	//            description: Name of agreement holding the reference data
+	//            type: string
+	//          lookup_column_name:
+	//            description: Column of reference data containing valid codes
+	//            type: string
+	//          delivery_date:
+	//            description: Date the reference delivery is pinned to (NULL => latest)
+	//            type: string
+	//          lookup_delivery_id:
+	//            description: ID of reference delivery currently resolved
+	//            type: integer
+	res, err := rep.QueryJson(`
+    SELECT l.agreement_id,
+           l.rule_id,
+           l.column_name,
+           l.lookup_agreement_id,
+           a.name AS lookup_agreement_name,
+           l.lookup_column_name,
+           l.delivery_date,
+           meta.get_repo_delivery_id(l.lookup_agreement_id, l.delivery_date) AS lookup_delivery_id
+      FROM meta.agreement_lookup l,
+           meta.agreement a
+     WHERE a.id = l.lookup_agreement_id
+       AND l.agreement_id = $1
+       AND meta.user_access($2, l.agreement_id, 'VIEW') > 0
+     ORDER BY l.rule_id`, 0, agreement_id, GetUsername(c))
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func AgreementCheck(c iris.Context, rep repository.Repository, agreement_id int64) string {
+	// swagger:operation GET /api/agreement/check/{agreement_id} Agreement AgreementCheck
+	// List agreement delivery level checks (row count bounds and drift detection)
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: agreement_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: AgreementCheck
+	//        properties:
+	//          agreement_id:
+	//            description: ID of agreement
+	//            type: integer
+	//          check_id:
+	//            description: ID of check within agreement
+	//            type: integer
+	//          check_type:
+	//            description: Type of check (ROW_COUNT, NULL_RATE, MEAN, MIN, MAX, DISTINCT_COUNT)
+	//            type: string
+	//          column_name:
+	//            description: Column the metric is computed for (NULL for ROW_COUNT)
+	//            type: string
+	//          tolerance:
+	//            description: Allowed deviation from trailing average in percent (points for NULL_RATE)
+	//            type: number
+	//          min_value:
+	//            description: Lower bound of metric
+	//            type: number
+	//          max_value:
+	//            description: Upper bound of metric
+	//            type: number
+	//          history:
+	//            description: Number of trailing deliveries averaged
+	//            type: integer
+	//          status_id:
+	//            description: Status logged on breach (2 WARN, 3 ERROR)
+	//            type: integer
+	res, err := rep.QueryJson(`
+    SELECT agreement_id, check_id, check_type, column_name, tolerance, min_value, max_value, history, status_id
+      FROM meta.agreement_check
+     WHERE agreement_id = $1
+       AND meta.user_access($2, agreement_id, 'VIEW') > 0
+     ORDER BY check_id`, 0, agreement_id, GetUsername(c))
 	if err != nil {
 		return err.Error()
 	}
@@ -365,12 +566,78 @@ func AgreementTrigger(c iris.Context, rep repository.Repository, agreement_id in
 	//          description:
 	//            description: Trigger description
 	//            type: string
+	//          kind:
+	//            description: Kind of trigger (SQL, HTTP, EXPORT, QUEUE, COMMAND)
+	//            type: string
+	//          timeout_secs:
+	//            description: Timeout per attempt of daemon triggers (NULL for default)
+	//            type: integer
+	//          retries:
+	//            description: Retries of failed daemon triggers (NULL for default)
+	//            type: integer
 	res, err := rep.QueryJson(`
     SELECT *
       FROM meta.agreement_trigger
      WHERE agreement_id = $1
        AND meta.user_access($2, agreement_id, 'VIEW') > 0
-     ORDER BY trigger_id`, 0, agreement_id, "system")
+     ORDER BY trigger_id`, 0, agreement_id, GetUsername(c))
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func AgreementScd2(c iris.Context, rep repository.Repository, agreement_name string) string {
+	// swagger:operation GET /api/agreement/scd2/{agreement_name} Agreement AgreementScd2
+	// SCD2 history of agreement entities - the state as of a date or every version
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: agreement_name
+	//   type: string
+	//   in: path
+	//   required: true
+	// - name: key
+	//   type: string
+	//   description: Business key of entity (values comma separated in BUSINESS_KEY order)
+	//   in: query
+	// - name: date
+	//   type: string
+	//   description: State as of date (YYYY-MM-DD) - all versions if omitted
+	//   in: query
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: AgreementScd2
+	//        properties:
+	//          dw_delivery_id:
+	//            description: ID of delivery the version originates from
+	//            type: integer
+	//          dw_row_id:
+	//            description: ID of row in delivery the version originates from
+	//            type: integer
+	//          valid_from:
+	//            description: Status date of delivery the version is valid from
+	//            type: string
+	//          valid_to:
+	//            description: Status date of delivery the version is valid until (NULL if current)
+	//            type: string
+	//          original:
+	//            description: Remaining columns in the original dataset
+	//            type: string
+	var key, date interface{}
+	if c.URLParamExists("key") {
+		key = c.URLParam("key")
+	}
+	if c.URLParamExists("date") {
+		date = c.URLParam("date")
+	}
+	res, err := rep.QueryJson(`EXEC meta.get_scd2 $1, $2, $3, $4`, 0, GetUsername(c), agreement_name, key, date)
 	if err != nil {
 		return err.Error()
 	}
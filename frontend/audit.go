@@ -0,0 +1,265 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kataras/iris"
+	"github.com/sorenbak/datawarehouse/repository"
+	"github.com/sorenbak/datawarehouse/webapi"
+)
+
+// AuditRecord returns the auditor (see webapi.UseAudit) appending to meta.api_audit. The
+// repository is not bound to the request, so calls are recorded when the client went away.
+func AuditRecord(rep repository.Repository) webapi.Auditor {
+	return func(e webapi.AuditEntry) {
+		var username, apiKeyId, params interface{}
+		if e.Username != "" {
+			username = e.Username
+		}
+		if e.ApiKeyId != 0 {
+			apiKeyId = e.ApiKeyId
+		}
+		if e.Params != "" {
+			params = e.Params
+		}
+		_, err := rep.Exec(`EXEC meta.api_audit_add $1, $2, $3, $4, $5, $6, $7, $8, $9, $10`,
+			username, apiKeyId, e.Ip, e.Method, e.Endpoint, e.Path, params, e.Status, e.Outcome, int64(e.Duration/time.Millisecond))
+		if err != nil {
+			log.Printf("Audit of [%s %s] by [%s] failed: [%s]\n", e.Method, e.Path, e.Username, err)
+		}
+	}
+}
+
+var auditListSpec = repository.ListSpec{
+	From:        "meta.api_audit",
+	Key:         "id",
+	Sort:        []string{"createdtm", "username", "endpoint", "status", "duration_ms"},
+	DefaultSort: "-id",
+	Filters:     map[string]string{"user": "username", "method": "method", "outcome": "outcome", "endpoint": "endpoint", "status": "status"},
+	Date:        "createdtm",
+}
+
+func AuditList(c iris.Context, rep repository.Repository) {
+	// swagger:operation GET /api/audit/list Audit AuditList
+	// List the audit trail of changes and sensitive reads - newest first (ADMIN only)
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: limit
+	//   description: Number of rows per page (default 100 - max 1000)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: offset
+	//   description: Number of rows to skip (default 0)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: cursor
+	//   description: Cursor of the next page from a previous page (replaces offset)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: sort
+	//   description: Column to sort on - prefix with - for descending (id, createdtm, username, endpoint, status, duration_ms - default -id)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: user
+	//   description: Username (comma separated for more)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: method
+	//   description: HTTP method (comma separated for more)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: outcome
+	//   description: Outcome (success, denied or failed - comma separated for more)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: endpoint
+	//   description: Route (e.g. /api/group/add)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: status
+	//   description: HTTP status (comma separated for more)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: from
+	//   description: From date (YYYY-MM-DD)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: to
+	//   description: To date (YYYY-MM-DD - inclusive)
+	//   type: string
+	//   in: query
+	//   required: false
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: object
+	//      title: AuditList
+	//      properties:
+	//        total:
+	//          description: Count of rows matching the filters
+	//          type: integer
+	//        next:
+	//          description: Link to the next page (empty on last page)
+	//          type: string
+	//        data:
+	//          type: array
+	//          items:
+	//            type: object
+	//            properties:
+	//              id:
+	//                description: ID of entry
+	//                type: integer
+	//              createdtm:
+	//                description: Time of call
+	//                type: string
+	//              username:
+	//                description: User (empty if not authenticated)
+	//                type: string
+	//              api_key_id:
+	//                description: ID of API key used (empty if none)
+	//                type: integer
+	//              ip:
+	//                description: Source IP
+	//                type: string
+	//              method:
+	//                description: HTTP method
+	//                type: string
+	//              endpoint:
+	//                description: Route called
+	//                type: string
+	//              path:
+	//                description: Path requested
+	//                type: string
+	//              params:
+	//                description: Query parameters and body as JSON
+	//                type: string
+	//              status:
+	//                description: HTTP status of the response
+	//                type: integer
+	//              outcome:
+	//                description: success, denied or failed
+	//                type: string
+	//              duration_ms:
+	//                description: Duration of the call in milliseconds
+	//                type: integer
+	//   '400':
+	//     description: Invalid parameters
+	//   '403':
+	//     description: Not member of ADMIN
+	res, err := listPage(c, rep, auditListSpec)
+	if err != nil {
+		c.StatusCode(400)
+		c.WriteString(err.Error())
+		return
+	}
+	c.JSON(res)
+}
+
+func AuditExport(c iris.Context, rep repository.Repository, format string) {
+	// swagger:operation GET /api/audit/export/{format} Audit AuditExport
+	// Export the audit trail (oldest first) - e.g. to archive it or feed a SIEM (ADMIN only)
+	// ---
+	// produces:
+	// - application/json
+	// - application/x-ndjson
+	// - text/csv
+	// - application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+	// parameters:
+	// - name: format
+	//   description: Format of the export (json, ndjson, csv or xlsx)
+	//   type: string
+	//   in: path
+	//   required: true
+	// - name: user
+	//   description: Username (comma separated for more)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: method
+	//   description: HTTP method (comma separated for more)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: outcome
+	//   description: Outcome (success, denied or failed - comma separated for more)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: endpoint
+	//   description: Route (e.g. /api/group/add)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: status
+	//   description: HTTP status (comma separated for more)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: from
+	//   description: From date (YYYY-MM-DD)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: to
+	//   description: To date (YYYY-MM-DD - inclusive)
+	//   type: string
+	//   in: query
+	//   required: false
+	// responses:
+	//   '200':
+	//     description: The entries as listed by AuditList
+	//   '400':
+	//     description: Invalid format or parameters
+	//   '403':
+	//     description: Not member of ADMIN
+	params, err := repository.ParseList(c.Request().URL.Query(), auditListSpec)
+	if err != nil {
+		c.StatusCode(400)
+		c.WriteString(err.Error())
+		return
+	}
+	w, err := repository.NewRowWriter(format, c.ResponseWriter(), 0)
+	if err != nil {
+		c.StatusCode(400)
+		c.WriteString(err.Error())
+		return
+	}
+	where, args := auditListSpec.Filter(params)
+	// The query is cancelled if the client goes away
+	rows, err := rep.Rows(`
+    SELECT id, createdtm, username, api_key_id, ip, method, endpoint, path, params, status, outcome, duration_ms
+      FROM meta.api_audit
+     WHERE `+where+`
+     ORDER BY id`, args...)
+	if err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	defer rows.Close()
+	c.ContentType(w.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="audit_%s.%s"`, time.Now().Format("20060102150405"), strings.ToLower(format)))
+	count, err := repository.Copy(w, rows, func() { c.ResponseWriter().Flush() }, downloadFlushRows)
+	if err != nil {
+		// Too late to change the status - abort the connection (see download)
+		log.Printf("AuditExport failed after [%d] rows: [%s]\n", count, err)
+		panic(http.ErrAbortHandler)
+	}
+}
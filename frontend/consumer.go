@@ -0,0 +1,317 @@
+package main
+
+import (
+	"github.com/kataras/iris"
+	"github.com/sorenbak/datawarehouse/repository"
+)
+
+func ConsumerUsage(c iris.Context, rep repository.Repository) string {
+	// swagger:operation GET /api/consumer/usage Consumer ConsumerUsage
+	// Consumption per agreement - least recently used (dead feeds) first
+	// ---
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: ConsumerUsage
+	//        properties:
+	//          agreement_id:
+	//            description: ID of agreement
+	//            type: integer
+	//          agreement_name:
+	//            description: Name of agreement
+	//            type: string
+	//          consumer_count:
+	//            description: Count of users having retrieved data
+	//            type: integer
+	//          retrieval_count:
+	//            description: Count of successful retrievals
+	//            type: integer
+	//          denied_count:
+	//            description: Count of denied retrievals
+	//            type: integer
+	//          last_access:
+	//            description: Time of latest successful retrieval (NULL if never)
+	//            type: string
+	//          published_count:
+	//            description: Count of deliveries published to repo
+	//            type: integer
+	//          unconsumed_count:
+	//            description: Count of published deliveries never retrieved
+	//            type: integer
+	res, err := rep.QueryJson(`
+    SELECT *
+      FROM meta.agreement_usage_v
+     WHERE meta.user_access($1, agreement_id, 'VIEW') > 0
+     ORDER BY CASE WHEN last_access IS NULL THEN 0 ELSE 1 END, last_access, agreement_name`, 0, GetUsername(c))
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func ConsumerAgreement(c iris.Context, rep repository.Repository, agreement_id int64) string {
+	// swagger:operation GET /api/consumer/agreement/{agreement_id} Consumer ConsumerAgreement
+	// Consumers of an agreement
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: agreement_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: ConsumerAgreement
+	//        properties:
+	//          agreement_id:
+	//            description: ID of agreement
+	//            type: integer
+	//          user_id:
+	//            description: ID of consuming user
+	//            type: integer
+	//          user_username:
+	//            description: Username of consuming user
+	//            type: string
+	//          user_realname:
+	//            description: Real name of consuming user
+	//            type: string
+	//          retrieval_count:
+	//            description: Count of successful retrievals
+	//            type: integer
+	//          denied_count:
+	//            description: Count of denied retrievals
+	//            type: integer
+	//          delivery_count:
+	//            description: Count of distinct deliveries retrieved
+	//            type: integer
+	//          first_access:
+	//            description: Time of first retrieval attempt
+	//            type: string
+	//          last_access:
+	//            description: Time of latest successful retrieval
+	//            type: string
+	//          last_delivery_id:
+	//            description: ID of latest delivery retrieved
+	//            type: integer
+	res, err := rep.QueryJson(`
+    SELECT *
+      FROM meta.agreement_consumer_v
+     WHERE agreement_id = $1
+       AND meta.user_access($2, agreement_id, 'VIEW') > 0
+     ORDER BY last_access DESC`, 0, agreement_id, GetUsername(c))
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func ConsumerDelivery(c iris.Context, rep repository.Repository, delivery_id int64) string {
+	// swagger:operation GET /api/consumer/delivery/{delivery_id} Consumer ConsumerDelivery
+	// Consumers of a delivery
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: delivery_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: ConsumerDelivery
+	//        properties:
+	//          user_id:
+	//            description: ID of consuming user
+	//            type: integer
+	//          user_username:
+	//            description: Username of consuming user
+	//            type: string
+	//          user_realname:
+	//            description: Real name of consuming user
+	//            type: string
+	//          retrieval_count:
+	//            description: Count of retrievals of the delivery
+	//            type: integer
+	//          first_access:
+	//            description: Time of first retrieval
+	//            type: string
+	//          last_access:
+	//            description: Time of latest retrieval
+	//            type: string
+	res, err := rep.QueryJson(`
+    SELECT user_id, user_username, user_realname,
+           COUNT(*) AS retrieval_count,
+           MIN(createdtm) AS first_access,
+           MAX(createdtm) AS last_access
+      FROM meta.link_v
+     WHERE dw_delivery_id = $1
+       AND status_id = 1
+       AND meta.user_access($2, agreement_id, 'VIEW') > 0
+     GROUP BY user_id, user_username, user_realname
+     ORDER BY last_access DESC`, 0, delivery_id, GetUsername(c))
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func ConsumerDenied(c iris.Context, rep repository.Repository, agreement_id int64) string {
+	// swagger:operation GET /api/consumer/denied/{agreement_id} Consumer ConsumerDenied
+	// Denied retrieval attempts of an agreement - latest first
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: agreement_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: ConsumerDenied
+	//        properties:
+	//          id:
+	//            description: ID of link
+	//            type: integer
+	//          external_id:
+	//            description: ID of external data item
+	//            type: integer
+	//          dw_delivery_id:
+	//            description: ID of delivery requested (NULL if latest)
+	//            type: integer
+	//          user_id:
+	//            description: ID of requesting user (NULL if unknown)
+	//            type: integer
+	//          user_username:
+	//            description: Username of requesting user
+	//            type: string
+	//          createdtm:
+	//            description: Time of attempt
+	//            type: string
+	res, err := rep.QueryJson(`
+    SELECT TOP 1000 id, external_id, dw_delivery_id, user_id, user_username, createdtm
+      FROM meta.link_v
+     WHERE agreement_id = $1
+       AND status_id <> 1
+       AND meta.user_access($2, agreement_id, 'VIEW') > 0
+     ORDER BY createdtm DESC`, 0, agreement_id, GetUsername(c))
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func ConsumerUnconsumed(c iris.Context, rep repository.Repository, agreement_id int64) string {
+	// swagger:operation GET /api/consumer/unconsumed/{agreement_id} Consumer ConsumerUnconsumed
+	// Deliveries of an agreement published to repo but never retrieved
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: agreement_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: ConsumerUnconsumed
+	//        properties:
+	//          delivery_id:
+	//            description: ID of delivery
+	//            type: integer
+	//          delivery_name:
+	//            description: Name of delivery
+	//            type: string
+	//          status_date:
+	//            description: Status date of delivery
+	//            type: string
+	//          createdtm:
+	//            description: Time of delivery
+	//            type: string
+	res, err := rep.QueryJson(`
+    SELECT d.id AS delivery_id, d.name AS delivery_name, d.status_date, d.createdtm
+      FROM meta.delivery d
+     WHERE d.agreement_id = $1
+       AND meta.user_access($2, d.agreement_id, 'VIEW') > 0
+       AND EXISTS (SELECT 1 FROM meta.audit u WHERE u.delivery_id = d.id AND u.stage_id = 3)
+       AND NOT EXISTS (SELECT 1 FROM meta.link l WHERE l.dw_delivery_id = d.id AND l.status_id = 1)
+     ORDER BY d.id DESC`, 0, agreement_id, GetUsername(c))
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func ConsumerActivity(c iris.Context, rep repository.Repository) string {
+	// swagger:operation GET /api/consumer/activity Consumer ConsumerActivity
+	// Activity per user from the usage log - least recently active first (ADMIN only)
+	// ---
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: ConsumerActivity
+	//        properties:
+	//          user_id:
+	//            description: ID of user
+	//            type: integer
+	//          username:
+	//            description: Username of user
+	//            type: string
+	//          realname:
+	//            description: Real name of user
+	//            type: string
+	//          request_count:
+	//            description: Count of logged requests
+	//            type: integer
+	//          last_access:
+	//            description: Time of latest logged request
+	//            type: string
+	//          last_path:
+	//            description: Path of latest logged request
+	//            type: string
+	//   '403':
+	//     description: Not member of ADMIN
+	res, err := rep.QueryJson(`
+    SELECT user_id, username, realname, COUNT(*) AS request_count, MAX(createdtm) AS last_access,
+           (SELECT TOP 1 x.path FROM meta.usage_v x WHERE x.user_id = u.user_id ORDER BY x.createdtm DESC) AS last_path
+      FROM meta.usage_v u
+     GROUP BY user_id, username, realname
+     ORDER BY last_access`, 0)
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kataras/iris"
+	"github.com/sorenbak/datawarehouse/repository"
+)
+
+// Delivery returned by get_data as of a date/time
+type dataDeliveryDto struct {
+	DeliveryId         int64      `json:"delivery_id"`
+	DeliveryName       string     `json:"delivery_name"`
+	DeliveryCreatedtm  time.Time  `json:"delivery_createdtm"`
+	DeliveryStatusDate *time.Time `json:"delivery_status_date"`
+	MaxAgeDays         int64      `json:"max_age_days"`
+}
+
+// Accepted formats of the asof parameter (as get_data)
+var asofLayouts = []string{"2006-01-02 15:04:05", "2006-01-02"}
+
+func Data(c iris.Context, rep repository.Repository, agreement_name string) {
+	// swagger:operation GET /api/data/{agreement_name} Data Data
+	// Data of an agreement as of a date/time, i.e.the latest delivery up until asof (default now)
+	// no older than the MAX_AGE_DAYS attribute (DELTA agreements do not age). The resolved delivery
+	// is returned in the X-Delivery-* headers. The format is taken from the format parameter or
+	// the Accept header.
+	// ---
+	// produces:
+	// - application/json
+	// - application/x-ndjson
+	// - text/csv
+	// - application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+	// parameters:
+	// - name: agreement_name
+	//   type: string
+	//   in: path
+	//   required: true
+	// - name: asof
+	//   description: Date/time (YYYY-MM-DD[ HH:MI:SS]) of latest delivery up until - default now
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: external_id
+	//   description: ID of external data item (e.g. in AAC) logged with the retrieval
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: format
+	//   description: Format of data (json, ndjson, csv or xlsx) - overrides the Accept header
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: header
+	//   description: CSV header line (yes/no) - default as the original file of the agreement type
+	//   type: string
+	//   in: query
+	//   required: false
+	// responses:
+	//   '200':
+	//     description: OK
+	//     headers:
+	//       X-Delivery-Id:
+	//         description: ID of the delivery returned
+	//         type: integer
+	//       X-Delivery-Name:
+	//         description: Name of the delivery returned
+	//         type: string
+	//       X-Delivery-Createdtm:
+	//         description: Creation time of the delivery returned (RFC 3339)
+	//         type: string
+	//       X-Delivery-Status-Date:
+	//         description: Status date (YYYY-MM-DD) of the delivery returned
+	//         type: string
+	//       X-Max-Age-Days:
+	//         description: MAX_AGE_DAYS of the agreement
+	//         type: integer
+	//   '400':
+	//     description: Invalid asof, external_id or format
+	//   '404':
+	//     description: No delivery as of the date/time within MAX_AGE_DAYS
+	var asof interface{} // NULL => now
+	asofText := "now"
+	if s := c.URLParam("asof"); s != "" {
+		if err := checkAsof(s); err != nil {
+			c.StatusCode(400)
+			c.WriteString(err.Error())
+			return
+		}
+		asof, asofText = s, s
+	}
+	var external_id int64
+	if s := c.URLParam("external_id"); s != "" {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			c.StatusCode(400)
+			c.WriteString(fmt.Sprintf("external_id [%s] is not an integer", s))
+			return
+		}
+		external_id = id
+	}
+	format := c.URLParam("format")
+	if format == "" {
+		format = repository.Negotiate(c.GetHeader("Accept"))
+	}
+
+	var deliveries []dataDeliveryDto
+	err := rep.QueryStruct(&deliveries, `
+    SELECT d.id AS delivery_id,
+           d.name AS delivery_name,
+           d.createdtm AS delivery_createdtm,
+           d.status_date AS delivery_status_date,
+           meta.get_max_age_days(a.id) AS max_age_days
+      FROM meta.agreement a,
+           meta.delivery d
+     WHERE a.name = $1
+       AND d.id = meta.get_data_delivery_id(a.id, CONVERT(DATETIME, $2, 120))
+       AND meta.user_access($3, a.id, 'VIEW') > 0`, agreement_name, asof, GetUsername(c))
+	if err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	if len(deliveries) == 0 {
+		c.StatusCode(404)
+		c.WriteString(fmt.Sprintf("No delivery of agreement [%s] as of [%s] within MAX_AGE_DAYS", agreement_name, asofText))
+		return
+	}
+
+	// The delivery is passed on to get_data, so the data matches the headers even if a new
+	// delivery arrives meanwhile
+	d := deliveries[0]
+	c.Header("X-Delivery-Id", strconv.FormatInt(d.DeliveryId, 10))
+	c.Header("X-Delivery-Name", d.DeliveryName)
+	c.Header("X-Delivery-Createdtm", d.DeliveryCreatedtm.Format(time.RFC3339))
+	if d.DeliveryStatusDate != nil {
+		c.Header("X-Delivery-Status-Date", d.DeliveryStatusDate.Format("2006-01-02"))
+	}
+	c.Header("X-Max-Age-Days", strconv.FormatInt(d.MaxAgeDays, 10))
+	download(c, rep, format, agreement_name, d.DeliveryId, external_id)
+}
+
+// checkAsof validates the asof date/time (YYYY-MM-DD[ HH:MI:SS])
+func checkAsof(s string) error {
+	for _, layout := range asofLayouts {
+		if _, err := time.Parse(layout, s); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("asof [%s] is not a date (YYYY-MM-DD[ HH:MI:SS])", s)
+}
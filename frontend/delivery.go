@@ -1,52 +1,76 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/kataras/iris"
 	"github.com/sorenbak/datawarehouse/file"
 	"github.com/sorenbak/datawarehouse/repository"
 )
 
-// Details for an agreement
+// Details for a delivery
 // swagger:model
 type DeliveryDto struct {
 	// ID of agreement
-	agreement_id int64
+	AgreementId int64 `json:"agreement_id"`
 	// Name of agreement
-	agreement_name string
-	// Name of owning gruop
-	agreement_group string
+	AgreementName string `json:"agreement_name"`
+	// Name of owning group
+	AgreementGroup string `json:"agreement_group"`
 	// Description of agreement
-	agreement_description string
+	AgreementDescription string `json:"agreement_description"`
 	// Prefix pattern for picking up files in delivery in
-	agreement_pattern string
+	AgreementPattern string `json:"agreement_pattern"`
 	// Name of delivery
-	delivery_name string
+	DeliveryName string `json:"delivery_name"`
 	// ID of delivery
-	delivery_id int64
-	// Name of delivery owner
-	delivery_owner string
+	DeliveryId int64 `json:"delivery_id"`
+	// Name of delivery owner (NULL if the user has no real name)
+	DeliveryOwner *string `json:"delivery_owner"`
 	// Creation date of delivery
-	delivery_createdtm string
+	DeliveryCreatedtm time.Time `json:"delivery_createdtm"`
 	// Size (number of rows) in delivery
-	delivery_size int64
-	// Date extracted from delivery name (YYYYMMDD) used for tracking validity
-	delivery_status_date string
+	DeliverySize int64 `json:"delivery_size"`
+	// Date extracted from delivery name (YYYYMMDD) used for tracking validity (NULL if none)
+	DeliveryStatusDate *time.Time `json:"delivery_status_date"`
 	// Name of stage delivery is in
-	stage_name string
+	StageName string `json:"stage_name"`
 	// Date of latest audit record for delivery
-	audit_createdtm string
-	// Description of latest audit entry
-	audit_description string
+	AuditCreatedtm time.Time `json:"audit_createdtm"`
+	// Description of latest audit entry (NULL if none)
+	AuditDescription *string `json:"audit_description"`
 	// ID of status delivery is in
-	status_id string
+	StatusId int64 `json:"status_id"`
 	// ID of user owning the delivery
-	user_id int64
+	UserId int64 `json:"user_id"`
 }
 
 var DeliveryDtoQ = struct2query(DeliveryDto{})
 
+// Metric computed by a delivery level check
+type DeliveryStatDto struct {
+	DeliveryId int64     `json:"delivery_id"`
+	CheckType  string    `json:"check_type"`
+	ColumnName string    `json:"column_name"`
+	Value      *float64  `json:"value"`
+	Createdtm  time.Time `json:"createdtm"`
+}
+
+var deliveryListSpec = repository.ListSpec{
+	From:        `(SELECT ` + DeliveryDtoQ + ` FROM meta.agreement_delivery_max_audit_v WHERE agreement_id = $1) d`,
+	Where:       `meta.user_access($2, agreement_id, 'VIEW') > 0`,
+	Key:         "delivery_id",
+	Sort:        []string{"delivery_name", "delivery_owner", "delivery_createdtm", "delivery_size", "delivery_status_date", "stage_name", "audit_createdtm", "status_id"},
+	DefaultSort: "-audit_createdtm",
+	Filters:     map[string]string{"status": "status_id", "stage": "stage_name", "owner": "delivery_owner"},
+	Date:        "delivery_status_date",
+}
+
 func DeliveryList(c iris.Context, rep repository.Repository, agreement_id int64) string {
 	// swagger:operation GET /api/delivery/agreement/{agreement_id} Delivery DeliveryList
 	// List available deliveries
@@ -58,29 +82,87 @@ func DeliveryList(c iris.Context, rep repository.Repository, agreement_id int64)
 	//   type: integer
 	//   in: path
 	//   required: false
+	// - name: limit
+	//   description: Number of rows per page (default 100 - max 1000)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: offset
+	//   description: Number of rows to skip (default 0)
+	//   type: integer
+	//   in: query
+	//   required: false
 	// - name: page
-	//   description: Page of results (starting with 0 - default)
+	//   description: Page of results (starting with 0 - default) - legacy alternative to offset
 	//   type: integer
 	//   in: query
 	//   required: false
+	// - name: cursor
+	//   description: Cursor of the next page from a previous page (replaces offset)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: sort
+	//   description: Column to sort on - prefix with - for descending (delivery_id, delivery_name, delivery_owner, delivery_createdtm, delivery_size, delivery_status_date, stage_name, audit_createdtm (default -audit_createdtm), status_id)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: status
+	//   description: Filter on status ID(s) - comma separated
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: stage
+	//   description: Filter on stage name(s) - comma separated
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: owner
+	//   description: Filter on delivery owner(s) - comma separated
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: from
+	//   description: Status date from date (YYYY-MM-DD)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: to
+	//   description: Status date to date (YYYY-MM-DD - inclusive)
+	//   type: string
+	//   in: query
+	//   required: false
 	// responses:
 	//   '200':
 	//     description: OK
 	//     schema:
-	//       type: array
-	//       items:
-	//          $ref: "#/definitions/DeliveryDto"
-	page := c.FormValueDefault("page", "0")
+	//      type: object
+	//      title: DeliveryList
+	//      properties:
+	//        total:
+	//          description: Count of rows matching the filters
+	//          type: integer
+	//        limit:
+	//          description: Number of rows per page
+	//          type: integer
+	//        offset:
+	//          description: Number of rows skipped
+	//          type: integer
+	//        sort:
+	//          description: Sort applied
+	//          type: string
+	//        cursor:
+	//          description: Cursor of the next page
+	//          type: string
+	//        next:
+	//          description: Link to the next page (empty on last page)
+	//          type: string
+	//        data:
+	//          type: array
+	//          items:
+	//            $ref: "#/definitions/DeliveryDto"
 	user := GetUsername(c)
-	user = "system"
-	res, err := rep.QueryJson(`
-    SELECT *
-      FROM (SELECT ROW_NUMBER() OVER ( ORDER BY audit_createdtm DESC ) AS rownum, `+DeliveryDtoQ+`
-              FROM meta.agreement_delivery_max_audit_v
-             WHERE agreement_id = $1
-               AND meta.user_access($2, $1, 'VIEW') > 0) r
-     WHERE rownum BETWEEN 100 * $3 AND ($3 + 1) * 100`,
-		0, agreement_id, user, page)
+	res, err := listJson(c, rep, deliveryListSpec, agreement_id, user)
 	if err != nil {
 		return err.Error()
 	}
@@ -106,17 +188,14 @@ func DeliveryDetail(c iris.Context, rep repository.Repository, delivery_id int64
 	//       items:
 	//          $ref: "#/definitions/DeliveryDto"
 	user := GetUsername(c)
-	user = "system"
-	res, err := rep.QueryJson(`
-    SELECT `+DeliveryDtoQ+` 
+	var res []DeliveryDto
+	err := rep.QueryStruct(&res, `
+    SELECT `+DeliveryDtoQ+`
       FROM meta.agreement_delivery_max_audit_v
      WHERE delivery_id = $1
        AND meta.user_access($2, agreement_id, 'VIEW') > 0`,
-		0, delivery_id, user)
-	if err != nil {
-		return err.Error()
-	}
-	return res
+		delivery_id, user)
+	return dtoJson(res, err)
 }
 
 func DeliveryOperation(c iris.Context, rep repository.Repository, delivery_id int64) string {
@@ -195,20 +274,235 @@ func DeliveryOperation(c iris.Context, rep repository.Repository, delivery_id in
       FROM meta.delivery_id_audit_operation_v
      WHERE delivery_id = $1
        AND meta.user_access($2, agreement_id, 'VIEW')>0
-     ORDER BY operation_createdtm DESC`, 0, delivery_id, "system")
+     ORDER BY operation_createdtm DESC`, 0, delivery_id, GetUsername(c))
 	if err != nil {
 		return err.Error()
 	}
 	return res
 }
 
-func DeliveryDownloadJson(c iris.Context, rep repository.Repository, agreement_name string, delivery_id int64) string {
-	// swagger:operation GET /api/delivery/download/json/{agreement_name}/{delivery_id} Delivery DeliveryDownloadJson
-	// Download contents of delivery
+func DeliveryStat(c iris.Context, rep repository.Repository, delivery_id int64) string {
+	// swagger:operation GET /api/delivery/stat/{delivery_id} Delivery DeliveryStat
+	// List the metrics computed by the delivery level checks
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: delivery_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: DeliveryStat
+	//        properties:
+	//          delivery_id:
+	//            description: ID of delivery
+	//            type: integer
+	//          check_type:
+	//            description: Type of metric
+	//            type: string
+	//          column_name:
+	//            description: Column the metric is computed for (empty for ROW_COUNT)
+	//            type: string
+	//          value:
+	//            description: Value of metric
+	//            type: number
+	//          createdtm:
+	//            description: Date of computation
+	//            type: string
+	var res []DeliveryStatDto
+	err := rep.QueryStruct(&res, `
+    SELECT s.delivery_id, s.check_type, s.column_name, s.value, s.createdtm
+      FROM meta.delivery_stat s,
+           meta.delivery d
+     WHERE s.delivery_id = d.id
+       AND s.delivery_id = $1
+       AND meta.user_access($2, d.agreement_id, 'VIEW') > 0
+     ORDER BY s.check_type, s.column_name`, delivery_id, GetUsername(c))
+	return dtoJson(res, err)
+}
+
+func DeliveryProfile(c iris.Context, rep repository.Repository, delivery_id int64) string {
+	// swagger:operation GET /api/delivery/profile/{delivery_id} Delivery DeliveryProfile
+	// Profile of delivery per column compared with the previous delivery of the agreement
 	// ---
 	// produces:
 	// - application/json
 	// parameters:
+	// - name: delivery_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: DeliveryProfile
+	//        properties:
+	//          column_name:
+	//            description: Name of column
+	//            type: string
+	//          ordinal_position:
+	//            description: Order in table
+	//            type: integer
+	//          data_type:
+	//            description: SQL data type in stag
+	//            type: string
+	//          row_count:
+	//            description: Number of rows
+	//            type: integer
+	//          null_count:
+	//            description: Number of NULL values
+	//            type: integer
+	//          distinct_count:
+	//            description: Number of distinct values
+	//            type: integer
+	//          min_value:
+	//            description: Smallest value
+	//            type: string
+	//          max_value:
+	//            description: Largest value
+	//            type: string
+	//          min_length:
+	//            description: Shortest value (characters)
+	//            type: integer
+	//          max_length:
+	//            description: Longest value (characters)
+	//            type: integer
+	//          avg_length:
+	//            description: Average length of values (characters)
+	//            type: number
+	//          previous_delivery_id:
+	//            description: ID of previous profiled delivery of agreement (NULL if none)
+	//            type: integer
+	//          previous_row_count:
+	//            description: Number of rows in previous delivery
+	//            type: integer
+	//          previous_null_count:
+	//            description: Number of NULL values in previous delivery
+	//            type: integer
+	//          previous_distinct_count:
+	//            description: Number of distinct values in previous delivery
+	//            type: integer
+	//          previous_min_value:
+	//            description: Smallest value in previous delivery
+	//            type: string
+	//          previous_max_value:
+	//            description: Largest value in previous delivery
+	//            type: string
+	//          previous_avg_length:
+	//            description: Average length of values in previous delivery
+	//            type: number
+	//          top:
+	//            description: Most frequent values (value, count)
+	//            type: array
+	//            items:
+	//              type: object
+	//          length:
+	//            description: Length distribution (value, count)
+	//            type: array
+	//            items:
+	//              type: object
+	//          pattern:
+	//            description: Pattern histogram with letters as A and digits as 9 (value, count)
+	//            type: array
+	//            items:
+	//              type: object
+	columns, err := rep.Query(`
+    SELECT p.column_name, p.ordinal_position, p.data_type, p.row_count, p.null_count, p.distinct_count,
+           p.min_value, p.max_value, p.min_length, p.max_length, p.avg_length,
+           q.delivery_id    AS previous_delivery_id,
+           q.row_count      AS previous_row_count,
+           q.null_count     AS previous_null_count,
+           q.distinct_count AS previous_distinct_count,
+           q.min_value      AS previous_min_value,
+           q.max_value      AS previous_max_value,
+           q.avg_length     AS previous_avg_length
+      FROM meta.delivery_profile p
+           INNER JOIN
+           meta.delivery d ON (d.id = p.delivery_id)
+           LEFT OUTER JOIN
+           meta.delivery_profile q ON (q.column_name = p.column_name
+                                   AND q.delivery_id = (SELECT MAX(x.delivery_id)
+                                                          FROM meta.delivery_profile x,
+                                                               meta.delivery y
+                                                         WHERE x.delivery_id  = y.id
+                                                           AND y.agreement_id = d.agreement_id
+                                                           AND x.delivery_id  < d.id))
+     WHERE p.delivery_id = $1
+       AND meta.user_access($2, d.agreement_id, 'VIEW') > 0
+     ORDER BY p.ordinal_position`, 0, delivery_id, GetUsername(c))
+	if err != nil {
+		return err.Error()
+	}
+	if len(columns) == 0 {
+		return "[]"
+	}
+	values, err := rep.Query(`
+    SELECT column_name, LOWER(profile_type) AS profile_type, value, value_count
+      FROM meta.delivery_profile_value
+     WHERE delivery_id = $1
+     ORDER BY column_name, profile_type, value_count DESC`, 0, delivery_id)
+	if err != nil {
+		return err.Error()
+	}
+	// Attach the top-N, length and pattern histograms to their columns
+	byname := make(map[string]map[string]interface{})
+	for _, col := range columns {
+		m := col.(map[string]interface{})
+		m["top"], m["length"], m["pattern"] = []interface{}{}, []interface{}{}, []interface{}{}
+		byname[m["column_name"].(string)] = m
+	}
+	for _, val := range values {
+		v := val.(map[string]interface{})
+		m, ok := byname[v["column_name"].(string)]
+		if !ok {
+			continue
+		}
+		t := v["profile_type"].(string)
+		m[t] = append(m[t].([]interface{}), map[string]interface{}{"value": v["value"], "count": v["value_count"]})
+	}
+	str, err := json.Marshal(columns)
+	if err != nil {
+		return err.Error()
+	}
+	return string(str)
+}
+
+// Rows written between flushes of streamed downloads
+const downloadFlushRows = 1000
+
+// Repo column of an agreement along with the file layout of its type
+type downloadColumnDto struct {
+	ColumnName      string  `json:"column_name"`
+	Fieldterminator *string `json:"fieldterminator"`
+	Firstrow        *int64  `json:"firstrow"`
+}
+
+func DeliveryDownload(c iris.Context, rep repository.Repository, format string, agreement_name string, delivery_id int64) {
+	// swagger:operation GET /api/delivery/download/{format}/{agreement_name}/{delivery_id} Delivery DeliveryDownload
+	// Download contents of delivery - streamed as it is read from the repository
+	// ---
+	// produces:
+	// - application/json
+	// - application/x-ndjson
+	// - text/csv
+	// - application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+	// parameters:
+	// - name: format
+	//   description: Format of download (json, ndjson, csv or xlsx)
+	//   type: string
+	//   in: path
+	//   required: true
 	// - name: agreement_name
 	//   type: string
 	//   in: path
@@ -217,6 +511,11 @@ func DeliveryDownloadJson(c iris.Context, rep repository.Repository, agreement_n
 	//   type: integer
 	//   in: path
 	//   required: false
+	// - name: header
+	//   description: CSV header line (yes/no) - default as the original file of the agreement type
+	//   type: string
+	//   in: query
+	//   required: false
 	// responses:
 	//   '200':
 	//     description: OK
@@ -235,11 +534,107 @@ func DeliveryDownloadJson(c iris.Context, rep repository.Repository, agreement_n
 	//          original:
 	//            description: Remaining columns in the original dataset
 	//            type: string
-	res, err := rep.QueryJson(`EXEC meta.get_data $1, $2, 0, NULL, $3`, 0, "system", agreement_name, delivery_id)
+	download(c, rep, format, agreement_name, delivery_id, 0)
+}
+
+func DeliveryDownloadNegotiated(c iris.Context, rep repository.Repository, agreement_name string, delivery_id int64) {
+	// swagger:operation GET /api/delivery/download/{agreement_name}/{delivery_id} Delivery DeliveryDownloadNegotiated
+	// Download contents of delivery in the format of the format query parameter or Accept header
+	// ---
+	// produces:
+	// - application/json
+	// - application/x-ndjson
+	// - text/csv
+	// - application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+	// parameters:
+	// - name: agreement_name
+	//   type: string
+	//   in: path
+	//   required: true
+	// - name: delivery_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: format
+	//   description: Format of download (json, ndjson, csv or xlsx) - overrides the Accept header
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: header
+	//   description: CSV header line (yes/no) - default as the original file of the agreement type
+	//   type: string
+	//   in: query
+	//   required: false
+	// responses:
+	//   '200':
+	//     description: OK
+	format := c.URLParam("format")
+	if format == "" {
+		format = repository.Negotiate(c.GetHeader("Accept"))
+	}
+	download(c, rep, format, agreement_name, delivery_id, 0)
+}
+
+// download streams the delivery (get_data) in format with the columns in the order of the
+// repo table. CSV uses the field terminator and header of the original file. The retrieval
+// is logged as a link with external_id.
+func download(c iris.Context, rep repository.Repository, format string, agreement_name string, delivery_id int64, external_id int64) {
+	var layout []downloadColumnDto
+	err := rep.QueryStruct(&layout, `
+    SELECT REPLACE(REPLACE(m.column_name, '[', ''), ']', '') AS column_name,
+           y.fieldterminator,
+           y.firstrow
+      FROM meta.agreement a,
+           meta.[type] y,
+           meta.column_mapping_v m
+     WHERE a.name = $1
+       AND y.id = a.type_id
+       AND m.agreement_id = a.id
+       AND m.table_schema = 'repo'
+     ORDER BY m.ordinal_position`, agreement_name)
 	if err != nil {
-		return err.Error()
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	var order []string
+	var separator rune
+	header := true
+	for _, col := range layout {
+		order = append(order, col.ColumnName)
+		separator = delimiter(col.Fieldterminator)
+		header = col.Firstrow != nil && *col.Firstrow > 1
+	}
+	if c.URLParamExists("header") {
+		header = c.URLParam("header") == "yes"
+	}
+
+	w, err := repository.NewRowWriter(format, c.ResponseWriter(), separator)
+	if err != nil {
+		c.StatusCode(400)
+		c.WriteString(err.Error())
+		return
+	}
+	if !header {
+		w = repository.NoHeader(w)
+	}
+	// The query is cancelled if the client goes away
+	rows, err := rep.Rows(`EXEC meta.get_data $1, $2, $3, NULL, $4`, GetUsername(c), agreement_name, external_id, delivery_id)
+	if err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	defer rows.Close()
+	c.ContentType(w.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s_%d.%s"`, agreement_name, delivery_id, strings.ToLower(format)))
+	count, err := repository.Copy(w, repository.OrderedRows(rows, order), func() { c.ResponseWriter().Flush() }, downloadFlushRows)
+	if err != nil {
+		// Too late to change the status - abort the connection so the client does not take
+		// the truncated download for a complete one
+		log.Printf("DeliveryDownload [%s] [%d] failed after [%d] rows: [%s]\n", agreement_name, delivery_id, count, err)
+		panic(http.ErrAbortHandler)
 	}
-	return res
 }
 
 func DeliveryDelete(c iris.Context, rep repository.Repository, delivery_id int64) string {
@@ -256,8 +651,26 @@ func DeliveryDelete(c iris.Context, rep repository.Repository, delivery_id int64
 	// responses:
 	//   '200':
 	//     description: OK
-	res, err := rep.QueryJson(`EXEC meta.delivery_delete $1`, 0, delivery_id)
+	//   '403':
+	//     description: No DELETE permission of the agreement (or unknown delivery)
+	var access []struct {
+		UserId int64 `json:"user_id"`
+	}
+	err := rep.QueryStruct(&access, `
+    SELECT meta.user_access($2, agreement_id, 'DELETE') AS user_id
+      FROM meta.delivery
+     WHERE id = $1`, delivery_id, GetUsername(c))
+	if err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	if len(access) == 0 || access[0].UserId == 0 {
+		c.StatusCode(403)
+		return fmt.Sprintf("No DELETE permission of delivery_id [%d]", delivery_id)
+	}
+	res, err := rep.QueryJson(`EXEC meta.delivery_delete $1, $2`, 0, delivery_id, GetUsername(c))
 	if err != nil {
+		c.StatusCode(500)
 		return err.Error()
 	}
 	return res
@@ -277,15 +690,15 @@ func DeliveryLog(c iris.Context, rep repository.Repository, filer file.DwFiler,
 	// responses:
 	//   '200':
 	//     description: OK
-	res, err := rep.Query(`SELECT delivery_name FROM meta.agreement_delivery_max_audit_v WHERE delivery_id = $1`, 1, delivery_id)
+	var res []DeliveryDto
+	err := rep.QueryStruct(&res, `SELECT delivery_name FROM meta.agreement_delivery_max_audit_v WHERE delivery_id = $1 AND meta.user_access($2, agreement_id, 'VIEW') > 0`, delivery_id, GetUsername(c))
 	if err != nil {
 		return err.Error()
 	}
 	if len(res) < 1 {
 		return fmt.Sprintf("delivery_id [%d] not found", delivery_id)
 	}
-	f := res[0].(map[string]interface{})["delivery_name"].(string)
-	dwfile := file.DwFile{Name: f, Path: "", Size: 0}
+	dwfile := file.DwFile{Name: res[0].DeliveryName, Path: "", Size: 0}
 	log, err := filer.ReadLog(dwfile)
 	if err != nil {
 		return err.Error()
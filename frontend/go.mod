@@ -32,6 +32,14 @@ require (
 	github.com/rubenv/sql-migrate v0.0.0-20190212093014-1007f53448d7 // indirect
 	github.com/ryanuber/columnize v2.1.0+incompatible // indirect
 	github.com/shurcooL/sanitized_anchor_name v1.0.0 // indirect
-	github.com/sorenbak/datawarehouse v0.0.0-20190319145215-78d449678201
+	github.com/sorenbak/datawarehouse/file v0.0.0
+	github.com/sorenbak/datawarehouse/repository v0.0.0
+	github.com/sorenbak/datawarehouse/webapi v0.0.0
 	gopkg.in/gorp.v1 v1.7.2 // indirect
 )
+
+replace (
+	github.com/sorenbak/datawarehouse/file => ../file
+	github.com/sorenbak/datawarehouse/repository => ../repository
+	github.com/sorenbak/datawarehouse/webapi => ../webapi
+)
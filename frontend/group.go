@@ -0,0 +1,508 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/kataras/iris"
+	"github.com/sorenbak/datawarehouse/repository"
+	"github.com/sorenbak/datawarehouse/webapi"
+)
+
+// Accesses that can be granted to a group on an agreement (meta.access)
+var accessNames = []string{"UPLOAD", "VIEW", "APPROVE", "DELETE"}
+
+// RequireGroup only lets members of group (e.g. ADMIN) through - never service accounts
+func RequireGroup(rep repository.Repository, group string) iris.Handler {
+	return func(c iris.Context) {
+		if webapi.ApiKeyId(c) != 0 {
+			// Service accounts are limited to their scopes
+			c.StopExecution()
+			c.StatusCode(403)
+			c.WriteString("Not allowed to service accounts")
+			return
+		}
+		var member []struct {
+			Member int64 `json:"member"`
+		}
+		err := rep.WithContext(c.Request().Context()).QueryStruct(&member, `SELECT meta.in_group($1, $2) AS member`, GetUsername(c), group)
+		if err != nil {
+			log.Printf("Membership of [%s] in [%s] failed: [%s]\n", GetUsername(c), group, err)
+			c.StopExecution()
+			c.StatusCode(500)
+			return
+		}
+		if len(member) == 0 || member[0].Member == 0 {
+			c.StopExecution()
+			c.StatusCode(403)
+			c.WriteString(fmt.Sprintf("Only members of [%s] are allowed", group))
+			return
+		}
+		c.Next()
+	}
+}
+
+var groupListSpec = repository.ListSpec{
+	From:        "meta.[group]",
+	Key:         "id",
+	Sort:        []string{"name", "createdtm"},
+	DefaultSort: "name",
+	Date:        "createdtm",
+}
+
+func GroupList(c iris.Context, rep repository.Repository) {
+	// swagger:operation GET /api/group/list Group GroupList
+	// List groups (ADMIN only)
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: limit
+	//   description: Number of rows per page (default 100 - max 1000)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: offset
+	//   description: Number of rows to skip (default 0)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: sort
+	//   description: Column to sort on - prefix with - for descending (id, name (default), createdtm)
+	//   type: string
+	//   in: query
+	//   required: false
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: object
+	//      title: GroupList
+	//      properties:
+	//        total:
+	//          description: Count of rows
+	//          type: integer
+	//        next:
+	//          description: Link to the next page (empty on last page)
+	//          type: string
+	//        data:
+	//          type: array
+	//          items:
+	//            type: object
+	//            properties:
+	//              id:
+	//                description: ID of group
+	//                type: integer
+	//              name:
+	//                description: Name of group
+	//                type: string
+	//              description:
+	//                description: Description of group
+	//                type: string
+	//              createdtm:
+	//                description: Date of creation
+	//                type: string
+	//   '403':
+	//     description: Not member of ADMIN
+	res, err := listPage(c, rep, groupListSpec)
+	if err != nil {
+		c.StatusCode(500)
+		return
+	}
+	c.JSON(res)
+}
+
+// Group as created by GroupAdd
+type groupDto struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func GroupAdd(c iris.Context, rep repository.Repository) string {
+	// swagger:operation POST /api/group/add Group GroupAdd
+	// Create a group - or update the description of an existing one (ADMIN only)
+	// ---
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: group
+	//   in: body
+	//   required: true
+	//   schema:
+	//     type: object
+	//     title: GroupAdd
+	//     properties:
+	//       name:
+	//         description: Name of group (max 50 characters)
+	//         type: string
+	//       description:
+	//         description: Description of group
+	//         type: string
+	// responses:
+	//   '200':
+	//     description: The group
+	//   '400':
+	//     description: Invalid group
+	//   '403':
+	//     description: Not member of ADMIN
+	var group groupDto
+	if err := c.ReadJSON(&group); err != nil {
+		c.StatusCode(400)
+		return fmt.Sprintf("Invalid group: [%s]", err)
+	}
+	group.Name = strings.TrimSpace(group.Name)
+	if group.Name == "" || len([]rune(group.Name)) > 50 {
+		c.StatusCode(400)
+		return "Name of group is required (max 50 characters)"
+	}
+	if _, err := rep.Exec(`EXEC meta.group_add $1, $2`, group.Name, group.Description); err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	res, err := rep.QueryJson(`SELECT * FROM meta.[group] WHERE name = $1`, 0, group.Name)
+	if err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	return res
+}
+
+func GroupMember(c iris.Context, rep repository.Repository, group_id int64) string {
+	// swagger:operation GET /api/group/member/{group_id} Group GroupMember
+	// Members of a group (ADMIN only)
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: group_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: GroupMember
+	//        properties:
+	//          user_id:
+	//            description: ID of user
+	//            type: integer
+	//          username:
+	//            description: Username of user
+	//            type: string
+	//          realname:
+	//            description: Real name of user
+	//            type: string
+	//          group_id:
+	//            description: ID of group
+	//            type: integer
+	//          group_name:
+	//            description: Name of group
+	//            type: string
+	//          createdtm:
+	//            description: Time of membership
+	//            type: string
+	//   '403':
+	//     description: Not member of ADMIN
+	res, err := rep.QueryJson(`
+    SELECT user_id, username, realname, group_id, group_name, createdtm
+      FROM meta.user_group_v
+     WHERE group_id = $1
+       AND createdtm IS NOT NULL
+     ORDER BY username`, 0, group_id)
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func GroupMemberAdd(c iris.Context, rep repository.Repository, group_id int64, user_id int64) string {
+	// swagger:operation PUT /api/group/member/{group_id}/{user_id} Group GroupMemberAdd
+	// Add a user to a group (ADMIN only) - returns the members of the group
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: group_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: user_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//   '403':
+	//     description: Not member of ADMIN
+	//   '404':
+	//     description: Unknown group or user
+	return groupMemberExec(c, rep, `EXEC meta.user_group_add $1, $2`, group_id, user_id)
+}
+
+func GroupMemberDelete(c iris.Context, rep repository.Repository, group_id int64, user_id int64) string {
+	// swagger:operation DELETE /api/group/member/{group_id}/{user_id} Group GroupMemberDelete
+	// Remove a user from a group (ADMIN only) - returns the members of the group
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: group_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: user_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//   '403':
+	//     description: Not member of ADMIN
+	//   '404':
+	//     description: Unknown group or user
+	return groupMemberExec(c, rep, `EXEC meta.user_group_delete $1, $2`, group_id, user_id)
+}
+
+// Runs the membership proc (user_id, group_id) after checking both exist
+func groupMemberExec(c iris.Context, rep repository.Repository, proc string, group_id int64, user_id int64) string {
+	if msg := groupCheck(rep, group_id, "meta.[user]", user_id); msg != "" {
+		c.StatusCode(404)
+		return msg
+	}
+	if _, err := rep.Exec(proc, user_id, group_id); err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	// Managed groups are synchronized with the token claims again
+	GroupSyncReset()
+	return GroupMember(c, rep, group_id)
+}
+
+func GroupAccess(c iris.Context, rep repository.Repository, group_id int64) string {
+	// swagger:operation GET /api/group/access/{group_id} Group GroupAccess
+	// Accesses of a group to agreements (ADMIN only) - members of ADMIN have all accesses
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: group_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: GroupAccess
+	//        properties:
+	//          group_id:
+	//            description: ID of group
+	//            type: integer
+	//          groupname:
+	//            description: Name of group
+	//            type: string
+	//          agreement_id:
+	//            description: ID of agreement
+	//            type: integer
+	//          agreementname:
+	//            description: Name of agreement
+	//            type: string
+	//          access_id:
+	//            description: ID of access
+	//            type: integer
+	//          accessname:
+	//            description: Access (UPLOAD, VIEW, APPROVE or DELETE)
+	//            type: string
+	//   '403':
+	//     description: Not member of ADMIN
+	res, err := rep.QueryJson(`
+    SELECT *
+      FROM meta.group_access_v
+     WHERE group_id = $1
+     ORDER BY agreementname, access_id`, 0, group_id)
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func GroupAccessAdd(c iris.Context, rep repository.Repository, group_id int64, agreement_id int64, access string) string {
+	// swagger:operation PUT /api/group/access/{group_id}/{agreement_id}/{access} Group GroupAccessAdd
+	// Grant an access on an agreement to a group (ADMIN only) - returns the accesses of the group
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: group_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: agreement_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: access
+	//   description: UPLOAD, VIEW, APPROVE or DELETE
+	//   type: string
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//   '400':
+	//     description: Unknown access
+	//   '403':
+	//     description: Not member of ADMIN
+	//   '404':
+	//     description: Unknown group or agreement
+	return groupAccessExec(c, rep, `meta.group_agreement_add`, group_id, agreement_id, access)
+}
+
+func GroupAccessDelete(c iris.Context, rep repository.Repository, group_id int64, agreement_id int64, access string) string {
+	// swagger:operation DELETE /api/group/access/{group_id}/{agreement_id}/{access} Group GroupAccessDelete
+	// Revoke an access on an agreement from a group (ADMIN only) - returns the accesses of the group
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: group_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: agreement_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: access
+	//   description: UPLOAD, VIEW, APPROVE or DELETE
+	//   type: string
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//   '400':
+	//     description: Unknown access
+	//   '403':
+	//     description: Not member of ADMIN
+	//   '404':
+	//     description: Unknown group or agreement
+	return groupAccessExec(c, rep, `meta.group_agreement_delete`, group_id, agreement_id, access)
+}
+
+// Runs the access proc (group_id, agreement_id, access_id) after checking group, agreement and access
+func groupAccessExec(c iris.Context, rep repository.Repository, proc string, group_id int64, agreement_id int64, access string) string {
+	access = strings.ToUpper(access)
+	known := false
+	for _, name := range accessNames {
+		known = known || name == access
+	}
+	if !known {
+		c.StatusCode(400)
+		return fmt.Sprintf("Unknown access [%s] - use %s", access, strings.Join(accessNames, ", "))
+	}
+	if msg := groupCheck(rep, group_id, "meta.agreement", agreement_id); msg != "" {
+		c.StatusCode(404)
+		return msg
+	}
+	_, err := rep.Exec(`
+    DECLARE @access_id BIGINT = (SELECT id FROM meta.access WHERE name = $3);
+    EXEC `+proc+` $1, $2, @access_id`, group_id, agreement_id, access)
+	if err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	return GroupAccess(c, rep, group_id)
+}
+
+// Returns a message if the group or the row of table (user or agreement) does not exist
+func groupCheck(rep repository.Repository, group_id int64, table string, id int64) string {
+	var found []struct {
+		GroupCount int64 `json:"group_count"`
+		RowCount   int64 `json:"row_count"`
+	}
+	err := rep.QueryStruct(&found, `
+    SELECT (SELECT COUNT(*) FROM meta.[group] WHERE id = $1) AS group_count,
+           (SELECT COUNT(*) FROM `+table+` WHERE id = $2) AS row_count`, group_id, id)
+	switch {
+	case err != nil:
+		return err.Error()
+	case found[0].GroupCount == 0:
+		return fmt.Sprintf("group_id [%d] not found", group_id)
+	case found[0].RowCount == 0:
+		return fmt.Sprintf("%s id [%d] not found", table, id)
+	}
+	return ""
+}
+
+func UserAccess(c iris.Context, rep repository.Repository, user_id int64) string {
+	// swagger:operation GET /api/user/access/{user_id} User UserAccess
+	// Effective accesses of a user to agreements via the group memberships (ADMIN only)
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: user_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: agreement_id
+	//   description: Only accesses to this agreement
+	//   type: integer
+	//   in: query
+	//   required: false
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: UserAccess
+	//        properties:
+	//          agreement_id:
+	//            description: ID of agreement
+	//            type: integer
+	//          agreementname:
+	//            description: Name of agreement
+	//            type: string
+	//          accessname:
+	//            description: Access (UPLOAD, VIEW, APPROVE or DELETE)
+	//            type: string
+	//          groups:
+	//            description: Groups (comma separated) granting the access
+	//            type: string
+	//   '403':
+	//     description: Not member of ADMIN
+	agreement_id, _ := c.URLParamInt64("agreement_id")
+	res, err := rep.QueryJson(`
+    SELECT agreement_id, agreementname, accessname,
+           STUFF((SELECT ', ' + g.groupname
+                    FROM meta.user_access_v g
+                   WHERE g.user_id = v.user_id
+                     AND g.agreement_id = v.agreement_id
+                     AND g.access_id = v.access_id
+                   ORDER BY g.groupname
+                     FOR XML PATH('')), 1, 2, '') AS groups
+      FROM meta.user_access_v v
+     WHERE user_id = $1
+       AND ($2 <= 0 OR agreement_id = $2)
+     GROUP BY user_id, agreement_id, agreementname, access_id, accessname
+     ORDER BY agreementname, access_id`, 0, user_id, agreement_id)
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
@@ -1,28 +1,94 @@
 package main
 
 import (
+	"encoding/json"
 	"reflect"
+	"strconv"
 	"strings"
 
-	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/kataras/iris"
+	"github.com/sorenbak/datawarehouse/repository"
+	"github.com/sorenbak/datawarehouse/webapi"
 )
 
+// Returns the user of the request (see webapi.Identity)
 func GetUsername(c iris.Context) string {
-	token := c.Values().Get("jwt")
-	if token == nil {
-		return ""
-	}
-	return token.(*jwt.Token).Claims.(jwt.MapClaims)["unique_name"].(string)
+	return webapi.Username(c)
 }
 
 // Returns a flattened list of element names of a struct (no nesting)
 // Useful for turning a DTO into a list of query columns in SQL
+// The column of an element is its json tag (as scanned by QueryStruct)
 func struct2query(s interface{}) string {
 	r := reflect.TypeOf(s)
 	var q []string
 	for i := 0; i < r.NumField(); i++ {
-		q = append(q, r.Field(i).Name)
+		name := strings.Split(r.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = r.Field(i).Name
+		}
+		q = append(q, name)
 	}
 	return strings.Join(q, ",")
 }
+
+// Returns DTOs scanned by QueryStruct as JSON (or the error)
+func dtoJson(v interface{}, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	str, err := json.Marshal(v)
+	if err != nil {
+		return err.Error()
+	}
+	return string(str)
+}
+
+// Returns the page of the list described by spec as requested by the query string
+// (limit, offset/page, cursor, sort, from, to and filters) with next as a full link
+func listPage(c iris.Context, rep repository.Repository, spec repository.ListSpec, args ...interface{}) (*repository.ListPage, error) {
+	params, err := repository.ParseList(c.Request().URL.Query(), spec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := rep.List(spec, params, args...)
+	if err != nil {
+		return nil, err
+	}
+	if page.Next != "" {
+		page.Next = c.Path() + "?" + page.Next
+	}
+	return page, nil
+}
+
+// Same as listPage but returning the page as JSON
+func listJson(c iris.Context, rep repository.Repository, spec repository.ListSpec, args ...interface{}) (string, error) {
+	page, err := listPage(c, rep, spec, args...)
+	if err != nil {
+		return "", err
+	}
+	str, err := json.Marshal(page)
+	if err != nil {
+		return "", err
+	}
+	return string(str), nil
+}
+
+// Returns the field terminator of an agreement type as a rune (e.g. ';', '\t' or 0x09)
+// - 0 if none (the default of the writer)
+func delimiter(fieldterminator *string) rune {
+	if fieldterminator == nil || *fieldterminator == "" {
+		return 0
+	}
+	switch t := *fieldterminator; {
+	case t == `\t`:
+		return '\t'
+	case strings.HasPrefix(strings.ToLower(t), "0x"):
+		if n, err := strconv.ParseInt(t[2:], 16, 32); err == nil {
+			return rune(n)
+		}
+		return 0
+	default:
+		return []rune(t)[0]
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kataras/iris"
+	"github.com/sorenbak/datawarehouse/repository"
+)
+
+func Lineage(c iris.Context, rep repository.Repository, agreement_name string, dw_row_id int64) string {
+	// swagger:operation GET /api/lineage/{agreement_name}/{dw_row_id} Lineage Lineage
+	// Lineage of a repo row: originating file and line, raw source, mappings, rules and consumers
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: agreement_name
+	//   type: string
+	//   in: path
+	//   required: true
+	// - name: dw_row_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: object
+	//      title: Lineage
+	//      properties:
+	//        agreement_id:
+	//          description: ID of agreement
+	//          type: integer
+	//        dw_delivery_id:
+	//          description: ID of delivery the row originates from
+	//          type: integer
+	//        dw_row_id:
+	//          description: ID of row in repo
+	//          type: integer
+	//        file_name:
+	//          description: Name of the delivered file
+	//          type: string
+	//        line_no:
+	//          description: Line number of the row in the delivered file (NULL if not kept)
+	//          type: integer
+	//        raw:
+	//          description: Raw (pre-mapping) source line as JSON of the temp columns (NULL if not kept)
+	//          type: string
+	//        mappings:
+	//          description: Mapping expression applied per column (temp to stag)
+	//          type: array
+	//          items:
+	//            type: object
+	//        rules:
+	//          description: Validation rules the row passed
+	//          type: array
+	//          items:
+	//            type: object
+	//        consumers:
+	//          description: Retrievals of the row by consumers (meta.link)
+	//          type: array
+	//          items:
+	//            type: object
+	rows, err := rep.Query(`EXEC meta.get_lineage $1, $2, $3`, 0, GetUsername(c), agreement_name, dw_row_id)
+	if err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	if len(rows) == 0 {
+		return "{}"
+	}
+	lineage := rows[0].(map[string]interface{})
+	agreement_id, delivery_id := fmt.Sprint(lineage["agreement_id"]), fmt.Sprint(lineage["dw_delivery_id"])
+
+	if lineage["mappings"], err = rep.Query(`
+    SELECT s.column_name, s.mapping, s.mapping_type, s.data_type, s.ordinal_position
+      FROM meta.column_mapping_v s
+     WHERE s.agreement_id = $1
+       AND s.table_schema = 'stag'
+       AND s.column_name <> '[dw_delivery_id]'
+     ORDER BY s.ordinal_position`, 0, agreement_id); err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	if lineage["rules"], err = rep.Query(`
+    SELECT rule_id, rule_text
+      FROM meta.agreement_rule_v
+     WHERE agreement_id = $1
+     ORDER BY rule_id`, 0, agreement_id); err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	// Later retrievals of DELTA agreements include the row as long as it is current
+	if lineage["consumers"], err = rep.Query(`
+    SELECT l.id, l.external_id, l.dw_delivery_id, l.user_username, l.user_realname, l.createdtm
+      FROM meta.link_v l
+     WHERE l.status_id = 1
+       AND (l.dw_delivery_id = $2
+            OR (l.agreement_id = $1 AND l.dw_delivery_id > $2 AND meta.get_delta_sql($1, NULL) IS NOT NULL))
+     ORDER BY l.createdtm`, 0, agreement_id, delivery_id); err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	str, err := json.Marshal(lineage)
+	if err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	return string(str)
+}
@@ -28,6 +28,11 @@ package main
 //go:generate rm -rf data
 
 import (
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/sorenbak/datawarehouse/file"
 	"github.com/sorenbak/datawarehouse/repository"
 	"github.com/sorenbak/datawarehouse/webapi"
@@ -44,8 +49,27 @@ func DwApi(db repository.Dber) (app *iris.Application) {
 	app.StaticEmbedded("/swagger", "./data/swagger", Asset, AssetNames)
 	app.StaticEmbedded("/", "./data/wwwroot", Asset, AssetNames)
 
-	// DI common classes
-	hero.Register(repository.New(db))
+	// DI common classes - the repository is bound to the request (cancelled when the client
+	// goes away) and every call is limited to DB_TIMEOUT seconds
+	timeout, _ := strconv.Atoi(envy.Get("DB_TIMEOUT", "30"))
+	rep := repository.New(db).WithTimeout(time.Duration(timeout) * time.Second)
+	hero.Register(func(c iris.Context) repository.Repository {
+		return rep.WithContext(c.Request().Context())
+	})
+	webapi.UseApiKeys(ApiKeyVerify(rep))
+	webapi.UseAudit(AuditRecord(rep))
+	if envy.Get("USERPROVISION", "") == "yes" {
+		api.Use(UserProvision(rep))
+	}
+	if groupmap := envy.Get("GROUPMAP", ""); groupmap != "" {
+		m, err := ParseGroupMap(groupmap)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Synchronize groups %v with token claims\n", m.Managed())
+		syncsecs, _ := strconv.Atoi(envy.Get("GROUPSYNC_SECS", "300"))
+		api.Use(GroupSync(rep, m, strings.Split(envy.Get("GROUPCLAIMS", "groups,roles"), ","), time.Duration(syncsecs)*time.Second))
+	}
 	hero.Register(file.New(envy.Get("INBOX", "./in/"), envy.Get("OUTBOX", "./out/"), envy.Get("BLOB", "")))
 
 	// Agreement
@@ -54,16 +78,63 @@ func DwApi(db repository.Dber) (app *iris.Application) {
 	api.Get("/agreement/{date: string}", hero.Handler(AgreementList))
 	api.Get("/agreement/column/{agreement_id:int64}", hero.Handler(AgreementColumn))
 	api.Get("/agreement/rule/{agreement_id:int64}", hero.Handler(AgreementRule))
+	api.Get("/agreement/lookup/{agreement_id:int64}", hero.Handler(AgreementLookup))
+	api.Get("/agreement/check/{agreement_id:int64}", hero.Handler(AgreementCheck))
 	api.Get("/agreement/trigger/{agreement_id:int64}", hero.Handler(AgreementTrigger))
+	api.Get("/agreement/scd2/{agreement_name:string}", hero.Handler(AgreementScd2))
 	// Delivery
 	api.Get("/delivery/agreement/{agreement_id:int64}", hero.Handler(DeliveryList))
 	api.Get("/delivery/detail/{delivery_id:int64}", hero.Handler(DeliveryDetail))
 	api.Get("/delivery/operation/{delivery_id:int64}", hero.Handler(DeliveryOperation))
-	api.Get("/delivery/download/json/{agreement_name:string}/{delivery_id:int64}}", hero.Handler(DeliveryDownloadJson))
+	api.Get("/delivery/stat/{delivery_id:int64}", hero.Handler(DeliveryStat))
+	api.Get("/delivery/profile/{delivery_id:int64}", hero.Handler(DeliveryProfile))
+	webapi.Sensitive(api.Get("/delivery/download/{format:string}/{agreement_name:string}/{delivery_id:int64}", hero.Handler(DeliveryDownload)))
+	webapi.Sensitive(api.Get("/delivery/download/{agreement_name:string}/{delivery_id:int64}", hero.Handler(DeliveryDownloadNegotiated)))
 	api.Get("/delivery/log/{delivery_id:int64}}", hero.Handler(DeliveryLog))
 	api.Delete("/delivery/delete/{delivery_id:int64}}", hero.Handler(DeliveryDelete))
+	// Consumer
+	api.Get("/consumer/usage", hero.Handler(ConsumerUsage))
+	api.Get("/consumer/activity", RequireGroup(rep, "ADMIN"), hero.Handler(ConsumerActivity))
+	api.Get("/consumer/agreement/{agreement_id:int64}", hero.Handler(ConsumerAgreement))
+	api.Get("/consumer/delivery/{delivery_id:int64}", hero.Handler(ConsumerDelivery))
+	api.Get("/consumer/denied/{agreement_id:int64}", hero.Handler(ConsumerDenied))
+	api.Get("/consumer/unconsumed/{agreement_id:int64}", hero.Handler(ConsumerUnconsumed))
+	// Lineage
+	webapi.Sensitive(api.Get("/lineage/{agreement_name:string}/{dw_row_id:int64}", hero.Handler(Lineage)))
+	// Data
+	webapi.Sensitive(api.Get("/data/{agreement_name:string}", hero.Handler(Data)))
+	webapi.Sensitive(webapi.Safe(api.Post("/query/{agreement_name:string}", hero.Handler(Query))))
+	// OData
+	api.Get("/odata", hero.Handler(OData))
+	webapi.Sensitive(api.Get("/odata/{set:string}", hero.Handler(ODataSet)))
 	// User
 	api.Get("/user/list", hero.Handler(UserList))
+	api.Get("/user/access/{user_id:int64}", RequireGroup(rep, "ADMIN"), hero.Handler(UserAccess))
+	// Group (ADMIN only)
+	group := api.Party("/group", RequireGroup(rep, "ADMIN"))
+	group.Get("/list", hero.Handler(GroupList))
+	group.Post("/add", hero.Handler(GroupAdd))
+	group.Get("/member/{group_id:int64}", hero.Handler(GroupMember))
+	group.Put("/member/{group_id:int64}/{user_id:int64}", hero.Handler(GroupMemberAdd))
+	group.Delete("/member/{group_id:int64}/{user_id:int64}", hero.Handler(GroupMemberDelete))
+	group.Get("/access/{group_id:int64}", hero.Handler(GroupAccess))
+	group.Put("/access/{group_id:int64}/{agreement_id:int64}/{access:string}", hero.Handler(GroupAccessAdd))
+	group.Delete("/access/{group_id:int64}/{agreement_id:int64}/{access:string}", hero.Handler(GroupAccessDelete))
+	// Service account (ADMIN only)
+	account := api.Party("/serviceaccount", RequireGroup(rep, "ADMIN"))
+	account.Get("/list", hero.Handler(ServiceAccountList))
+	account.Post("/add", hero.Handler(ServiceAccountAdd))
+	account.Get("/scope/{user_id:int64}", hero.Handler(ServiceAccountScope))
+	account.Put("/scope/{user_id:int64}/{agreement_id:int64}/{access:string}", hero.Handler(ServiceAccountScopeAdd))
+	account.Delete("/scope/{user_id:int64}/{agreement_id:int64}/{access:string}", hero.Handler(ServiceAccountScopeDelete))
+	account.Get("/key/{user_id:int64}", hero.Handler(ServiceAccountKey))
+	account.Post("/key/{user_id:int64}", hero.Handler(ServiceAccountKeyAdd))
+	account.Post("/key/rotate/{api_key_id:int64}", hero.Handler(ServiceAccountKeyRotate))
+	account.Delete("/key/revoke/{api_key_id:int64}", hero.Handler(ServiceAccountKeyRevoke))
+	// Audit (ADMIN only)
+	audit := api.Party("/audit", RequireGroup(rep, "ADMIN"))
+	webapi.Sensitive(audit.Get("/list", hero.Handler(AuditList)))
+	webapi.Sensitive(audit.Get("/export/{format:string}", hero.Handler(AuditExport)))
 
 	return app
 }
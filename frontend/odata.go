@@ -0,0 +1,428 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gobuffalo/envy"
+	"github.com/kataras/iris"
+	"github.com/sorenbak/datawarehouse/repository"
+)
+
+// OData entity set over a table/view of the meta model. The properties are read from
+// INFORMATION_SCHEMA, so columns added to the views show up by themselves.
+type odataMetaSet struct {
+	Name  string
+	Table string
+	Key   string
+	// Access check - $1 is the user
+	Where string
+}
+
+const odataAgreementAccess = "meta.user_access($1, agreement_id, 'VIEW') > 0"
+
+var odataMetaSets = []odataMetaSet{
+	{"Agreements", "agreement_delivery_count_v", "id", "meta.user_access($1, id, 'VIEW') > 0"},
+	{"Deliveries", "agreement_delivery_max_audit_v", "delivery_id", odataAgreementAccess},
+	{"Audits", "audit", "id", "EXISTS (SELECT 1 FROM meta.delivery d WHERE d.id = delivery_id AND meta.user_access($1, d.agreement_id, 'VIEW') > 0)"},
+	{"Operations", "delivery_id_audit_operation_v", "operation_id", odataAgreementAccess},
+	{"Rules", "agreement_rule", "id", odataAgreementAccess},
+	{"Users", "user_v", "id", ""},
+	{"Groups", "group", "id", "meta.in_group($1, 'ADMIN') = 1"},
+}
+
+// Published (repo) data of an agreement - as returned by /data (see queryData) - is the
+// entity set Data_<agreement name> with characters not allowed in OData names replaced by _.
+// Retrievals are logged as links (see queryLink).
+const odataDataPrefix = "Data_"
+
+var odataInvalid = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func odataDataSet(agreement_name string) string {
+	return odataDataPrefix + odataInvalid.ReplaceAllString(agreement_name, "_")
+}
+
+// Column of an entity set (agreement_name/table_name identify the set)
+type odataColumnDto struct {
+	AgreementName string `json:"agreement_name"`
+	TableName     string `json:"table_name"`
+	ColumnName    string `json:"column_name"`
+	DataType      string `json:"data_type"`
+}
+
+// odataEntity is an entity set with its select source - and agreement of Data_ sets
+type odataEntity struct {
+	Name      string
+	Agreement string
+	Source    repository.SelectSource
+	Args      []interface{}
+	Columns   []odataColumnDto
+}
+
+// odataEntities reads the entity sets visible to the user - only the one named if name is not empty
+func odataEntities(rep repository.Repository, user string, name string) (entities []*odataEntity, err error) {
+	var tables []string
+	for _, m := range odataMetaSets {
+		if name == "" || name == m.Name {
+			tables = append(tables, m.Table)
+		}
+	}
+	if len(tables) > 0 {
+		var columns []odataColumnDto
+		args := []interface{}{}
+		var in []string
+		for _, t := range tables {
+			args = append(args, t)
+			in = append(in, "$"+strconv.Itoa(len(args)))
+		}
+		err = rep.QueryStruct(&columns, fmt.Sprintf(`
+    SELECT table_name,
+           column_name,
+           data_type
+      FROM INFORMATION_SCHEMA.COLUMNS
+     WHERE table_schema = 'meta'
+       AND table_name IN (%s)
+     ORDER BY ordinal_position`, strings.Join(in, ", ")), args...)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range odataMetaSets {
+			if name != "" && name != m.Name {
+				continue
+			}
+			e := &odataEntity{Name: m.Name, Source: repository.SelectSource{From: fmt.Sprintf("meta.[%s]", m.Table), Where: m.Where, Key: m.Key}}
+			if m.Where != "" {
+				e.Args = []interface{}{user}
+			}
+			for _, col := range columns {
+				if col.TableName == m.Table {
+					e.add(col)
+				}
+			}
+			entities = append(entities, e)
+		}
+	}
+	if name != "" && !strings.HasPrefix(name, odataDataPrefix) {
+		return entities, nil
+	}
+
+	// Published data of the agreements as described by the column mapping
+	var columns []odataColumnDto
+	err = rep.QueryStruct(&columns, `
+    SELECT a.name AS agreement_name,
+           m.table_name,
+           REPLACE(REPLACE(m.column_name, '[', ''), ']', '') AS column_name,
+           m.data_type
+      FROM meta.agreement a,
+           meta.column_mapping_v m
+     WHERE m.agreement_id = a.id
+       AND m.table_schema = 'repo'
+       AND meta.user_access($1, a.id, 'VIEW') > 0
+     ORDER BY a.name, m.ordinal_position`, user)
+	if err != nil {
+		return nil, err
+	}
+	sets := make(map[string]*odataEntity)
+	for _, col := range columns {
+		set := odataDataSet(col.AgreementName)
+		if name != "" && name != set {
+			continue
+		}
+		e, ok := sets[set]
+		if !ok {
+			// The delivery (and its repo table) is resolved by ODataSet
+			e = &odataEntity{Name: set, Agreement: col.AgreementName, Source: repository.SelectSource{Key: "dw_row_id"}}
+			sets[set] = e
+			entities = append(entities, e)
+		}
+		if len(e.Columns) > 0 && e.Columns[0].AgreementName != col.AgreementName {
+			continue // Names clashing after replacing characters - the first agreement wins
+		}
+		e.add(col)
+	}
+	return entities, nil
+}
+
+func (e *odataEntity) add(col odataColumnDto) {
+	e.Source.Columns = append(e.Source.Columns, col.ColumnName)
+	e.Columns = append(e.Columns, col)
+}
+
+// EDM type of a SQL Server data type
+func odataType(data_type string) string {
+	switch strings.ToLower(data_type) {
+	case "bigint":
+		return "Edm.Int64"
+	case "int":
+		return "Edm.Int32"
+	case "smallint":
+		return "Edm.Int16"
+	case "tinyint":
+		return "Edm.Byte"
+	case "bit":
+		return "Edm.Boolean"
+	case "decimal", "numeric", "money", "smallmoney":
+		return "Edm.Decimal"
+	case "float":
+		return "Edm.Double"
+	case "real":
+		return "Edm.Single"
+	case "date", "datetime", "datetime2", "smalldatetime", "datetimeoffset":
+		return "Edm.DateTimeOffset"
+	case "uniqueidentifier":
+		return "Edm.Guid"
+	default:
+		return "Edm.String"
+	}
+}
+
+// CSDL (XML) of the service
+type odataEdmx struct {
+	XMLName xml.Name    `xml:"edmx:Edmx"`
+	Version string      `xml:"Version,attr"`
+	Ns      string      `xml:"xmlns:edmx,attr"`
+	Schema  odataSchema `xml:"edmx:DataServices>Schema"`
+}
+
+type odataSchema struct {
+	Ns          string            `xml:"xmlns,attr"`
+	Namespace   string            `xml:"Namespace,attr"`
+	EntityTypes []odataEntityType `xml:"EntityType"`
+	Container   struct {
+		Name string           `xml:"Name,attr"`
+		Sets []odataEntitySet `xml:"EntitySet"`
+	} `xml:"EntityContainer"`
+}
+
+type odataEntityType struct {
+	Name       string          `xml:"Name,attr"`
+	Key        odataRef        `xml:"Key>PropertyRef"`
+	Properties []odataProperty `xml:"Property"`
+}
+
+type odataRef struct {
+	Name string `xml:"Name,attr"`
+}
+
+type odataProperty struct {
+	Name     string `xml:"Name,attr"`
+	Type     string `xml:"Type,attr"`
+	Nullable string `xml:"Nullable,attr,omitempty"`
+}
+
+type odataEntitySet struct {
+	Name       string `xml:"Name,attr"`
+	EntityType string `xml:"EntityType,attr"`
+}
+
+// odataRoot is the URL of the service
+func odataRoot(c iris.Context) string {
+	scheme := "http"
+	if c.Request().TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	path := c.Path()
+	return scheme + "://" + c.Request().Host + path[:strings.Index(path, "/odata")+len("/odata")]
+}
+
+// odataError writes an error in the OData JSON format
+func odataError(c iris.Context, status int, err error) {
+	c.StatusCode(status)
+	c.JSON(map[string]interface{}{"error": map[string]string{"code": strconv.Itoa(status), "message": err.Error()}})
+}
+
+func OData(c iris.Context, rep repository.Repository) {
+	// swagger:operation GET /api/odata OData OData
+	// OData (v4) service document listing the entity sets: Agreements, Deliveries, Audits,
+	// Operations, Rules, Users, Groups and the published data of each agreement (Data_<agreement>)
+	// ---
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     description: OK
+	entities, err := odataEntities(rep, GetUsername(c), "")
+	if err != nil {
+		odataError(c, 500, err)
+		return
+	}
+	var sets []map[string]string
+	for _, e := range entities {
+		sets = append(sets, map[string]string{"name": e.Name, "kind": "EntitySet", "url": e.Name})
+	}
+	c.Header("OData-Version", "4.0")
+	c.JSON(map[string]interface{}{"@odata.context": odataRoot(c) + "/$metadata", "value": sets})
+}
+
+func ODataSet(c iris.Context, rep repository.Repository, set string) {
+	// swagger:operation GET /api/odata/{set} OData ODataSet
+	// OData (v4) entity set (or $metadata for the CSDL of the service). Supports $select,
+	// $filter (eq, ne, lt, le, gt, ge, in, contains, startswith, endswith, and, or, not),
+	// $orderby, $top and $skip. Pages of up to 1000 entities link to the next page by
+	// @odata.nextLink. Data_ sets hold the data of the latest delivery of the agreement (or
+	// as of asof or delivery_id) as returned by /data.
+	// ---
+	// produces:
+	// - application/json
+	// - application/xml
+	// parameters:
+	// - name: set
+	//   description: Name of entity set or $metadata
+	//   type: string
+	//   in: path
+	//   required: true
+	// - name: $select
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: $filter
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: $orderby
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: $top
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: $skip
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: asof
+	//   description: Date/time (YYYY-MM-DD[ HH:MI:SS]) of latest delivery up until - Data_ sets only
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: delivery_id
+	//   description: ID of delivery (overrides asof) - Data_ sets only
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: external_id
+	//   description: ID of external data item (e.g. in AAC) logged with the retrieval - Data_ sets only
+	//   type: integer
+	//   in: query
+	//   required: false
+	// responses:
+	//   '200':
+	//     description: OK
+	//   '400':
+	//     description: Invalid query option
+	//   '404':
+	//     description: Unknown entity set or no such delivery
+	c.Header("OData-Version", "4.0")
+	if set == "$metadata" {
+		odataMetadata(c, rep)
+		return
+	}
+	spec, err := repository.ParseOData(c.Request().URL.Query())
+	if err != nil {
+		odataError(c, 400, err)
+		return
+	}
+	entities, err := odataEntities(rep, GetUsername(c), set)
+	if err != nil {
+		odataError(c, 500, err)
+		return
+	}
+	if len(entities) == 0 {
+		odataError(c, 404, fmt.Errorf("entity set [%s] does not exist", set))
+		return
+	}
+	e := entities[0]
+
+	// Data_ sets return the delivery as /data does
+	var data *queryDataSource
+	var params queryDataParams
+	if e.Agreement != "" {
+		if params, err = parseDataParams(c); err != nil {
+			odataError(c, 400, err)
+			return
+		}
+		if data, err = queryData(rep, GetUsername(c), e.Agreement, params); err != nil {
+			odataError(c, 500, err)
+			return
+		}
+		if data == nil {
+			odataError(c, 404, fmt.Errorf("no delivery of agreement [%s] as requested (asof, delivery_id or within MAX_AGE_DAYS)", e.Agreement))
+			return
+		}
+		data.Source.Columns = e.Source.Columns
+		e.Source, e.Args = data.Source, data.Args
+	}
+
+	// Server driven paging: $top is served in pages
+	top := spec.Limit
+	if top == 0 || top > repository.SelectLimit {
+		spec.Limit = repository.SelectLimit
+	}
+	if _, _, err := e.Source.Query(spec, e.Args...); err != nil {
+		odataError(c, 400, err)
+		return
+	}
+	if data != nil {
+		if err := queryLink(rep, GetUsername(c), data, params.ExternalId); err != nil {
+			odataError(c, 500, err)
+			return
+		}
+	}
+	timeout, _ := strconv.Atoi(envy.Get("QUERY_TIMEOUT", "10"))
+	page, err := rep.WithTimeout(time.Duration(timeout)*time.Second).Select(e.Source, spec, e.Args...)
+	if err != nil {
+		odataError(c, 500, err)
+		return
+	}
+
+	res := map[string]interface{}{"@odata.context": odataRoot(c) + "/$metadata#" + e.Name, "value": page.Data}
+	if page.More && (top == 0 || top > len(page.Data)) {
+		next := c.Request().URL.Query()
+		next.Set("$skip", strconv.Itoa(spec.Offset+len(page.Data)))
+		if top > 0 {
+			next.Set("$top", strconv.Itoa(top-len(page.Data)))
+		}
+		res["@odata.nextLink"] = odataRoot(c) + "/" + url.PathEscape(e.Name) + "?" + next.Encode()
+	}
+	c.JSON(res)
+}
+
+// odataMetadata writes the CSDL of the service
+func odataMetadata(c iris.Context, rep repository.Repository) {
+	entities, err := odataEntities(rep, GetUsername(c), "")
+	if err != nil {
+		odataError(c, 500, err)
+		return
+	}
+	edmx := odataEdmx{Version: "4.0", Ns: "http://docs.oasis-open.org/odata/ns/edmx"}
+	edmx.Schema.Ns, edmx.Schema.Namespace = "http://docs.oasis-open.org/odata/ns/edm", "Dw"
+	edmx.Schema.Container.Name = "Container"
+	for _, e := range entities {
+		t := odataEntityType{Name: e.Name, Key: odataRef{e.Source.Key}}
+		for _, col := range e.Columns {
+			p := odataProperty{Name: col.ColumnName, Type: odataType(col.DataType)}
+			if col.ColumnName == e.Source.Key {
+				p.Nullable = "false"
+			}
+			t.Properties = append(t.Properties, p)
+		}
+		edmx.Schema.EntityTypes = append(edmx.Schema.EntityTypes, t)
+		edmx.Schema.Container.Sets = append(edmx.Schema.Container.Sets, odataEntitySet{e.Name, "Dw." + e.Name})
+	}
+	b, err := xml.Marshal(edmx)
+	if err != nil {
+		odataError(c, 500, err)
+		return
+	}
+	c.ContentType("application/xml")
+	c.Write(append([]byte(xml.Header), b...))
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gobuffalo/envy"
+	"github.com/kataras/iris"
+	"github.com/sorenbak/datawarehouse/repository"
+)
+
+// Repo column of an agreement
+type queryColumnDto struct {
+	ColumnName string `json:"column_name"`
+}
+
+// Delivery of an agreement as retrieved by get_data: its repo table (partition) and - for
+// DELTA agreements - the FROM clause of the current state as of the delivery
+type queryDataDto struct {
+	AgreementId int64   `json:"agreement_id"`
+	DeliveryId  int64   `json:"delivery_id"`
+	TableName   string  `json:"table_name"`
+	Delta       *string `json:"delta"`
+}
+
+// Which data of an agreement is read - as get_data (asof or delivery_id) and logged with
+// external_id
+type queryDataParams struct {
+	Asof       interface{} // NULL => now
+	DeliveryId int64       // 0 => as of Asof
+	ExternalId int64
+}
+
+// parseDataParams reads the asof, delivery_id and external_id parameters of a data query
+func parseDataParams(c iris.Context) (p queryDataParams, err error) {
+	if s := c.URLParam("asof"); s != "" {
+		if err = checkAsof(s); err != nil {
+			return p, err
+		}
+		p.Asof = s
+	}
+	for name, dest := range map[string]*int64{"delivery_id": &p.DeliveryId, "external_id": &p.ExternalId} {
+		if s := c.URLParam(name); s != "" {
+			if *dest, err = strconv.ParseInt(s, 10, 64); err != nil {
+				return p, fmt.Errorf("%s [%s] is not an integer", name, s)
+			}
+		}
+	}
+	return p, nil
+}
+
+// Select source (and its arguments) of the data of a delivery
+type queryDataSource struct {
+	queryDataDto
+	Source repository.SelectSource
+	Args   []interface{}
+}
+
+// queryData returns the data of the agreement as get_data returns it - the delivery within
+// its repo table (partition) or the current state of DELTA agreements as of the delivery.
+// nil is returned if the user has no such delivery.
+func queryData(rep repository.Repository, username string, agreement_name string, p queryDataParams) (*queryDataSource, error) {
+	var res []queryDataDto
+	err := rep.QueryStruct(&res, `
+    SELECT a.id AS agreement_id,
+           d.id AS delivery_id,
+           t.name AS table_name,
+           meta.get_delta_sql(a.id, d.id) AS delta
+      FROM meta.agreement a
+           INNER JOIN
+           meta.delivery d ON (d.agreement_id = a.id)
+           INNER JOIN
+           meta.audit u ON (u.delivery_id = d.id AND u.stage_id = 3)
+           INNER JOIN
+           meta.[table] t ON (t.id = u.table_id)
+     WHERE a.name = $1
+       AND d.id = COALESCE(NULLIF($2, 0), meta.get_data_delivery_id(a.id, CONVERT(DATETIME, $3, 120)))
+       AND meta.user_access($4, a.id, 'VIEW') > 0`, agreement_name, p.DeliveryId, p.Asof, username)
+	if err != nil || len(res) == 0 {
+		return nil, err
+	}
+	data := &queryDataSource{queryDataDto: res[0]}
+	if data.Delta != nil {
+		data.Source = repository.SelectSource{From: "(SELECT x.* FROM " + *data.Delta + ") s", Key: "dw_row_id"}
+	} else {
+		data.Source = repository.SelectSource{From: fmt.Sprintf("[repo].[%s]", data.TableName), Where: "dw_delivery_id = $1", Key: "dw_row_id"}
+		data.Args = []interface{}{data.DeliveryId}
+	}
+	return data, nil
+}
+
+// queryLink records the retrieval of the data of a delivery as a link (like get_data)
+func queryLink(rep repository.Repository, username string, data *queryDataSource, external_id int64) error {
+	_, err := rep.Exec(`
+    INSERT INTO meta.link
+           (external_id, dw_delivery_id, user_id, status_id, agreement_id)
+    SELECT $1, $2, meta.user_access($3, $4, 'VIEW'), 1, $4`, external_id, data.DeliveryId, username, data.AgreementId)
+	return err
+}
+
+func Query(c iris.Context, rep repository.Repository, agreement_name string) {
+	// swagger:operation POST /api/query/{agreement_name} Query Query
+	// Ad-hoc read-only query of the published (repo) data of an agreement as returned by
+	// /data - the latest delivery (or as of asof or delivery_id), for DELTA agreements the
+	// current state as of the delivery. Columns of the repo table (incl dw_delivery_id and
+	// dw_row_id) may be selected, filtered, grouped and sorted on. The retrieval is logged as a
+	// link.
+	// Filter operators are eq, ne, lt, le, gt, ge, like, in (array), between (array of two) and
+	// null (true/false) combined by and, or and not. Aggregate functions are count,
+	// count_distinct, sum, avg, min and max. At most 10000 rows are returned (default 1000) and
+	// the query is cancelled after QUERY_TIMEOUT seconds.
+	// ---
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: agreement_name
+	//   type: string
+	//   in: path
+	//   required: true
+	// - name: asof
+	//   description: Date/time (YYYY-MM-DD[ HH:MI:SS]) of latest delivery up until - default now
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: delivery_id
+	//   description: ID of delivery (overrides asof)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: external_id
+	//   description: ID of external data item (e.g. in AAC) logged with the retrieval
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: query
+	//   in: body
+	//   required: true
+	//   schema:
+	//     type: object
+	//     title: SelectSpec
+	//     example:
+	//       select: [customer, amount]
+	//       where:
+	//         and:
+	//         - column: customer
+	//           op: eq
+	//           value: 42
+	//         - column: amount
+	//           op: ge
+	//           value: 1000
+	//       order_by: [-amount]
+	//       limit: 100
+	//     properties:
+	//       select:
+	//         description: Columns returned (default all) - must be grouped on when aggregating
+	//         type: array
+	//         items:
+	//           type: string
+	//       where:
+	//         description: Filter - {and|or:[filters]}, {not:filter} or {column, op, value}
+	//         type: object
+	//       group_by:
+	//         description: Columns grouped on
+	//         type: array
+	//         items:
+	//           type: string
+	//       aggregate:
+	//         description: Aggregates - {func, column, as}
+	//         type: array
+	//         items:
+	//           type: object
+	//       order_by:
+	//         description: Columns (or aggregate names) sorted on - prefixed by - for descending
+	//         type: array
+	//         items:
+	//           type: string
+	//       limit:
+	//         type: integer
+	//       offset:
+	//         type: integer
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//       type: object
+	//       title: SelectPage
+	//       properties:
+	//         data:
+	//           type: array
+	//           items:
+	//             type: object
+	//         limit:
+	//           type: integer
+	//         offset:
+	//           type: integer
+	//         more:
+	//           description: More rows follow (query again with offset + limit)
+	//           type: boolean
+	//   '400':
+	//     description: Invalid query
+	//   '404':
+	//     description: Unknown agreement (or no VIEW permission) or no such delivery
+	var spec repository.SelectSpec
+	if err := c.ReadJSON(&spec); err != nil {
+		c.StatusCode(400)
+		c.WriteString(fmt.Sprintf("Invalid query: [%s]", err))
+		return
+	}
+
+	params, err := parseDataParams(c)
+	if err != nil {
+		c.StatusCode(400)
+		c.WriteString(err.Error())
+		return
+	}
+
+	var columns []queryColumnDto
+	err = rep.QueryStruct(&columns, `
+    SELECT REPLACE(REPLACE(m.column_name, '[', ''), ']', '') AS column_name
+      FROM meta.agreement a,
+           meta.column_mapping_v m
+     WHERE a.name = $1
+       AND m.agreement_id = a.id
+       AND m.table_schema = 'repo'
+       AND meta.user_access($2, a.id, 'VIEW') > 0
+     ORDER BY m.ordinal_position`, agreement_name, GetUsername(c))
+	if err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	if len(columns) == 0 {
+		c.StatusCode(404)
+		c.WriteString(fmt.Sprintf("Agreement [%s] has no published data", agreement_name))
+		return
+	}
+	data, err := queryData(rep, GetUsername(c), agreement_name, params)
+	if err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	if data == nil {
+		c.StatusCode(404)
+		c.WriteString(fmt.Sprintf("No delivery of agreement [%s] as requested (asof, delivery_id or within MAX_AGE_DAYS)", agreement_name))
+		return
+	}
+	for _, col := range columns {
+		data.Source.Columns = append(data.Source.Columns, col.ColumnName)
+	}
+	if _, _, err := data.Source.Query(spec, data.Args...); err != nil {
+		c.StatusCode(400)
+		c.WriteString(err.Error())
+		return
+	}
+	if err := queryLink(rep, GetUsername(c), data, params.ExternalId); err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	c.Header("X-Delivery-Id", strconv.FormatInt(data.DeliveryId, 10))
+
+	timeout, _ := strconv.Atoi(envy.Get("QUERY_TIMEOUT", "10"))
+	page, err := rep.WithTimeout(time.Duration(timeout)*time.Second).Select(data.Source, spec, data.Args...)
+	if err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	c.JSON(page)
+}
@@ -0,0 +1,582 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/envy"
+	"github.com/kataras/iris"
+	"github.com/sorenbak/datawarehouse/repository"
+	"github.com/sorenbak/datawarehouse/webapi"
+)
+
+// ApiKeyVerify returns the verifier of API keys (see webapi.UseApiKeys) recording every use
+// in meta.usage_log
+func ApiKeyVerify(rep repository.Repository) webapi.ApiKeyVerifier {
+	return func(c iris.Context, prefix string, hash string) (string, int64, error) {
+		var keys []struct {
+			ApiKeyId int64  `json:"api_key_id"`
+			Username string `json:"username"`
+		}
+		err := rep.WithContext(c.Request().Context()).QueryStruct(&keys, `EXEC meta.api_key_use $1, $2, $3, $4`,
+			prefix, hash, c.Path(), c.Request().URL.RawQuery)
+		if err != nil || len(keys) == 0 {
+			return "", 0, err
+		}
+		return keys[0].Username, keys[0].ApiKeyId, nil
+	}
+}
+
+// Service account as created by ServiceAccountAdd
+type serviceAccountDto struct {
+	Username    string `json:"username"`
+	Realname    string `json:"realname"`
+	Description string `json:"description"`
+}
+
+// API key as returned once when created
+type apiKeyDto struct {
+	Id        int64  `json:"id"`
+	UserId    int64  `json:"user_id"`
+	Prefix    string `json:"prefix"`
+	Expiredtm string `json:"expiredtm"`
+	Key       string `json:"key"`
+}
+
+func ServiceAccountList(c iris.Context, rep repository.Repository) string {
+	// swagger:operation GET /api/serviceaccount/list ServiceAccount ServiceAccountList
+	// List service accounts (ADMIN only)
+	// ---
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: ServiceAccount
+	//        properties:
+	//          id:
+	//            description: ID of user
+	//            type: integer
+	//          username:
+	//            description: Username of service account
+	//            type: string
+	//          realname:
+	//            description: Real name of service account
+	//            type: string
+	//          description:
+	//            description: Description of service account
+	//            type: string
+	//          createdtm:
+	//            description: Date of creation
+	//            type: string
+	//          active_keys:
+	//            description: Count of API keys not expired
+	//            type: integer
+	//          lastuseddtm:
+	//            description: Time of latest use of any key
+	//            type: string
+	//   '403':
+	//     description: Not member of ADMIN
+	res, err := rep.QueryJson(`SELECT * FROM meta.service_account_v ORDER BY username`, 0)
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func ServiceAccountAdd(c iris.Context, rep repository.Repository) string {
+	// swagger:operation POST /api/serviceaccount/add ServiceAccount ServiceAccountAdd
+	// Create a service account - or make an existing user one (ADMIN only). Service accounts get
+	// access via groups like other users, but only within their scopes
+	// ---
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: account
+	//   in: body
+	//   required: true
+	//   schema:
+	//     type: object
+	//     title: ServiceAccountAdd
+	//     properties:
+	//       username:
+	//         description: Username of service account (max 50 characters)
+	//         type: string
+	//       realname:
+	//         type: string
+	//       description:
+	//         type: string
+	// responses:
+	//   '200':
+	//     description: The service account
+	//   '400':
+	//     description: Invalid service account
+	//   '403':
+	//     description: Not member of ADMIN
+	var account serviceAccountDto
+	if err := c.ReadJSON(&account); err != nil {
+		c.StatusCode(400)
+		return fmt.Sprintf("Invalid service account: [%s]", err)
+	}
+	account.Username = strings.TrimSpace(account.Username)
+	if account.Username == "" || len([]rune(account.Username)) > 50 {
+		c.StatusCode(400)
+		return "Username of service account is required (max 50 characters)"
+	}
+	_, err := rep.Exec(`
+    DECLARE @user_id BIGINT;
+    EXEC meta.service_account_add $1, $2, $3, @user_id OUT`, account.Username, account.Realname, account.Description)
+	if err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	res, err := rep.QueryJson(`SELECT * FROM meta.service_account_v WHERE username = $1`, 0, account.Username)
+	if err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	return res
+}
+
+func ServiceAccountScope(c iris.Context, rep repository.Repository, user_id int64) string {
+	// swagger:operation GET /api/serviceaccount/scope/{user_id} ServiceAccount ServiceAccountScope
+	// Scopes of a service account (ADMIN only) - agreement_id NULL means all agreements
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: user_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: ServiceAccountScope
+	//        properties:
+	//          agreement_id:
+	//            description: ID of agreement (NULL means all)
+	//            type: integer
+	//          agreementname:
+	//            description: Name of agreement
+	//            type: string
+	//          accessname:
+	//            description: Access (UPLOAD, VIEW, APPROVE or DELETE)
+	//            type: string
+	//          createdtm:
+	//            description: Time of creation
+	//            type: string
+	//   '403':
+	//     description: Not member of ADMIN
+	res, err := rep.QueryJson(`
+    SELECT agreement_id, agreementname, accessname, createdtm
+      FROM meta.service_account_scope_v
+     WHERE user_id = $1
+     ORDER BY agreementname, access_id`, 0, user_id)
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func ServiceAccountScopeAdd(c iris.Context, rep repository.Repository, user_id int64, agreement_id int64, access string) string {
+	// swagger:operation PUT /api/serviceaccount/scope/{user_id}/{agreement_id}/{access} ServiceAccount ServiceAccountScopeAdd
+	// Allow an access on an agreement (0 for all) to a service account (ADMIN only) - returns the scopes
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: user_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: agreement_id
+	//   description: ID of agreement - 0 for all agreements
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: access
+	//   description: UPLOAD, VIEW, APPROVE or DELETE
+	//   type: string
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//   '400':
+	//     description: Unknown access
+	//   '403':
+	//     description: Not member of ADMIN
+	//   '404':
+	//     description: Unknown service account or agreement
+	return serviceAccountScopeExec(c, rep, `meta.service_account_scope_add`, user_id, agreement_id, access)
+}
+
+func ServiceAccountScopeDelete(c iris.Context, rep repository.Repository, user_id int64, agreement_id int64, access string) string {
+	// swagger:operation DELETE /api/serviceaccount/scope/{user_id}/{agreement_id}/{access} ServiceAccount ServiceAccountScopeDelete
+	// Remove an access on an agreement (0 for all) from a service account (ADMIN only) - returns the scopes
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: user_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: agreement_id
+	//   description: ID of agreement - 0 for all agreements
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: access
+	//   description: UPLOAD, VIEW, APPROVE or DELETE
+	//   type: string
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//   '400':
+	//     description: Unknown access
+	//   '403':
+	//     description: Not member of ADMIN
+	//   '404':
+	//     description: Unknown service account or agreement
+	return serviceAccountScopeExec(c, rep, `meta.service_account_scope_delete`, user_id, agreement_id, access)
+}
+
+// Runs the scope proc (user_id, agreement_id, accessname) after checking account, agreement and access
+func serviceAccountScopeExec(c iris.Context, rep repository.Repository, proc string, user_id int64, agreement_id int64, access string) string {
+	access = strings.ToUpper(access)
+	known := false
+	for _, name := range accessNames {
+		known = known || name == access
+	}
+	if !known {
+		c.StatusCode(400)
+		return fmt.Sprintf("Unknown access [%s] - use %s", access, strings.Join(accessNames, ", "))
+	}
+	if msg := serviceAccountCheck(rep, user_id); msg != "" {
+		c.StatusCode(404)
+		return msg
+	}
+	var agreement interface{}
+	if agreement_id != 0 {
+		var found []struct {
+			Id int64 `json:"id"`
+		}
+		if err := rep.QueryStruct(&found, `SELECT id FROM meta.agreement WHERE id = $1`, agreement_id); err != nil {
+			c.StatusCode(500)
+			return err.Error()
+		}
+		if len(found) == 0 {
+			c.StatusCode(404)
+			return fmt.Sprintf("agreement_id [%d] not found", agreement_id)
+		}
+		agreement = agreement_id
+	}
+	if _, err := rep.Exec(`EXEC `+proc+` $1, $2, $3`, user_id, agreement, access); err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	return ServiceAccountScope(c, rep, user_id)
+}
+
+// Returns a message if the user is not a service account
+func serviceAccountCheck(rep repository.Repository, user_id int64) string {
+	var found []struct {
+		UserId int64 `json:"user_id"`
+	}
+	if err := rep.QueryStruct(&found, `SELECT user_id FROM meta.service_account WHERE user_id = $1`, user_id); err != nil {
+		return err.Error()
+	}
+	if len(found) == 0 {
+		return fmt.Sprintf("user_id [%d] is not a service account", user_id)
+	}
+	return ""
+}
+
+func ServiceAccountKey(c iris.Context, rep repository.Repository, user_id int64) string {
+	// swagger:operation GET /api/serviceaccount/key/{user_id} ServiceAccount ServiceAccountKey
+	// API keys of a service account (ADMIN only) - the keys themselves are never returned
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: user_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//      type: array
+	//      items:
+	//        type: object
+	//        title: ApiKey
+	//        properties:
+	//          id:
+	//            description: ID of key
+	//            type: integer
+	//          prefix:
+	//            description: Prefix identifying the key (dw_<prefix>_...)
+	//            type: string
+	//          expiredtm:
+	//            description: Time the key expires
+	//            type: string
+	//          active:
+	//            description: 1 if not expired
+	//            type: integer
+	//          lastuseddtm:
+	//            description: Time of latest use
+	//            type: string
+	//          createdtm:
+	//            description: Time of creation
+	//            type: string
+	//   '403':
+	//     description: Not member of ADMIN
+	res, err := rep.QueryJson(`
+    SELECT id, prefix, expiredtm, active, lastuseddtm, createdtm
+      FROM meta.api_key_v
+     WHERE user_id = $1
+     ORDER BY createdtm DESC`, 0, user_id)
+	if err != nil {
+		return err.Error()
+	}
+	return res
+}
+
+func ServiceAccountKeyAdd(c iris.Context, rep repository.Repository, user_id int64) {
+	// swagger:operation POST /api/serviceaccount/key/{user_id} ServiceAccount ServiceAccountKeyAdd
+	// Create an API key of a service account (ADMIN only). The key is only returned here - it is
+	// passed in the X-API-Key header (or Authorization: ApiKey <key>) of requests
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: user_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: days
+	//   description: Days until the key expires (default APIKEY_DAYS - max APIKEY_MAX_DAYS)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// responses:
+	//   '200':
+	//     description: OK
+	//     schema:
+	//       type: object
+	//       title: ApiKeyAdd
+	//       properties:
+	//         id:
+	//           description: ID of key
+	//           type: integer
+	//         user_id:
+	//           description: ID of service account
+	//           type: integer
+	//         prefix:
+	//           description: Prefix identifying the key
+	//           type: string
+	//         expiredtm:
+	//           description: Time the key expires
+	//           type: string
+	//         key:
+	//           description: The key (not shown again)
+	//           type: string
+	//   '400':
+	//     description: Invalid days
+	//   '403':
+	//     description: Not member of ADMIN
+	//   '404':
+	//     description: Not a service account
+	if msg := serviceAccountCheck(rep, user_id); msg != "" {
+		c.StatusCode(404)
+		c.WriteString(msg)
+		return
+	}
+	days, err := apiKeyDays(c)
+	if err != nil {
+		c.StatusCode(400)
+		c.WriteString(err.Error())
+		return
+	}
+	key, err := apiKeyAdd(rep, user_id, days)
+	if err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	c.JSON(key)
+}
+
+func ServiceAccountKeyRotate(c iris.Context, rep repository.Repository, api_key_id int64) {
+	// swagger:operation POST /api/serviceaccount/key/rotate/{api_key_id} ServiceAccount ServiceAccountKeyRotate
+	// Replace an API key by a new key of the same service account (ADMIN only). The old key
+	// keeps working for the grace period, so consumers can switch
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: api_key_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// - name: days
+	//   description: Days until the new key expires (default APIKEY_DAYS - max APIKEY_MAX_DAYS)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: grace
+	//   description: Hours the old key keeps working (default 24)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// responses:
+	//   '200':
+	//     description: The new key (see ServiceAccountKeyAdd)
+	//   '400':
+	//     description: Invalid days or grace
+	//   '403':
+	//     description: Not member of ADMIN
+	//   '404':
+	//     description: Unknown or expired key
+	var old []struct {
+		UserId int64 `json:"user_id"`
+	}
+	if err := rep.QueryStruct(&old, `SELECT user_id FROM meta.api_key_v WHERE id = $1 AND active = 1`, api_key_id); err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	if len(old) == 0 {
+		c.StatusCode(404)
+		c.WriteString(fmt.Sprintf("api_key_id [%d] not found or expired", api_key_id))
+		return
+	}
+	days, err := apiKeyDays(c)
+	graceText := "24"
+	if c.URLParamExists("grace") {
+		graceText = c.URLParam("grace")
+	}
+	grace, gerr := strconv.Atoi(graceText)
+	if err == nil && (gerr != nil || grace < 0) {
+		err = fmt.Errorf("grace must be a positive number of hours")
+	}
+	if err != nil {
+		c.StatusCode(400)
+		c.WriteString(err.Error())
+		return
+	}
+	// The new key is only stored if the old one is expired as well
+	var key *apiKeyDto
+	err = rep.Tx(func(tx repository.Repository) error {
+		var err error
+		if key, err = apiKeyAdd(tx, old[0].UserId, days); err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+    DECLARE @expiredtm DATETIME = DATEADD(hour, $2, GETDATE());
+    EXEC meta.api_key_expire $1, @expiredtm`, api_key_id, grace)
+		return err
+	})
+	if err != nil {
+		c.StatusCode(500)
+		c.WriteString(err.Error())
+		return
+	}
+	c.JSON(key)
+}
+
+func ServiceAccountKeyRevoke(c iris.Context, rep repository.Repository, api_key_id int64) string {
+	// swagger:operation DELETE /api/serviceaccount/key/revoke/{api_key_id} ServiceAccount ServiceAccountKeyRevoke
+	// Revoke (expire) an API key now (ADMIN only)
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: api_key_id
+	//   type: integer
+	//   in: path
+	//   required: true
+	// responses:
+	//   '200':
+	//     description: OK
+	//   '403':
+	//     description: Not member of ADMIN
+	//   '404':
+	//     description: Unknown key
+	var keys []struct {
+		Id int64 `json:"id"`
+	}
+	if err := rep.QueryStruct(&keys, `SELECT id FROM meta.api_key_v WHERE id = $1`, api_key_id); err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	if len(keys) == 0 {
+		c.StatusCode(404)
+		return fmt.Sprintf("api_key_id [%d] not found", api_key_id)
+	}
+	if _, err := rep.Exec(`EXEC meta.api_key_expire $1, NULL`, api_key_id); err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	res, err := rep.QueryJson(`SELECT id, user_id, prefix, expiredtm, active FROM meta.api_key_v WHERE id = $1`, 0, api_key_id)
+	if err != nil {
+		c.StatusCode(500)
+		return err.Error()
+	}
+	return res
+}
+
+// Returns the days (query parameter) until a new key expires
+func apiKeyDays(c iris.Context) (int, error) {
+	max, _ := strconv.Atoi(envy.Get("APIKEY_MAX_DAYS", "365"))
+	daysText := envy.Get("APIKEY_DAYS", "90")
+	if c.URLParamExists("days") {
+		daysText = c.URLParam("days")
+	}
+	days, err := strconv.Atoi(daysText)
+	if err != nil || days < 1 || days > max {
+		return 0, fmt.Errorf("days must be between 1 and %d", max)
+	}
+	return days, nil
+}
+
+// Generates and stores a key of the service account
+func apiKeyAdd(rep repository.Repository, user_id int64, days int) (*apiKeyDto, error) {
+	key, prefix, hash, err := webapi.NewApiKey()
+	if err != nil {
+		return nil, err
+	}
+	_, err = rep.Exec(`
+    DECLARE @expiredtm DATETIME = DATEADD(day, $4, GETDATE());
+    DECLARE @api_key_id BIGINT;
+    EXEC meta.api_key_add $1, $2, $3, @expiredtm, @api_key_id OUT`, user_id, prefix, hash, days)
+	if err != nil {
+		return nil, err
+	}
+	var keys []apiKeyDto
+	err = rep.QueryStruct(&keys, `SELECT id, user_id, prefix, CONVERT(NVARCHAR(19), expiredtm, 120) AS expiredtm FROM meta.api_key_v WHERE prefix = $1`, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("API key [%s] not stored", prefix)
+	}
+	keys[0].Key = key
+	return &keys[0], nil
+}
@@ -1,10 +1,175 @@
 package main
 
 import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/kataras/iris"
 	"github.com/sorenbak/datawarehouse/repository"
+	"github.com/sorenbak/datawarehouse/webapi"
 )
 
+// Users already provisioned by this process
+var provisioned sync.Map
+
+// UserProvision creates the meta.user of authenticated users (USERPROVISION=yes) on their first
+// request - the real name is taken from the name claim of the token
+func UserProvision(rep repository.Repository) iris.Handler {
+	return func(c iris.Context) {
+		claims := webapi.Claims(c)
+		username := webapi.Username(c)
+		if claims == nil || username == "" {
+			// Anonymous
+			c.Next()
+			return
+		}
+		if _, ok := provisioned.Load(username); !ok {
+			realname, _ := claims["name"].(string)
+			if realname == "" {
+				realname = username
+			}
+			// Existing users are left as they are (meta.user_add would update them)
+			_, err := rep.WithContext(c.Request().Context()).Exec(`
+    IF NOT EXISTS (SELECT 1 FROM meta.[user] WHERE username = $1)
+        EXEC meta.user_add $1, $2, $3`, username, realname, "Provisioned on login")
+			if err != nil {
+				log.Printf("Provisioning of user [%s] failed: [%s]\n", username, err)
+				c.StopExecution()
+				c.StatusCode(500)
+				return
+			}
+			provisioned.Store(username, true)
+		}
+		c.Next()
+	}
+}
+
+// GroupMap maps values of group/role claims of tokens to meta.group names
+type GroupMap map[string][]string
+
+// ParseGroupMap reads the mapping (GROUPMAP) of claim values to groups, e.g.
+// "a1b2c3d4-...=ADMIN,DataReader=READERS" - a value may be mapped to more groups
+func ParseGroupMap(s string) (GroupMap, error) {
+	m := make(GroupMap)
+	for _, entry := range strings.Split(s, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" || strings.TrimSpace(kv[1]) == "" {
+			return nil, fmt.Errorf("invalid group mapping [%s] - use claimvalue=GROUP", entry)
+		}
+		value, group := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		m[value] = append(m[value], group)
+	}
+	return m, nil
+}
+
+// Groups returns the (sorted) groups of the claim values
+func (m GroupMap) Groups(values []string) []string {
+	set := make(map[string]bool)
+	for _, v := range values {
+		for _, g := range m[v] {
+			set[g] = true
+		}
+	}
+	return sortedKeys(set)
+}
+
+// Managed returns the (sorted) groups the mapping maintains memberships of
+func (m GroupMap) Managed() []string {
+	set := make(map[string]bool)
+	for _, groups := range m {
+		for _, g := range groups {
+			set[g] = true
+		}
+	}
+	return sortedKeys(set)
+}
+
+func sortedKeys(set map[string]bool) (keys []string) {
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// claimValues returns the values of the claims (strings or arrays of strings)
+func claimValues(claims map[string]interface{}, names []string) (values []string) {
+	for _, name := range names {
+		switch v := claims[strings.TrimSpace(name)].(type) {
+		case string:
+			values = append(values, v)
+		case []interface{}:
+			for _, e := range v {
+				if s, ok := e.(string); ok {
+					values = append(values, s)
+				}
+			}
+		}
+	}
+	return values
+}
+
+// Groups last synchronized per user by this process (see groupSync)
+var synced sync.Map
+
+type groupSync struct {
+	groups string
+	at     time.Time
+}
+
+// GroupSyncReset makes GroupSync synchronize all users again on their next request - after
+// memberships were changed by the group API
+func GroupSyncReset() {
+	synced.Range(func(k, _ interface{}) bool {
+		synced.Delete(k)
+		return true
+	})
+}
+
+// GroupSync synchronizes the memberships of the managed groups of authenticated users with the
+// group/role claims (GROUPCLAIMS) of their tokens as mapped by m - on the first request, whenever
+// the claims change and at least every ttl (memberships changed in the database by others)
+func GroupSync(rep repository.Repository, m GroupMap, claimNames []string, ttl time.Duration) iris.Handler {
+	managed := strings.Join(m.Managed(), ",")
+	return func(c iris.Context) {
+		claims := webapi.Claims(c)
+		username := webapi.Username(c)
+		if claims == nil || username == "" {
+			// Anonymous
+			c.Next()
+			return
+		}
+		groups := strings.Join(m.Groups(claimValues(claims, claimNames)), ",")
+		last, ok := synced.Load(username)
+		if !ok || last.(groupSync).groups != groups || time.Since(last.(groupSync).at) > ttl {
+			_, err := rep.WithContext(c.Request().Context()).Exec(`EXEC meta.user_group_sync $1, $2, $3`, username, groups, managed)
+			if err != nil {
+				log.Printf("Group synchronization of user [%s] failed: [%s]\n", username, err)
+				c.StopExecution()
+				c.StatusCode(500)
+				return
+			}
+			synced.Store(username, groupSync{groups, time.Now()})
+		}
+		c.Next()
+	}
+}
+
+var userListSpec = repository.ListSpec{
+	From:        "meta.user_v",
+	Key:         "id",
+	Sort:        []string{"username", "realname", "createdtm", "delivery_count"},
+	DefaultSort: "username",
+	Date:        "createdtm",
+}
+
 func UserList(c iris.Context, rep repository.Repository) {
 	// swagger:operation GET /api/user/list User UserList
 	// List available users
@@ -12,43 +177,90 @@ func UserList(c iris.Context, rep repository.Repository) {
 	// produces:
 	// - application/json
 	// parameters:
+	// - name: limit
+	//   description: Number of rows per page (default 100 - max 1000)
+	//   type: integer
+	//   in: query
+	//   required: false
+	// - name: offset
+	//   description: Number of rows to skip (default 0)
+	//   type: integer
+	//   in: query
+	//   required: false
 	// - name: page
-	//   description: Page of results (starting with 0 - default)
+	//   description: Page of results (starting with 0 - default) - legacy alternative to offset
 	//   type: integer
 	//   in: query
 	//   required: false
+	// - name: cursor
+	//   description: Cursor of the next page from a previous page (replaces offset)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: sort
+	//   description: Column to sort on - prefix with - for descending (id, username (default), realname, createdtm, delivery_count)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: from
+	//   description: Creation from date (YYYY-MM-DD)
+	//   type: string
+	//   in: query
+	//   required: false
+	// - name: to
+	//   description: Creation to date (YYYY-MM-DD - inclusive)
+	//   type: string
+	//   in: query
+	//   required: false
 	// responses:
 	//   '200':
 	//     description: OK
 	//     schema:
-	//      type: array
-	//      items:
-	//        type: object
-	//        title: UserList
-	//        properties:
-	//          id:
-	//            description: ID of user
-	//            type: integer
-	//          username:
-	//            description: Username of user
-	//            type: string
-	//          realname:
-	//            description: Real name of user
-	//            type: string
-	//          description:
-	//            description: Description of user
-	//            type: string
-	//          createdtm:
-	//            description: Date of creation
-	//            type: string
-	//          delivery_count:
-	//            description: Count of deliveries
-	//            type: integer
-	page := c.FormValueDefault("page", "0")
-	res, err := rep.Query(`
-    SELECT *
-      FROM ( SELECT ROW_NUMBER() OVER (ORDER BY username) AS rownum, * FROM meta.user_v) u
-     WHERE rownum BETWEEN 100 * $1 AND ($1 + 1) * 100`, 0, page)
+	//      type: object
+	//      title: UserList
+	//      properties:
+	//        total:
+	//          description: Count of rows matching the filters
+	//          type: integer
+	//        limit:
+	//          description: Number of rows per page
+	//          type: integer
+	//        offset:
+	//          description: Number of rows skipped
+	//          type: integer
+	//        sort:
+	//          description: Sort applied
+	//          type: string
+	//        cursor:
+	//          description: Cursor of the next page
+	//          type: string
+	//        next:
+	//          description: Link to the next page (empty on last page)
+	//          type: string
+	//        data:
+	//          type: array
+	//          items:
+	//            type: object
+	//            properties:
+	//              id:
+	//                description: ID of user
+	//                type: integer
+	//              username:
+	//                description: Username of user
+	//                type: string
+	//              realname:
+	//                description: Real name of user
+	//                type: string
+	//              description:
+	//                description: Description of user
+	//                type: string
+	//              createdtm:
+	//                description: Date of creation
+	//                type: string
+	//              delivery_count:
+	//                description: Count of deliveries
+	//                type: integer
+	res, err := listPage(c, rep, userListSpec)
 	if err != nil {
 		c.StatusCode(500)
 		return
@@ -0,0 +1,859 @@
+
+-- +migrate Up
+CREATE TABLE[meta].[agreement_lookup]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [agreement_id] [bigint] NOT NULL,
+
+    [rule_id] [int] NOT NULL,
+
+    [column_name] [nvarchar] (128) NOT NULL,
+
+    [lookup_agreement_id] [bigint] NOT NULL,
+
+    [lookup_column_name] [nvarchar] (128) NOT NULL,
+
+    [delivery_date] [nvarchar] (25) NULL,
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_agreement_lookup_createdtm] DEFAULT(getdate()),
+ CONSTRAINT[PK_agreement_lookup] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[agreement_lookup] WITH CHECK ADD CONSTRAINT[FK_agreement_lookup_agreement] FOREIGN KEY([agreement_id])
+REFERENCES[meta].[agreement]
+        ([id])
+ON DELETE CASCADE
+;
+ALTER TABLE[meta].[agreement_lookup] WITH CHECK ADD CONSTRAINT[FK_agreement_lookup_lookup_agreement] FOREIGN KEY([lookup_agreement_id])
+REFERENCES[meta].[agreement]
+        ([id])
+;
+CREATE FUNCTION [meta].[get_repo_delivery_id] --|
+--| ==========================================================================================
+--| Description: Return the latest delivery of an agreement published to repo with a status
+--|              date up until @delivery_date.If @delivery_date is NULL the latest published
+--|              delivery is returned.NULL is returned if nothing has been published.
+--| Arguments:
+(
+    @agreement_id  BIGINT,       --| ID of agreement to find the published delivery for
+    @delivery_date NVARCHAR(25)  --| Date string (YYYY-MM-DD) to pin the delivery version to
+)
+RETURNS BIGINT
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @delivery_id BIGINT
+
+    SELECT @delivery_id = MAX(d.id)
+      FROM meta.delivery d,
+           meta.audit a
+     WHERE d.id           = a.delivery_id
+       AND a.stage_id     = 3
+       AND d.agreement_id = @agreement_id
+       AND (@delivery_date IS NULL OR d.status_date <= CONVERT(DATETIME, @delivery_date, 120))
+
+    RETURN @delivery_id
+END
+--| ==========================================================================================
+;
+CREATE
+VIEW[meta].[agreement_rule_v] --|
+--| ==========================================================================================
+--| Description: All validation rules of an agreement, i.e. the customized rules along with
+--|              the lookup rules translated into SQL against the latest published (or pinned)
+--|              repo delivery of the lookup agreement - in the repo table (partition) it was
+--|              published to.Without a published delivery no value is found.
+--| ==========================================================================================
+AS
+SELECT agreement_id,
+       rule_id,
+       CAST(rule_text AS NVARCHAR(MAX)) AS rule_text
+  FROM meta.agreement_rule
+ UNION ALL
+SELECT l.agreement_id,
+       l.rule_id,
+       CAST(QUOTENAME(l.column_name) + ' IS NULL OR '
+          + QUOTENAME(l.column_name) + ' IN (SELECT CAST(' + QUOTENAME(l.lookup_column_name) + ' AS NVARCHAR(4000))'
+          + COALESCE(' FROM [' + r.table_schema + '].[' + r.table_name + ']'
+                   + ' WHERE dw_delivery_id = ' + CAST(d.delivery_id AS NVARCHAR),
+                     ' WHERE 1 = 0')
+          + ')' AS NVARCHAR(MAX)) AS rule_text
+  FROM meta.agreement_lookup l
+       CROSS APPLY
+       --+ Published (or pinned) delivery of the lookup agreement
+       (SELECT meta.get_repo_delivery_id(l.lookup_agreement_id, l.delivery_date) AS delivery_id) d
+       OUTER APPLY
+       --+ Repo table the delivery was published to
+       (SELECT TOP 1 t.[schema] AS table_schema, t.name AS table_name
+          FROM meta.audit u,
+               meta.[table] t
+         WHERE u.delivery_id = d.delivery_id
+           AND u.stage_id    = 3
+           AND t.id          = u.table_id
+         ORDER BY u.id DESC) r
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[agreement_lookup_add] --|
+--| ==========================================================================================
+--| Description: Add a lookup validation rule to a specific agreement.The values of the
+--|              column must exist in a column of the latest published repo delivery of
+--|              another agreement (dimension/reference data).The lookup delivery can be
+--|              pinned to the latest delivery up until a specific date.
+--| Arguments:
+(
+    @agreement_id        BIGINT,        --| ID of meta.agreement the rule should be linked to
+    @rule_id             INT,           --| Agreement specific rule id (for log in error table)
+    @column_name         NVARCHAR(128), --| Column of agreement to validate
+    @lookup_agreement_id BIGINT,        --| ID of meta.agreement holding the reference data
+    @lookup_column_name  NVARCHAR(128), --| Column of reference data containing valid codes
+    @delivery_date       NVARCHAR(25)   --| Date string (YYYY-MM-DD) pinning the reference
+                                        --| delivery - NULL => latest published
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @table  NVARCHAR(200)
+    DECLARE @count  INT
+
+    --| Look up the init table from agreement_id
+    SELECT @table = '[' + table_schema + '].[' + table_name + ']'
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND stage_id = 0
+
+    IF @table IS NULL
+    BEGIN
+        RAISERROR ('Agreement [%I64d] does not exist', 11, 1, @agreement_id)
+        RETURN 2
+    END
+
+    --| Check the column exists in the agreement
+    SELECT @count = COUNT(*)
+      FROM meta.column_mapping_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'temp'
+       AND column_name  = QUOTENAME(@column_name)
+
+    IF @count = 0
+    BEGIN
+        RAISERROR ('Column [%s] does not exist in agreement [%I64d]', 11, 1, @column_name, @agreement_id)
+        RETURN 3
+    END
+
+    --| Check the lookup column exists in the repo table of the lookup agreement
+    SELECT @count = COUNT(*)
+      FROM meta.column_mapping_v
+     WHERE agreement_id = @lookup_agreement_id
+       AND table_schema = 'repo'
+       AND column_name  = QUOTENAME(@lookup_column_name)
+
+    IF @count = 0
+    BEGIN
+        RAISERROR ('Column [%s] does not exist in repo of lookup agreement [%I64d]', 11, 1, @lookup_column_name, @lookup_agreement_id)
+        RETURN 4
+    END
+
+    --| Check the pinned delivery date
+    IF meta.check_date(@delivery_date, 120) <> 0
+    BEGIN
+        RAISERROR ('Delivery date [%s] is not a valid date (YYYY-MM-DD)', 11, 1, @delivery_date)
+        RETURN 5
+    END
+
+    --| Rule IDs are shared with the customized rules in the error table
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_rule
+     WHERE agreement_id = @agreement_id
+       AND rule_id = @rule_id
+
+    IF @count > 0
+    BEGIN
+        RAISERROR ('Rule [%d] already exists as validation rule on agreement [%I64d]', 11, 1, @rule_id, @agreement_id)
+        RETURN 6
+    END
+
+    SET @msg = 'Lookup [' + CAST(@rule_id AS NVARCHAR) + ']=[' + @column_name + '] IN [' + CAST(@lookup_agreement_id AS NVARCHAR) + '].[' + @lookup_column_name + '] AS OF [' + COALESCE(@delivery_date, 'latest') + ']'
+    EXEC meta.debug @@PROCID, @msg
+
+    --| Determine update or insert lookup
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_lookup
+     WHERE agreement_id = @agreement_id
+       AND rule_id = @rule_id
+
+    IF @count = 0
+        INSERT INTO meta.agreement_lookup
+               (agreement_id, rule_id, column_name, lookup_agreement_id, lookup_column_name, delivery_date)
+        VALUES (@agreement_id, @rule_id, @column_name, @lookup_agreement_id, @lookup_column_name, @delivery_date)
+    ELSE
+        UPDATE meta.agreement_lookup
+           SET column_name         = @column_name,
+               lookup_agreement_id = @lookup_agreement_id,
+               lookup_column_name  = @lookup_column_name,
+               delivery_date       = @delivery_date
+         WHERE agreement_id = @agreement_id
+           AND rule_id = @rule_id
+
+    -- | Return Success
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+ALTER
+PROCEDURE[meta].[delivery_validate] --|
+--| ==========================================================================================
+--| Description: Validate the data loaded into the database from file and move to staging area
+--|              The agreement to which the delivery belongs specifies the temp2stag procedure
+--|              for moving the data to stag after validation using generic rules.This allows
+--|              customized validation procedures to be implemented for deliveries requiring
+--|              special treatment on top of the standard rule system.Such procedures must
+--|              implement the moving of data from temp to stag schema - or call the default
+--|              generic_temp2stag() procedure in the end.
+--| Arguments:             
+(
+    @name NVARCHAR(250)  --| Name of file to match against the agreement pattern
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @temp2stag     NVARCHAR(1000)
+    DECLARE @count         INT
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id   BIGINT
+    DECLARE @table_id BIGINT
+    DECLARE @audit_id      BIGINT
+
+    --| Based on name pattern, lookup the agreement from meta.agreement table
+    EXEC meta.debug @@PROCID, 'Lookup active agreement from delivery.name LIKE agreement.pattern'
+    EXEC meta.agreement_find @name, 2, @agreement_id OUT, @temp2stag OUT
+    IF @agreement_id IS NULL
+    BEGIN
+        RAISERROR ('Error looking up agreement [%s]', 11, 1, @name)
+        RETURN 2
+    END
+
+    --| Promote delivery to stag(ID 2)
+    EXEC meta.delivery_add @agreement_id, 2, @name, NULL, 0, 'VALIDATE', @delivery_id OUT, @audit_id OUT, @table_id OUT
+
+    --+ Error if valid delivery_id is not returned
+    IF @delivery_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] for staging not available', 11, 1, @name)
+        RETURN 4
+    END
+
+    --+ Get the temp and stag schema and table names
+    DECLARE @temp_schema NVARCHAR(50)
+    DECLARE @temp_name    NVARCHAR(100)
+    DECLARE @stag_schema  NVARCHAR(50)
+    DECLARE @stag_name    NVARCHAR(100)
+
+    --+ Temp table
+    SELECT @temp_name = table_name,
+           @temp_schema = table_schema
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'temp'
+
+    -- + Stag table
+    SELECT @stag_name   = table_name,
+           @stag_schema = table_schema
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'stag'
+
+    -- + Check if table names were found
+    IF @temp_name IS NULL
+    BEGIN
+        RAISERROR('Temp table not found for delivery [%s]', 11, 1, @name)
+        RETURN 5
+    END
+    IF @stag_name IS NULL
+    BEGIN
+        RAISERROR('Stag table not found for delivery [%s]', 11, 1, @name)
+        RETURN 6
+    END
+
+    --| Check if delivery has already been loaded once
+    DECLARE @sql    NVARCHAR(MAX)
+    SET @sql = 'SELECT @o_count = COUNT(*) '
+             + '  FROM [' + @stag_schema + '].[' + @stag_name + ']'
+             + ' WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+    EXEC sp_executesql @sql, N'@o_count INT OUT', @o_count = @count OUT
+
+	--+ Error + break if delivery_id is present in stag table
+    IF COALESCE(@count, 0) > 0 
+    BEGIN
+        EXEC meta.operation_add @audit_id, 3, @@PROCID, 'Delivery has already promoted'
+        RAISERROR('Delivery [%s] has already been promoted to [stag] ([%d] rows)', 11, 1, @name, @count)
+        RETURN 7
+    END
+    
+    --| Load validation rules (including lookup rules) if any
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_rule_v
+     WHERE agreement_id = @agreement_id
+
+
+    IF @count > 0
+    BEGIN
+        DECLARE @rule_id INT
+        DECLARE @rule_text   NVARCHAR(MAX)
+        DECLARE @rule_count INT
+        DECLARE @err_table   NVARCHAR(110)
+        SET @err_table = '[' + @temp_schema + '].[' + @temp_name + '_errors]'
+
+        -- + Drop the error table if it exists
+        IF OBJECT_ID(@err_table) IS NOT NULL
+        BEGIN
+            SET @sql = 'DROP TABLE ' + @err_table
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+        END
+        
+        --| Loop over rules specific for the agreement
+        DECLARE rul CURSOR FOR
+        SELECT rule_id, rule_text
+          FROM meta.agreement_rule_v
+         WHERE agreement_id = @agreement_id
+
+
+        OPEN rul
+
+        --+ Prepare (daft MS SQL) loop
+        FETCH NEXT FROM rul INTO @rule_id, @rule_text
+
+        SET @sql = CAST('SELECT * INTO ' + @err_table + ' FROM (' AS NVARCHAR(MAX))
+        SET @rule_count = 0
+
+        WHILE @@FETCH_STATUS = 0
+        BEGIN
+            SET @rule_count = @rule_count + 1
+            SET @msg = '  RULE [' + CAST(@rule_id AS NVARCHAR) + ']: [' + @rule_text + ']'
+            EXEC meta.debug @@PROCID, @msg
+
+            --| Append validation SQL
+            SET @sql = @sql
+                     + CAST('SELECT *,'  AS NVARCHAR(MAX))
+                     + CAST(@rule_id     AS NVARCHAR(MAX)) + CAST(' AS rule_id, '    AS NVARCHAR(MAX))
+                     + CAST(@delivery_id AS NVARCHAR(MAX)) + CAST(' AS delivery_id ' AS NVARCHAR(MAX))
+                     + CAST('  FROM [' + @temp_schema + '].[' + @temp_name + ']'     AS NVARCHAR(MAX))
+                     + CAST(' WHERE NOT(' + @rule_text + ')' AS NVARCHAR(MAX))
+
+            FETCH NEXT FROM rul INTO @rule_id, @rule_text
+            IF @@FETCH_STATUS = 0 SET @sql = @sql + CAST(' UNION ALL ' AS NVARCHAR(MAX))
+        END
+
+        --| Set dummy validation statement if no rules applied
+        IF @rule_count = 0 SET @sql = @sql + 'SELECT CAST(0 AS INT) AS dummy_with_no_rows WHERE 1=0'
+        SET @sql = @sql + CAST(') a' AS NVARCHAR(MAX))
+
+        -- | Execute the final validation SQL statement built from rules
+        EXEC meta.debug @@PROCID, 'Loaded rules into SQL'
+        EXEC meta.debug @@PROCID, @rule_count
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql
+        
+        --| Perform error checking after rules have been applied
+        --+ Check if rows are found in the temp error table
+        SET @sql = 'SELECT @o_count = COUNT(*) FROM ' + @err_table + ' WHERE delivery_id = ' + CAST(@delivery_id AS NVARCHAR(MAX))
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql, N'@o_count INT OUT', @o_count = @count OUT
+
+        IF @count > 0 
+        BEGIN
+            --| Collect the unknown codes of failing lookup rules for the error message
+            DECLARE @codes         NVARCHAR(4000)
+            DECLARE @column_name   NVARCHAR(128)
+            SET @codes = ''
+
+            DECLARE lkp CURSOR FOR
+            SELECT rule_id, column_name
+              FROM meta.agreement_lookup
+             WHERE agreement_id = @agreement_id
+
+            OPEN lkp
+
+            --+ Prepare (daft MS SQL) loop
+            FETCH NEXT FROM lkp INTO @rule_id, @column_name
+
+            WHILE @@FETCH_STATUS = 0
+            BEGIN
+                SET @msg = NULL
+                SET @sql = 'SELECT @o_msg = COALESCE(@o_msg + '', '', '''') + code '
+                         + '  FROM (SELECT DISTINCT TOP 20 CAST(' + QUOTENAME(@column_name) + ' AS NVARCHAR(100)) AS code '
+                         + '          FROM ' + @err_table
+                         + '         WHERE delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+                         + '           AND rule_id = ' + CAST(@rule_id AS NVARCHAR) + ') c'
+                EXEC meta.debug @@PROCID, @sql
+                EXEC sp_executesql @sql, N'@o_msg NVARCHAR(4000) OUT', @o_msg = @msg OUT
+
+                IF @msg IS NOT NULL
+                    SET @codes = @codes + ' Rule [' + CAST(@rule_id AS NVARCHAR) + '] unknown ' + QUOTENAME(@column_name) + ' [' + @msg + ']'
+
+                --+ Repeat (daft MS SQL) loop
+                FETCH NEXT FROM lkp INTO @rule_id, @column_name
+            END
+            CLOSE lkp
+            DEALLOCATE lkp
+
+            SET @msg = 'Validation failed' + @codes
+            EXEC meta.operation_add @audit_id, 3, @@PROCID, @msg
+            RAISERROR('Validation errors found [%d] - check [%s].[%s_errors]%s', 11, 1, @count, @temp_schema, @temp_name, @codes)
+            RETURN 2
+        END
+
+        --+ Only drop if truly empty
+        SET @sql = 'SELECT @o_count = COUNT(*) FROM ' + @err_table
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql, N'@o_count INT OUT', @o_count = @count OUT
+
+        IF @count = 0 
+        BEGIN
+            --+ Drop the error(empty) table
+            SET @sql = 'DROP TABLE ' + @err_table
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+        END
+    END
+
+    --| BEGIN controlled transaction
+    --+ From here we take over error handling in order to log failed attempts
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        --| Get the stored procedure call for moving data(temp --> stag)
+        EXEC meta.debug @@PROCID, 'Prepare SQL for mapping temp table to stag'
+        --| Replace available parameters in quotes in the dynamic query (temp2stag)
+        --|  @delivery_id
+        SET @temp2stag = REPLACE(@temp2stag, '@delivery_id', CAST(@delivery_id AS NVARCHAR))
+        EXEC meta.debug @@PROCID, @temp2stag
+        EXEC sp_executesql @temp2stag, N'@o_sql NVARCHAR(MAX) OUT', @o_sql = @sql OUT
+
+        EXEC meta.debug @@PROCID, @sql
+
+        IF LEN(@sql) = 0 RAISERROR('Temp->Stag procedure [%s] returned empty SQL', 11, 1, @temp2stag)
+        
+        --| Execute the SQL insert into stag statement
+        EXEC sp_executesql @sql
+
+        --+ Prepare cleanup of temp statement(TRUNCATE)
+        EXEC meta.debug @@PROCID, 'Truncate temp table'
+        SET @sql = 'TRUNCATE TABLE [' + @temp_schema + '].[' + @temp_name + ']'
+
+        -- | Execute the cleanup of temp table
+        EXEC sp_executesql @sql
+
+
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        --| Log in audit and rollback transaction
+        IF @@trancount > 0 ROLLBACK TRANSACTION
+        EXEC meta.operation_add @audit_id, 3, @@PROCID, NULL
+        EXEC meta.debug @@PROCID, 'Validating delivery failed'
+        --| Return error code
+        RETURN 10
+    END CATCH
+    
+    --| SUCCESS handling
+    EXEC meta.debug @@PROCID, 'DONE'
+        --| COMMIT controlled transaction
+    COMMIT TRANSACTION
+        --| Return success
+    RETURN
+    --| END
+END
+--| ==========================================================================================
+;
+ALTER PROCEDURE[meta].[agreement_rule_add] --|
+--| ==========================================================================================
+--| Description: Add a customized validation rule to a specific agreement
+--| Arguments:
+(
+    @agreement_id BIGINT,        --| ID of meta.agreement the rule should be linked to
+@rule_id           INT,           --| Agreement specific rule id(for log in error table)
+    @rule_text NVARCHAR(4000) --| Validation SQL to be inserted into WHERE clause
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @table  NVARCHAR(200)
+
+    --| Look up the init table from agreement_id
+    SELECT @table = '[' + table_schema + '].[' + table_name + ']'
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND stage_id = 0
+
+    IF @table IS NULL
+    BEGIN
+        RAISERROR ('Agreement [%I64d] does not exist', 11, 1, @agreement_id)
+        RETURN 2
+    END
+
+    SET @msg = 'Rule [' + CAST(@rule_id AS NVARCHAR) + ']=[' + @rule_text + ']'
+    EXEC meta.debug @@PROCID, @msg
+
+    --| Check the validation rule against the table definition
+    /*SET @msg = 'SELECT TOP 1 1 FROM ' + @table + ' WHERE ' + @rule_text
+    BEGIN TRY
+        EXEC sp_executesql @msg
+    END TRY
+    BEGIN CATCH
+        SET @msg = 'Validation [' + @rule_text + '] error [' + CAST(ERROR_NUMBER() AS NVARCHAR) + '] [' + ERROR_MESSAGE() + ']'
+        EXEC meta.debug @@PROCID, @msg
+        RETURN 3
+    END CATCH*/
+
+    DECLARE @count INT
+
+    --| Rule IDs are shared with the lookup rules of the agreement
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_lookup
+     WHERE agreement_id = @agreement_id
+       AND rule_id = @rule_id
+
+    IF @count > 0
+    BEGIN
+        RAISERROR ('Rule [%d] already exists as lookup rule on agreement [%I64d]', 11, 1, @rule_id, @agreement_id)
+        RETURN 4
+    END
+
+    --| Determine update or insert rule
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_rule
+     WHERE agreement_id = @agreement_id
+       AND rule_id = @rule_id
+
+    IF @count = 0
+        INSERT INTO meta.agreement_rule
+               (agreement_id, rule_id, rule_text)
+        VALUES (@agreement_id, @rule_id, @rule_text)
+    ELSE
+        UPDATE meta.agreement_rule
+           SET rule_text = @rule_text
+         WHERE agreement_id = @agreement_id
+           AND rule_id = @rule_id
+
+    -- | Return Success
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+ALTER PROCEDURE[meta].[agreement_rule_add] --|
+--| ==========================================================================================
+--| Description: Add a customized validation rule to a specific agreement
+--| Arguments:
+(
+    @agreement_id BIGINT,        --| ID of meta.agreement the rule should be linked to
+@rule_id           INT,           --| Agreement specific rule id(for log in error table)
+    @rule_text NVARCHAR(4000) --| Validation SQL to be inserted into WHERE clause
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @table  NVARCHAR(200)
+
+    --| Look up the init table from agreement_id
+    SELECT @table = '[' + table_schema + '].[' + table_name + ']'
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND stage_id = 0
+
+    IF @table IS NULL
+    BEGIN
+        RAISERROR ('Agreement [%I64d] does not exist', 11, 1, @agreement_id)
+        RETURN 2
+    END
+
+    SET @msg = 'Rule [' + CAST(@rule_id AS NVARCHAR) + ']=[' + @rule_text + ']'
+    EXEC meta.debug @@PROCID, @msg
+
+    --| Check the validation rule against the table definition
+    /*SET @msg = 'SELECT TOP 1 1 FROM ' + @table + ' WHERE ' + @rule_text
+    BEGIN TRY
+        EXEC sp_executesql @msg
+    END TRY
+    BEGIN CATCH
+        SET @msg = 'Validation [' + @rule_text + '] error [' + CAST(ERROR_NUMBER() AS NVARCHAR) + '] [' + ERROR_MESSAGE() + ']'
+        EXEC meta.debug @@PROCID, @msg
+        RETURN 3
+    END CATCH*/
+
+    --| Determine update or insert rule
+    DECLARE @count INT
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_rule
+     WHERE agreement_id = @agreement_id
+       AND rule_id = @rule_id
+
+    IF @count = 0
+        INSERT INTO meta.agreement_rule
+               (agreement_id, rule_id, rule_text)
+        VALUES (@agreement_id, @rule_id, @rule_text)
+    ELSE
+        UPDATE meta.agreement_rule
+           SET rule_text = @rule_text
+         WHERE agreement_id = @agreement_id
+           AND rule_id = @rule_id
+
+    -- | Return Success
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+ALTER
+PROCEDURE[meta].[delivery_validate] --|
+--| ==========================================================================================
+--| Description: Validate the data loaded into the database from file and move to staging area
+--|              The agreement to which the delivery belongs specifies the temp2stag procedure
+--|              for moving the data to stag after validation using generic rules.This allows
+--|              customized validation procedures to be implemented for deliveries requiring
+--|              special treatment on top of the standard rule system.Such procedures must
+--|              implement the moving of data from temp to stag schema - or call the default
+--|              generic_temp2stag() procedure in the end.
+--| Arguments:             
+(
+    @name NVARCHAR(250)  --| Name of file to match against the agreement pattern
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @temp2stag     NVARCHAR(1000)
+    DECLARE @count         INT
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id   BIGINT
+    DECLARE @table_id BIGINT
+    DECLARE @audit_id      BIGINT
+
+    --| Based on name pattern, lookup the agreement from meta.agreement table
+    EXEC meta.debug @@PROCID, 'Lookup active agreement from delivery.name LIKE agreement.pattern'
+    EXEC meta.agreement_find @name, 2, @agreement_id OUT, @temp2stag OUT
+    IF @agreement_id IS NULL
+    BEGIN
+        RAISERROR ('Error looking up agreement [%s]', 11, 1, @name)
+        RETURN 2
+    END
+
+    --| Promote delivery to stag(ID 2)
+    EXEC meta.delivery_add @agreement_id, 2, @name, NULL, 0, 'VALIDATE', @delivery_id OUT, @audit_id OUT, @table_id OUT
+
+    --+ Error if valid delivery_id is not returned
+    IF @delivery_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] for staging not available', 11, 1, @name)
+        RETURN 4
+    END
+
+    --+ Get the temp and stag schema and table names
+    DECLARE @temp_schema NVARCHAR(50)
+    DECLARE @temp_name    NVARCHAR(100)
+    DECLARE @stag_schema  NVARCHAR(50)
+    DECLARE @stag_name    NVARCHAR(100)
+
+    --+ Temp table
+    SELECT @temp_name = table_name,
+           @temp_schema = table_schema
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'temp'
+
+    -- + Stag table
+    SELECT @stag_name   = table_name,
+           @stag_schema = table_schema
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'stag'
+
+    -- + Check if table names were found
+    IF @temp_name IS NULL
+    BEGIN
+        RAISERROR('Temp table not found for delivery [%s]', 11, 1, @name)
+        RETURN 5
+    END
+    IF @stag_name IS NULL
+    BEGIN
+        RAISERROR('Stag table not found for delivery [%s]', 11, 1, @name)
+        RETURN 6
+    END
+
+    --| Check if delivery has already been loaded once
+    DECLARE @sql    NVARCHAR(MAX)
+    SET @sql = 'SELECT @o_count = COUNT(*) '
+             + '  FROM [' + @stag_schema + '].[' + @stag_name + ']'
+             + ' WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+    EXEC sp_executesql @sql, N'@o_count INT OUT', @o_count = @count OUT
+
+	--+ Error + break if delivery_id is present in stag table
+    IF COALESCE(@count, 0) > 0 
+    BEGIN
+        EXEC meta.operation_add @audit_id, 3, @@PROCID, 'Delivery has already promoted'
+        RAISERROR('Delivery [%s] has already been promoted to [stag] ([%d] rows)', 11, 1, @name, @count)
+        RETURN 7
+    END
+    
+    --| Load validation rules if any
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_rule
+     WHERE agreement_id = @agreement_id
+
+
+    IF @count > 0
+    BEGIN
+        DECLARE @rule_id INT
+        DECLARE @rule_text   NVARCHAR(MAX)
+        DECLARE @rule_count INT
+        DECLARE @err_table   NVARCHAR(110)
+        SET @err_table = '[' + @temp_schema + '].[' + @temp_name + '_errors]'
+
+        -- + Drop the error table if it exists
+        IF OBJECT_ID(@err_table) IS NOT NULL
+        BEGIN
+            SET @sql = 'DROP TABLE ' + @err_table
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+        END
+        
+        --| Loop over rules specific for the agreement
+        DECLARE rul CURSOR FOR
+        SELECT rule_id, rule_text
+          FROM meta.agreement_rule
+         WHERE agreement_id = @agreement_id
+
+
+        OPEN rul
+
+        --+ Prepare (daft MS SQL) loop
+        FETCH NEXT FROM rul INTO @rule_id, @rule_text
+
+        SET @sql = CAST('SELECT * INTO ' + @err_table + ' FROM (' AS NVARCHAR(MAX))
+        SET @rule_count = 0
+
+        WHILE @@FETCH_STATUS = 0
+        BEGIN
+            SET @rule_count = @rule_count + 1
+            SET @msg = '  RULE [' + CAST(@rule_id AS NVARCHAR) + ']: [' + @rule_text + ']'
+            EXEC meta.debug @@PROCID, @msg
+
+            --| Append validation SQL
+            SET @sql = @sql
+                     + CAST('SELECT *,'  AS NVARCHAR(MAX))
+                     + CAST(@rule_id     AS NVARCHAR(MAX)) + CAST(' AS rule_id, '    AS NVARCHAR(MAX))
+                     + CAST(@delivery_id AS NVARCHAR(MAX)) + CAST(' AS delivery_id ' AS NVARCHAR(MAX))
+                     + CAST('  FROM [' + @temp_schema + '].[' + @temp_name + ']'     AS NVARCHAR(MAX))
+                     + CAST(' WHERE NOT(' + @rule_text + ')' AS NVARCHAR(MAX))
+
+            FETCH NEXT FROM rul INTO @rule_id, @rule_text
+            IF @@FETCH_STATUS = 0 SET @sql = @sql + CAST(' UNION ALL ' AS NVARCHAR(MAX))
+        END
+
+        --| Set dummy validation statement if no rules applied
+        IF @rule_count = 0 SET @sql = @sql + 'SELECT CAST(0 AS INT) AS dummy_with_no_rows WHERE 1=0'
+        SET @sql = @sql + CAST(') a' AS NVARCHAR(MAX))
+
+        -- | Execute the final validation SQL statement built from rules
+        EXEC meta.debug @@PROCID, 'Loaded rules into SQL'
+        EXEC meta.debug @@PROCID, @rule_count
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql
+        
+        --| Perform error checking after rules have been applied
+        --+ Check if rows are found in the temp error table
+        SET @sql = 'SELECT @o_count = COUNT(*) FROM ' + @err_table + ' WHERE delivery_id = ' + CAST(@delivery_id AS NVARCHAR(MAX))
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql, N'@o_count INT OUT', @o_count = @count OUT
+
+        IF @count > 0 
+        BEGIN
+            EXEC meta.operation_add @audit_id, 3, @@PROCID, 'Validation failed'
+            RAISERROR('Validation errors found [%d] - check [%s].[%s_errors]', 11, 1, @count, @temp_schema, @temp_name)
+            RETURN 2
+        END
+
+        --+ Only drop if truly empty
+        SET @sql = 'SELECT @o_count = COUNT(*) FROM ' + @err_table
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql, N'@o_count INT OUT', @o_count = @count OUT
+
+        IF @count = 0 
+        BEGIN
+            --+ Drop the error(empty) table
+            SET @sql = 'DROP TABLE ' + @err_table
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+        END
+    END
+
+    --| BEGIN controlled transaction
+    --+ From here we take over error handling in order to log failed attempts
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        --| Get the stored procedure call for moving data(temp --> stag)
+        EXEC meta.debug @@PROCID, 'Prepare SQL for mapping temp table to stag'
+        --| Replace available parameters in quotes in the dynamic query (temp2stag)
+        --|  @delivery_id
+        SET @temp2stag = REPLACE(@temp2stag, '@delivery_id', CAST(@delivery_id AS NVARCHAR))
+        EXEC meta.debug @@PROCID, @temp2stag
+        EXEC sp_executesql @temp2stag, N'@o_sql NVARCHAR(MAX) OUT', @o_sql = @sql OUT
+
+        EXEC meta.debug @@PROCID, @sql
+
+        IF LEN(@sql) = 0 RAISERROR('Temp->Stag procedure [%s] returned empty SQL', 11, 1, @temp2stag)
+        
+        --| Execute the SQL insert into stag statement
+        EXEC sp_executesql @sql
+
+        --+ Prepare cleanup of temp statement(TRUNCATE)
+        EXEC meta.debug @@PROCID, 'Truncate temp table'
+        SET @sql = 'TRUNCATE TABLE [' + @temp_schema + '].[' + @temp_name + ']'
+
+        -- | Execute the cleanup of temp table
+        EXEC sp_executesql @sql
+
+
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        --| Log in audit and rollback transaction
+        IF @@trancount > 0 ROLLBACK TRANSACTION
+        EXEC meta.operation_add @audit_id, 3, @@PROCID, NULL
+        EXEC meta.debug @@PROCID, 'Validating delivery failed'
+        --| Return error code
+        RETURN 10
+    END CATCH
+    
+    --| SUCCESS handling
+    EXEC meta.debug @@PROCID, 'DONE'
+        --| COMMIT controlled transaction
+    COMMIT TRANSACTION
+        --| Return success
+    RETURN
+    --| END
+END
+--| ==========================================================================================
+;
+DROP PROCEDURE [meta].[agreement_lookup_add]
+;
+DROP VIEW [meta].[agreement_rule_v]
+;
+DROP FUNCTION [meta].[get_repo_delivery_id]
+;
+DROP TABLE [meta].[agreement_lookup]
+;
@@ -0,0 +1,365 @@
+
+-- +migrate Up
+CREATE TABLE[meta].[agreement_check]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [agreement_id] [bigint] NOT NULL,
+
+    [check_id] [int] NOT NULL,
+
+    [check_type] [nvarchar] (20) NOT NULL,
+
+    [column_name] [nvarchar] (128) NULL,
+
+    [tolerance] [float] NULL,
+
+    [min_value] [float] NULL,
+
+    [max_value] [float] NULL,
+
+    [history] [int] NOT NULL CONSTRAINT[DF_agreement_check_history] DEFAULT((5)),
+
+    [status_id] [bigint] NOT NULL CONSTRAINT[DF_agreement_check_status_id] DEFAULT((2)),
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_agreement_check_createdtm] DEFAULT(getdate()),
+ CONSTRAINT[PK_agreement_check] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[agreement_check] WITH CHECK ADD CONSTRAINT[FK_agreement_check_agreement] FOREIGN KEY([agreement_id])
+REFERENCES[meta].[agreement]
+        ([id])
+ON DELETE CASCADE
+;
+ALTER TABLE[meta].[agreement_check] WITH CHECK ADD CONSTRAINT[FK_agreement_check_status] FOREIGN KEY([status_id])
+REFERENCES[meta].[status]
+        ([id])
+;
+ALTER TABLE[meta].[agreement_check] WITH CHECK ADD CONSTRAINT[CK_agreement_check_check_type]
+CHECK ([check_type] IN ('ROW_COUNT', 'NULL_RATE', 'MEAN', 'MIN', 'MAX', 'DISTINCT_COUNT'))
+;
+CREATE TABLE[meta].[delivery_stat]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [delivery_id] [bigint] NOT NULL,
+
+    [check_type] [nvarchar] (20) NOT NULL,
+
+    [column_name] [nvarchar] (128) NOT NULL,
+
+    [value] [float] NULL,
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_delivery_stat_createdtm] DEFAULT(getdate()),
+ CONSTRAINT[PK_delivery_stat] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[delivery_stat] WITH CHECK ADD CONSTRAINT[FK_delivery_stat_delivery] FOREIGN KEY([delivery_id])
+REFERENCES[meta].[delivery]
+        ([id])
+ON DELETE CASCADE
+;
+CREATE
+PROCEDURE[meta].[agreement_check_add] --|
+--| ==========================================================================================
+--| Description: Add a delivery level check to a specific agreement.The metric of the check
+--|              is computed for every delivery in stag and compared to fixed bounds and/or
+--|              the trailing average of the previously published deliveries.
+--|              Check types (column_name required for all but ROW_COUNT):
+--|                ROW_COUNT      - number of rows in delivery
+--|                NULL_RATE      - percentage of NULL values in column
+--|                MEAN, MIN, MAX - numeric statistic of column
+--|                DISTINCT_COUNT - number of distinct values in column
+--| Arguments:
+(
+    @agreement_id BIGINT,        --| ID of meta.agreement the check should be linked to
+    @check_id     INT,           --| Agreement specific check id
+    @check_type   NVARCHAR(20),  --| Type of check (see above)
+    @column_name  NVARCHAR(128), --| Column to compute metric for (NULL for ROW_COUNT)
+    @tolerance    FLOAT,         --| Allowed deviation from trailing average in percent
+                                 --| (percentage points for NULL_RATE) - NULL => no drift check
+    @min_value    FLOAT,         --| Lower bound of metric - NULL => no lower bound
+    @max_value    FLOAT,         --| Upper bound of metric - NULL => no upper bound
+    @history      INT,           --| Number of trailing deliveries to average - NULL => 5
+    @status_id    BIGINT         --| Status on breach 2 (WARN) or 3 (ERROR) - NULL => 2
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @count  INT
+
+    SET @check_type = UPPER(@check_type)
+    SET @history    = COALESCE(@history, 5)
+    SET @status_id  = COALESCE(@status_id, 2)
+
+    --| Check the agreement exists
+    SELECT @count = COUNT(*)
+      FROM meta.agreement
+     WHERE id = @agreement_id
+
+    IF @count = 0
+    BEGIN
+        RAISERROR ('Agreement [%I64d] does not exist', 11, 1, @agreement_id)
+        RETURN 2
+    END
+
+    --| Check type and column
+    IF @check_type NOT IN ('ROW_COUNT', 'NULL_RATE', 'MEAN', 'MIN', 'MAX', 'DISTINCT_COUNT')
+    BEGIN
+        RAISERROR ('Unknown check type [%s]', 11, 1, @check_type)
+        RETURN 3
+    END
+
+    IF @check_type = 'ROW_COUNT' SET @column_name = NULL
+    ELSE
+    BEGIN
+        SELECT @count = COUNT(*)
+          FROM meta.column_mapping_v
+         WHERE agreement_id = @agreement_id
+           AND table_schema = 'stag'
+           AND column_name  = QUOTENAME(@column_name)
+
+        IF @count = 0
+        BEGIN
+            RAISERROR ('Column [%s] does not exist in stag of agreement [%I64d]', 11, 1, @column_name, @agreement_id)
+            RETURN 4
+        END
+    END
+
+    IF @status_id NOT IN (2, 3) OR @history < 1
+    BEGIN
+        RAISERROR ('Check must have status 2 (WARN) or 3 (ERROR) and a positive history', 11, 1)
+        RETURN 5
+    END
+
+    SET @msg = 'Check [' + CAST(@check_id AS NVARCHAR) + ']=[' + @check_type + '] [' + COALESCE(@column_name, '') + ']'
+    EXEC meta.debug @@PROCID, @msg
+
+    --| Determine update or insert check
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_check
+     WHERE agreement_id = @agreement_id
+       AND check_id = @check_id
+
+    IF @count = 0
+        INSERT INTO meta.agreement_check
+               (agreement_id, check_id, check_type, column_name, tolerance, min_value, max_value, history, status_id)
+        VALUES (@agreement_id, @check_id, @check_type, @column_name, @tolerance, @min_value, @max_value, @history, @status_id)
+    ELSE
+        UPDATE meta.agreement_check
+           SET check_type  = @check_type,
+               column_name = @column_name,
+               tolerance   = @tolerance,
+               min_value   = @min_value,
+               max_value   = @max_value,
+               history     = @history,
+               status_id   = @status_id
+         WHERE agreement_id = @agreement_id
+           AND check_id = @check_id
+
+    -- | Return Success
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[delivery_check] --|
+--| ==========================================================================================
+--| Description: Perform the delivery level checks of the agreement on a delivery in stag.
+--|              The metrics are stored in meta.delivery_stat and compared with the trailing
+--|              average of the previously published deliveries of the same agreement.
+--|              Every breach is logged in the operation trail of the stag audit with the
+--|              status of the check - if any check breaches with ERROR, 2 is returned.
+--| Arguments:             
+(
+    @name NVARCHAR(250)  --| Name of file to match against the agreement pattern
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @sql           NVARCHAR(MAX)
+    DECLARE @temp2stag     NVARCHAR(1000)
+    DECLARE @count         INT
+    DECLARE @agreement_id  BIGINT
+    DECLARE @delivery_id   BIGINT
+    DECLARE @audit_id      BIGINT
+    DECLARE @stag_schema   NVARCHAR(50)
+    DECLARE @stag_name     NVARCHAR(100)
+
+    --| Based on name pattern, lookup the agreement from meta.agreement table
+    EXEC meta.debug @@PROCID, 'Lookup active agreement from delivery.name LIKE agreement.pattern'
+    EXEC meta.agreement_find @name, 2, @agreement_id OUT, @temp2stag OUT
+    IF @agreement_id IS NULL
+    BEGIN
+        RAISERROR ('Error looking up agreement [%s]', 11, 1, @name)
+        RETURN 2
+    END
+
+    --| Lookup the delivery and its stag audit
+    SELECT @delivery_id = MAX(d.id),
+           @audit_id    = MAX(a.id)
+      FROM meta.delivery d,
+           meta.audit a
+     WHERE d.id           = a.delivery_id
+       AND a.stage_id     = 2
+       AND d.agreement_id = @agreement_id
+       AND d.name         = @name
+
+    IF @delivery_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] not available in stag', 11, 1, @name)
+        RETURN 4
+    END
+
+    --+ Stag table
+    SELECT @stag_name   = table_name,
+           @stag_schema = table_schema
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'stag'
+
+    IF @stag_name IS NULL
+    BEGIN
+        RAISERROR('Stag table not found for delivery [%s]', 11, 1, @name)
+        RETURN 6
+    END
+
+    --| Loop over checks specific for the agreement
+    DECLARE @check_id    INT
+    DECLARE @check_type  NVARCHAR(20)
+    DECLARE @column_name NVARCHAR(128)
+    DECLARE @tolerance   FLOAT
+    DECLARE @min_value   FLOAT
+    DECLARE @max_value   FLOAT
+    DECLARE @history     INT
+    DECLARE @status_id   BIGINT
+    DECLARE @value       FLOAT
+    DECLARE @average     FLOAT
+    DECLARE @breach      NVARCHAR(250)
+    DECLARE @max_status  BIGINT
+    DECLARE @check_count INT
+
+    SET @max_status  = 1
+    SET @check_count = 0
+
+    DECLARE chk CURSOR FOR
+    SELECT check_id, check_type, column_name, tolerance, min_value, max_value, history, status_id
+      FROM meta.agreement_check
+     WHERE agreement_id = @agreement_id
+     ORDER BY check_id
+
+    OPEN chk
+
+    --+ Prepare (daft MS SQL) loop
+    FETCH NEXT FROM chk INTO @check_id, @check_type, @column_name, @tolerance, @min_value, @max_value, @history, @status_id
+
+    WHILE @@FETCH_STATUS = 0
+    BEGIN
+        SET @check_count = @check_count + 1
+
+        --| Compute the metric of the delivery
+        SET @sql = 'SELECT @o_value = '
+                 + CASE @check_type
+                       WHEN 'ROW_COUNT'      THEN 'COUNT(*)'
+                       WHEN 'NULL_RATE'      THEN '100.0 * SUM(CASE WHEN ' + QUOTENAME(@column_name) + ' IS NULL THEN 1 ELSE 0 END) / NULLIF(COUNT(*), 0)'
+                       WHEN 'MEAN'           THEN 'AVG(CAST(' + QUOTENAME(@column_name) + ' AS FLOAT))'
+                       WHEN 'MIN'            THEN 'MIN(CAST(' + QUOTENAME(@column_name) + ' AS FLOAT))'
+                       WHEN 'MAX'            THEN 'MAX(CAST(' + QUOTENAME(@column_name) + ' AS FLOAT))'
+                       WHEN 'DISTINCT_COUNT' THEN 'COUNT(DISTINCT ' + QUOTENAME(@column_name) + ')'
+                   END
+                 + '  FROM [' + @stag_schema + '].[' + @stag_name + ']'
+                 + ' WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+        EXEC meta.debug @@PROCID, @sql
+        SET @value = NULL
+        EXEC sp_executesql @sql, N'@o_value FLOAT OUT', @o_value = @value OUT
+
+        --| Store the metric for later deliveries to compare with
+        DELETE FROM meta.delivery_stat
+         WHERE delivery_id = @delivery_id
+           AND check_type  = @check_type
+           AND column_name = COALESCE(@column_name, '')
+
+        INSERT INTO meta.delivery_stat
+               (delivery_id, check_type, column_name, value)
+        VALUES (@delivery_id, @check_type, COALESCE(@column_name, ''), @value)
+
+        --| Trailing average of the previously published deliveries
+        SELECT @average = AVG(h.value),
+               @count   = COUNT(*)
+          FROM (SELECT TOP (@history) s.value
+                  FROM meta.delivery_stat s,
+                       meta.delivery d
+                 WHERE s.delivery_id  = d.id
+                   AND s.check_type   = @check_type
+                   AND s.column_name  = COALESCE(@column_name, '')
+                   AND d.agreement_id = @agreement_id
+                   AND d.id          <> @delivery_id
+                   AND EXISTS (SELECT 1 FROM meta.audit a WHERE a.delivery_id = d.id AND a.stage_id = 3)
+                 ORDER BY d.status_date DESC, d.id DESC) h
+
+        --| Evaluate bounds and drift
+        SET @breach = NULL
+        IF @value IS NULL AND @check_type <> 'NULL_RATE'
+            SET @breach = 'no value'
+        ELSE IF @value < @min_value
+            SET @breach = 'below minimum [' + LTRIM(STR(@min_value, 25, 4)) + ']'
+        ELSE IF @value > @max_value
+            SET @breach = 'above maximum [' + LTRIM(STR(@max_value, 25, 4)) + ']'
+        ELSE IF @tolerance IS NOT NULL AND @count > 0 AND @check_type = 'NULL_RATE' AND ABS(COALESCE(@value, 0) - @average) > @tolerance
+            SET @breach = 'deviates more than [' + LTRIM(STR(@tolerance, 25, 4)) + '] points from average [' + LTRIM(STR(@average, 25, 4)) + ']'
+        ELSE IF @tolerance IS NOT NULL AND @count > 0 AND @check_type <> 'NULL_RATE' AND ABS(@value - @average) > ABS(@average) * @tolerance / 100.0
+            SET @breach = 'deviates more than [' + LTRIM(STR(@tolerance, 25, 4)) + ']% from average [' + LTRIM(STR(@average, 25, 4)) + ']'
+
+        IF @breach IS NOT NULL
+        BEGIN
+            SET @msg = 'Check [' + CAST(@check_id AS NVARCHAR) + '] ' + @check_type
+                     + COALESCE(' [' + @column_name + ']', '')
+                     + ' value [' + COALESCE(LTRIM(STR(@value, 25, 4)), 'NULL') + '] ' + @breach
+            EXEC meta.debug @@PROCID, @msg
+            EXEC meta.operation_add @audit_id, @status_id, @@PROCID, @msg
+            IF @status_id > @max_status SET @max_status = @status_id
+        END
+
+        --+ Repeat (daft MS SQL) loop
+        FETCH NEXT FROM chk INTO @check_id, @check_type, @column_name, @tolerance, @min_value, @max_value, @history, @status_id
+    END
+    CLOSE chk
+    DEALLOCATE chk
+
+    --| Log the overall outcome in the operation trail
+    IF @check_count > 0 AND @max_status = 1
+        EXEC meta.operation_add @audit_id, 1, @@PROCID, 'Delivery checks passed'
+
+    --| Failed checks are returned - not raised - so the breaches logged above are kept (and
+    --| alerted on) even if the caller rolls back on errors
+    IF @max_status >= 3
+    BEGIN
+        EXEC meta.debug @@PROCID, 'Delivery checks failed - check the operation log'
+        RETURN 2
+    END
+
+    --| SUCCESS handling
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+DROP PROCEDURE [meta].[delivery_check]
+;
+DROP PROCEDURE [meta].[agreement_check_add]
+;
+DROP TABLE [meta].[delivery_stat]
+;
+DROP TABLE [meta].[agreement_check]
+;
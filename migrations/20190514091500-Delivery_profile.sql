@@ -0,0 +1,279 @@
+
+-- +migrate Up
+CREATE TABLE[meta].[delivery_profile]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [delivery_id] [bigint] NOT NULL,
+
+    [column_name] [nvarchar] (128) NOT NULL,
+
+    [ordinal_position] [int] NOT NULL,
+
+    [data_type] [nvarchar] (128) NULL,
+
+    [row_count] [bigint] NULL,
+
+    [null_count] [bigint] NULL,
+
+    [distinct_count] [bigint] NULL,
+
+    [min_value] [nvarchar] (4000) NULL,
+
+    [max_value] [nvarchar] (4000) NULL,
+
+    [min_length] [int] NULL,
+
+    [max_length] [int] NULL,
+
+    [avg_length] [float] NULL,
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_delivery_profile_createdtm] DEFAULT(getdate()),
+ CONSTRAINT[PK_delivery_profile] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[delivery_profile] WITH CHECK ADD CONSTRAINT[FK_delivery_profile_delivery] FOREIGN KEY([delivery_id])
+REFERENCES[meta].[delivery]
+        ([id])
+ON DELETE CASCADE
+;
+CREATE TABLE[meta].[delivery_profile_value]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [delivery_id] [bigint] NOT NULL,
+
+    [column_name] [nvarchar] (128) NOT NULL,
+
+    [profile_type] [nvarchar] (20) NOT NULL,
+
+    [value] [nvarchar] (4000) NULL,
+
+    [value_count] [bigint] NOT NULL,
+ CONSTRAINT[PK_delivery_profile_value] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[delivery_profile_value] WITH CHECK ADD CONSTRAINT[FK_delivery_profile_value_delivery] FOREIGN KEY([delivery_id])
+REFERENCES[meta].[delivery]
+        ([id])
+ON DELETE CASCADE
+;
+INSERT INTO [meta].[attribute] (name, description, default_value, options)
+SELECT 'PROFILE_TOP_N', 'Number of most frequent values and patterns kept per column when profiling a delivery in stag - 0 disables profiling', '10', '0,5,10,20,50'
+;
+CREATE
+PROCEDURE[meta].[delivery_profile] --|
+--| ==========================================================================================
+--| Description: Profile a delivery loaded into stag by computing per column statistics
+--|              (null count, distinct count, min/max, length) along with the top-N values,
+--|              length distribution and pattern histogram (letters => A, digits => 9).
+--|              The results are stored in meta.delivery_profile and
+--|              meta.delivery_profile_value replacing any previous profile of the delivery.
+--| Arguments:             
+(
+    @name NVARCHAR(250)  --| Name of file to match against the agreement pattern
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @sql           NVARCHAR(MAX)
+    DECLARE @temp2stag     NVARCHAR(1000)
+    DECLARE @agreement_id  BIGINT
+    DECLARE @delivery_id   BIGINT
+    DECLARE @audit_id      BIGINT
+    DECLARE @stag_schema   NVARCHAR(50)
+    DECLARE @stag_name     NVARCHAR(100)
+    DECLARE @top_n         INT
+    DECLARE @top_n_c       NVARCHAR(50)
+
+    --| Based on name pattern, lookup the agreement from meta.agreement table
+    EXEC meta.debug @@PROCID, 'Lookup active agreement from delivery.name LIKE agreement.pattern'
+    EXEC meta.agreement_find @name, 2, @agreement_id OUT, @temp2stag OUT
+    IF @agreement_id IS NULL
+    BEGIN
+        RAISERROR ('Error looking up agreement [%s]', 11, 1, @name)
+        RETURN 2
+    END
+
+    --| Get the PROFILE_TOP_N attribute (0 => profiling disabled)
+    SELECT @top_n_c = value
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+       AND attribute_name = 'PROFILE_TOP_N'
+
+    -- + Set default 10
+    SET @top_n = 10
+    -- + Override with actual value if valid numeric
+    IF meta.check_numeric(@top_n_c, 12, 0) = 0 SET @top_n = CAST(@top_n_c AS INT)
+    IF @top_n <= 0
+    BEGIN
+        EXEC meta.debug @@PROCID, 'Profiling disabled'
+        RETURN
+    END
+
+    --| Lookup the delivery and its stag audit
+    SELECT @delivery_id = MAX(d.id),
+           @audit_id    = MAX(a.id)
+      FROM meta.delivery d,
+           meta.audit a
+     WHERE d.id           = a.delivery_id
+       AND a.stage_id     = 2
+       AND d.agreement_id = @agreement_id
+       AND d.name         = @name
+
+    IF @delivery_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] not available in stag', 11, 1, @name)
+        RETURN 4
+    END
+
+    --+ Stag table
+    SELECT @stag_name   = table_name,
+           @stag_schema = table_schema
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'stag'
+
+    IF @stag_name IS NULL
+    BEGIN
+        RAISERROR('Stag table not found for delivery [%s]', 11, 1, @name)
+        RETURN 6
+    END
+
+    --| BEGIN controlled transaction
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        --| Remove previous profile of the delivery
+        DELETE FROM meta.delivery_profile       WHERE delivery_id = @delivery_id
+        DELETE FROM meta.delivery_profile_value WHERE delivery_id = @delivery_id
+
+        --| Loop over the columns of the stag table
+        DECLARE @column_name      NVARCHAR(128)
+        DECLARE @data_type        NVARCHAR(128)
+        DECLARE @ordinal_position INT
+        DECLARE @col              NVARCHAR(300)
+        DECLARE @txt              NVARCHAR(400)
+        DECLARE @from             NVARCHAR(400)
+        DECLARE @column_count     INT
+        SET @column_count = 0
+        SET @from = ' FROM [' + @stag_schema + '].[' + @stag_name + '] WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+
+        DECLARE rec CURSOR FOR
+        SELECT SUBSTRING(column_name, 2, LEN(column_name) - 2), data_type, ordinal_position
+          FROM meta.column_mapping_v
+         WHERE agreement_id = @agreement_id
+           AND table_schema = 'stag'
+           AND column_name <> '[dw_delivery_id]'
+         ORDER BY ordinal_position
+
+        OPEN rec
+
+        --+ Prepare (daft MS SQL) loop
+        FETCH NEXT FROM rec INTO @column_name, @data_type, @ordinal_position
+
+        WHILE @@FETCH_STATUS = 0
+        BEGIN
+            SET @column_count = @column_count + 1
+            --+ BIT cannot be aggregated with MIN/MAX
+            SET @col = CASE WHEN @data_type = 'bit' THEN 'CAST(' + QUOTENAME(@column_name) + ' AS INT)' ELSE QUOTENAME(@column_name) END
+            SET @txt = 'CAST(' + QUOTENAME(@column_name) + ' AS NVARCHAR(4000))'
+            SET @msg = '  PROFILE [' + @column_name + '] [' + @data_type + ']'
+            EXEC meta.debug @@PROCID, @msg
+
+            --| Column statistics
+            SET @sql = 'INSERT INTO meta.delivery_profile '
+                     + '(delivery_id, column_name, ordinal_position, data_type, row_count, null_count, distinct_count, min_value, max_value, min_length, max_length, avg_length) '
+                     + 'SELECT @delivery_id, @column_name, @ordinal_position, @data_type, COUNT(*), '
+                     + 'SUM(CASE WHEN ' + @col + ' IS NULL THEN 1 ELSE 0 END), '
+                     + 'COUNT(DISTINCT ' + @col + '), '
+                     + 'CAST(MIN(' + @col + ') AS NVARCHAR(4000)), '
+                     + 'CAST(MAX(' + @col + ') AS NVARCHAR(4000)), '
+                     + 'MIN(LEN(' + @txt + ')), '
+                     + 'MAX(LEN(' + @txt + ')), '
+                     + 'AVG(CAST(LEN(' + @txt + ') AS FLOAT))'
+                     + @from
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql, N'@delivery_id BIGINT, @column_name NVARCHAR(128), @ordinal_position INT, @data_type NVARCHAR(128)',
+                 @delivery_id = @delivery_id, @column_name = @column_name, @ordinal_position = @ordinal_position, @data_type = @data_type
+
+            --| Top-N most frequent values
+            SET @sql = 'INSERT INTO meta.delivery_profile_value (delivery_id, column_name, profile_type, value, value_count) '
+                     + 'SELECT TOP (@top_n) @delivery_id, @column_name, ''TOP'', ' + @txt + ', COUNT(*)'
+                     + @from
+                     + ' GROUP BY ' + @txt
+                     + ' ORDER BY COUNT(*) DESC'
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql, N'@top_n INT, @delivery_id BIGINT, @column_name NVARCHAR(128)',
+                 @top_n = @top_n, @delivery_id = @delivery_id, @column_name = @column_name
+
+            --| Length distribution
+            SET @sql = 'INSERT INTO meta.delivery_profile_value (delivery_id, column_name, profile_type, value, value_count) '
+                     + 'SELECT @delivery_id, @column_name, ''LENGTH'', CAST(LEN(' + @txt + ') AS NVARCHAR), COUNT(*)'
+                     + @from
+                     + ' GROUP BY LEN(' + @txt + ')'
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql, N'@delivery_id BIGINT, @column_name NVARCHAR(128)',
+                 @delivery_id = @delivery_id, @column_name = @column_name
+
+            --| Pattern histogram (letters => A, digits => 9, other characters kept)
+            SET @txt = 'TRANSLATE(UPPER(LEFT(' + @txt + ', 50)), ''ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789'', ''AAAAAAAAAAAAAAAAAAAAAAAAAA9999999999'')'
+            SET @sql = 'INSERT INTO meta.delivery_profile_value (delivery_id, column_name, profile_type, value, value_count) '
+                     + 'SELECT TOP (@top_n) @delivery_id, @column_name, ''PATTERN'', ' + @txt + ', COUNT(*)'
+                     + @from
+                     + ' GROUP BY ' + @txt
+                     + ' ORDER BY COUNT(*) DESC'
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql, N'@top_n INT, @delivery_id BIGINT, @column_name NVARCHAR(128)',
+                 @top_n = @top_n, @delivery_id = @delivery_id, @column_name = @column_name
+
+            --+ Repeat (daft MS SQL) loop
+            FETCH NEXT FROM rec INTO @column_name, @data_type, @ordinal_position
+        END
+        CLOSE rec
+        DEALLOCATE rec
+
+        SET @msg = 'Delivery profiled [' + CAST(@column_count AS NVARCHAR) + '] columns'
+        EXEC meta.operation_add @audit_id, 1, @@PROCID, @msg
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        --| Log in audit as warning (profiling never blocks a delivery) and rollback
+        IF @@trancount > 0 ROLLBACK TRANSACTION
+        IF CURSOR_STATUS('global', 'rec') >= -1 DEALLOCATE rec
+        EXEC meta.operation_add @audit_id, 2, @@PROCID, NULL
+        EXEC meta.debug @@PROCID, 'Profiling delivery failed'
+        --| Return error code
+        RETURN 10
+    END CATCH
+
+    --| SUCCESS handling
+    EXEC meta.debug @@PROCID, 'DONE'
+        --| COMMIT controlled transaction
+    COMMIT TRANSACTION
+        --| Return success
+    RETURN
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+DROP PROCEDURE [meta].[delivery_profile]
+;
+DELETE FROM [meta].[agreement_attribute]
+ WHERE attribute_id IN (SELECT id FROM [meta].[attribute] WHERE name = 'PROFILE_TOP_N')
+;
+DELETE FROM [meta].[attribute]
+ WHERE name = 'PROFILE_TOP_N'
+;
+DROP TABLE [meta].[delivery_profile_value]
+;
+DROP TABLE [meta].[delivery_profile]
+;
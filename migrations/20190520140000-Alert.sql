@@ -0,0 +1,81 @@
+
+-- +migrate Up
+CREATE TABLE[meta].[alert]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [alert_key] [nvarchar] (200) NOT NULL,
+
+    [alert_type] [nvarchar] (20) NOT NULL,
+
+    [agreement_id] [bigint] NULL,
+
+    [delivery_id] [bigint] NULL,
+
+    [message] [nvarchar] (1000) NULL,
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_alert_createdtm] DEFAULT(getdate()),
+
+    [resolvedtm] [datetime] NULL,
+ CONSTRAINT[PK_alert] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+CREATE
+VIEW[meta].[alert_condition_v] --|
+--| ==========================================================================================
+--| Description: Current alert conditions per agreement evaluated by the daemon monitor:
+--|                LATE       - no delivery within the expected frequency (days)
+--|                VALIDATION - latest delivery failed validation or delivery checks
+--|                TRIGGER    - a trigger failed on the latest delivery
+--|              The alert_key identifies the condition for de-duplication of notifications
+--| ==========================================================================================
+AS
+SELECT 'LATE:' + CAST(a.id AS NVARCHAR) AS alert_key,
+       'LATE' AS alert_type,
+       a.id AS agreement_id,
+       a.name AS agreement_name,
+       CAST(NULL AS BIGINT) AS delivery_id,
+       'Delivery for agreement [' + a.name + '] is late - expected every [' + CAST(a.frequency AS NVARCHAR)
+       + '] days, latest status date [' + COALESCE(CONVERT(NVARCHAR(10), MAX(d.status_date), 120), 'none') + ']' AS message
+  FROM meta.agreement a
+       LEFT OUTER JOIN
+       meta.delivery d ON (d.agreement_id = a.id)
+ WHERE a.frequency > 0
+ GROUP BY a.id, a.name, a.frequency, a.createdtm
+HAVING DATEDIFF(day, COALESCE(MAX(d.status_date), a.createdtm), GETDATE()) > a.frequency
+ UNION ALL
+SELECT CASE WHEN o.name = 'meta.delivery_trigger' THEN 'TRIGGER:' ELSE 'VALIDATION:' END + CAST(d.agreement_id AS NVARCHAR) AS alert_key,
+       CASE WHEN o.name = 'meta.delivery_trigger' THEN 'TRIGGER' ELSE 'VALIDATION' END AS alert_type,
+       d.agreement_id,
+       a.name AS agreement_name,
+       d.id AS delivery_id,
+       'Delivery [' + d.name + '] failed in [' + o.name + ']: ' + COALESCE(o.description, '') AS message
+  FROM meta.agreement a,
+       meta.delivery d,
+       meta.audit u,
+       meta.operation o
+ WHERE a.id = d.agreement_id
+   AND d.id = u.delivery_id
+   AND u.id = o.audit_id
+   --+ Latest delivery of the agreement only
+   AND d.id = (SELECT MAX(x.id) FROM meta.delivery x WHERE x.agreement_id = d.agreement_id)
+   --+ Most recent failing operation per kind (validation/check or trigger)
+   AND o.id = (SELECT MAX(p.id)
+                 FROM meta.operation p,
+                      meta.audit q
+                WHERE p.audit_id = q.id
+                  AND q.delivery_id = d.id
+                  AND (p.name = o.name OR (p.name IN ('meta.delivery_validate', 'meta.delivery_check') AND o.name IN ('meta.delivery_validate', 'meta.delivery_check'))))
+   AND o.name IN ('meta.delivery_validate', 'meta.delivery_check', 'meta.delivery_trigger')
+   AND o.status_id >= 3
+--| ==========================================================================================
+;
+
+-- +migrate Down
+DROP VIEW [meta].[alert_condition_v]
+;
+DROP TABLE [meta].[alert]
+;
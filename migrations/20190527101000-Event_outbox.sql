@@ -0,0 +1,221 @@
+
+-- +migrate Up
+CREATE TABLE[meta].[webhook]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [agreement_id] [bigint] NULL,
+
+    [url] [nvarchar] (1000) NOT NULL,
+
+    [secret] [nvarchar] (250) NOT NULL,
+
+    [events] [nvarchar] (250) NULL,
+
+    [active] [bit] NOT NULL CONSTRAINT[DF_webhook_active] DEFAULT((1)),
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_webhook_createdtm] DEFAULT(getdate()),
+ CONSTRAINT[PK_webhook] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[webhook] WITH CHECK ADD CONSTRAINT[FK_webhook_agreement] FOREIGN KEY([agreement_id])
+REFERENCES[meta].[agreement]
+        ([id])
+ON DELETE CASCADE
+;
+CREATE TABLE[meta].[event_outbox]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [webhook_id] [bigint] NOT NULL,
+
+    [event_type] [nvarchar] (20) NOT NULL,
+
+    [agreement_id] [bigint] NULL,
+
+    [delivery_id] [bigint] NULL,
+
+    [payload] [nvarchar] (max) NOT NULL,
+
+    [attempts] [int] NOT NULL CONSTRAINT[DF_event_outbox_attempts] DEFAULT((0)),
+
+    [next_attempt_dtm] [datetime] NOT NULL CONSTRAINT[DF_event_outbox_next_attempt_dtm] DEFAULT(getdate()),
+
+    [sent_dtm] [datetime] NULL,
+
+    [last_error] [nvarchar] (1000) NULL,
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_event_outbox_createdtm] DEFAULT(getdate()),
+ CONSTRAINT[PK_event_outbox] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[event_outbox] WITH CHECK ADD CONSTRAINT[FK_event_outbox_webhook] FOREIGN KEY([webhook_id])
+REFERENCES[meta].[webhook]
+        ([id])
+ON DELETE CASCADE
+;
+CREATE
+PROCEDURE[meta].[webhook_add] --|
+--| ==========================================================================================
+--| Description: Register an HTTP webhook receiving delivery events as signed JSON.The
+--|              payload is signed with HMAC-SHA256 using the secret (X-DW-Signature header)
+--| Arguments:
+(
+    @agreement_id BIGINT,         --| ID of agreement to receive events for - NULL => all
+    @url          NVARCHAR(1000), --| URL events are POSTed to
+    @secret       NVARCHAR(250),  --| Secret for signing the payload
+    @events       NVARCHAR(250),  --| Comma separated list of events - NULL => all
+                                  --| (loaded,validated,rejected,published,triggered,deleted)
+    @webhook_id   BIGINT OUTPUT   --| Returned ID of webhook
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @count INT
+
+    IF @agreement_id IS NOT NULL
+    BEGIN
+        SELECT @count = COUNT(*)
+          FROM meta.agreement
+         WHERE id = @agreement_id
+
+        IF @count = 0
+        BEGIN
+            RAISERROR ('Agreement [%I64d] does not exist', 11, 1, @agreement_id)
+            RETURN 2
+        END
+    END
+
+    IF NULLIF(@url, '') IS NULL OR NULLIF(@secret, '') IS NULL
+    BEGIN
+        RAISERROR ('Webhook requires both url and secret', 11, 1)
+        RETURN 3
+    END
+
+    INSERT INTO meta.webhook
+           (agreement_id, url, secret, events)
+    VALUES (@agreement_id, @url, @secret, REPLACE(LOWER(@events), ' ', ''))
+
+    SET @webhook_id = IDENT_CURRENT('meta.webhook')
+
+    -- | Return Success
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[event_add] --|
+--| ==========================================================================================
+--| Description: Add a delivery event to the outbox of every active webhook subscribing to
+--|              the event and agreement.The events are dispatched by the daemon.
+--| Arguments:
+(
+    @event_type  NVARCHAR(20),   --| Event (loaded,validated,rejected,published,triggered,deleted)
+    @name        NVARCHAR(250),  --| Name of delivery (file)
+    @description NVARCHAR(1000)  --| Optional human readable details (e.g. error message)
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id  BIGINT
+    DECLARE @temp2stag    NVARCHAR(1000)
+    DECLARE @payload      NVARCHAR(MAX)
+
+    --| Lookup the latest delivery by name (or the agreement if not yet added)
+    SELECT @delivery_id  = MAX(id)
+      FROM meta.delivery
+     WHERE name = @name
+
+    IF @delivery_id IS NOT NULL
+        SELECT @agreement_id = agreement_id
+          FROM meta.delivery
+         WHERE id = @delivery_id
+    ELSE
+        EXEC meta.agreement_find @name, 1, @agreement_id OUT, @temp2stag OUT
+
+    SET @payload = (SELECT @event_type                           AS event,
+                           @agreement_id                         AS agreement_id,
+                           a.name                                AS agreement_name,
+                           @delivery_id                          AS delivery_id,
+                           @name                                 AS delivery_name,
+                           CONVERT(NVARCHAR(10), d.status_date, 120) AS status_date,
+                           d.size                                AS delivery_size,
+                           @description                          AS description,
+                           CONVERT(NVARCHAR(30), GETDATE(), 126) AS createdtm
+                      FROM (SELECT 1 AS dummy) x
+                           LEFT OUTER JOIN
+                           meta.agreement a ON (a.id = @agreement_id)
+                           LEFT OUTER JOIN
+                           meta.delivery d ON (d.id = @delivery_id)
+                       FOR JSON PATH, WITHOUT_ARRAY_WRAPPER)
+
+    INSERT INTO meta.event_outbox
+           (webhook_id, event_type, agreement_id, delivery_id, payload)
+    SELECT w.id, @event_type, @agreement_id, @delivery_id, @payload
+      FROM meta.webhook w
+     WHERE w.active = 1
+       AND (w.agreement_id IS NULL OR w.agreement_id = @agreement_id)
+       AND (w.events IS NULL OR ',' + w.events + ',' LIKE '%,' + @event_type + ',%')
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+TRIGGER[meta].[delivery_deleted_event] --|
+--| ==========================================================================================
+--| Description: Add a deleted event to the outbox whenever deliveries are deleted - in the
+--|              same transaction, so the event exists only if the delete is committed
+--| ==========================================================================================
+ON [meta].[delivery]
+AFTER DELETE
+AS
+BEGIN
+    SET NOCOUNT ON
+
+    INSERT INTO meta.event_outbox
+           (webhook_id, event_type, agreement_id, delivery_id, payload)
+    SELECT w.id,
+           'deleted',
+           d.agreement_id,
+           d.id,
+           (SELECT 'deleted'                             AS event,
+                   d.agreement_id                        AS agreement_id,
+                   a.name                                AS agreement_name,
+                   d.id                                  AS delivery_id,
+                   d.name                                AS delivery_name,
+                   CONVERT(NVARCHAR(10), d.status_date, 120) AS status_date,
+                   d.size                                AS delivery_size,
+                   CONVERT(NVARCHAR(30), GETDATE(), 126) AS createdtm
+               FOR JSON PATH, WITHOUT_ARRAY_WRAPPER)
+      FROM deleted d
+           LEFT OUTER JOIN
+           meta.agreement a ON (a.id = d.agreement_id),
+           meta.webhook w
+     WHERE w.active = 1
+       AND (w.agreement_id IS NULL OR w.agreement_id = d.agreement_id)
+       AND (w.events IS NULL OR ',' + w.events + ',' LIKE '%,deleted,%')
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+DROP TRIGGER [meta].[delivery_deleted_event]
+;
+DROP PROCEDURE [meta].[event_add]
+;
+DROP PROCEDURE [meta].[webhook_add]
+;
+DROP TABLE [meta].[event_outbox]
+;
+DROP TABLE [meta].[webhook]
+;
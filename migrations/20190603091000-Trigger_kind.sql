@@ -0,0 +1,366 @@
+
+-- +migrate Up
+ALTER TABLE[meta].[agreement_trigger] ADD
+    [kind] [nvarchar] (20) NOT NULL CONSTRAINT[DF_agreement_trigger_kind] DEFAULT('SQL'),
+    [timeout_secs] [int] NULL,
+    [retries] [int] NULL
+;
+ALTER
+PROCEDURE[meta].[agreement_trigger_add] --|
+--| ==========================================================================================
+--| Author:      Soren Bak Larsen
+--| Description: Add a customized trigger call to a specific agreement for notifying consumers.
+--|              SQL triggers are executed by meta.delivery_trigger - all other kinds are
+--|              executed by the daemon after meta.delivery_trigger:
+--|                SQL     - call to stored procedure (or SQL that gets executed)
+--|                HTTP    - URL the delivery is POSTed to as JSON
+--|                EXPORT  - file (relative to OUTBOX) the delivery data is exported to
+--|                QUEUE   - queue (topic) the delivery is published to as JSON
+--|                COMMAND - command line executed by the daemon
+--|              @delivery_id in the trigger text is replaced by the ID of the delivery
+--| Arguments:
+(
+    @agreement_id BIGINT,              --| ID of meta.agreement the trigger should be linked to
+    @trigger_id   INT,                 --| Agreement specific trigger id (for log in error table)
+    @trigger_text NVARCHAR(1000),      --| SQL, URL, file, queue or command depending on kind
+    @description  NVARCHAR(1000),      --| Optional description for trigger
+    @kind         NVARCHAR(20) = 'SQL',--| Kind of trigger (SQL,HTTP,EXPORT,QUEUE,COMMAND)
+    @timeout_secs INT = NULL,          --| Timeout per attempt - NULL => daemon default
+    @retries      INT = NULL           --| Retries after a failed attempt - NULL => daemon default
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @table  NVARCHAR(200)
+
+    --| Look up the init table from agreement_id
+    SELECT @table = '[' + table_schema + '].[' + table_name + ']'
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND stage_id = 0
+
+    IF @table IS NULL
+    BEGIN
+        RAISERROR ('Agreement [%I64d] does not exist', 11, 1, @agreement_id)
+        RETURN 2
+    END
+
+    SET @kind = UPPER(COALESCE(@kind, 'SQL'))
+    IF @kind NOT IN ('SQL', 'HTTP', 'EXPORT', 'QUEUE', 'COMMAND')
+    BEGIN
+        RAISERROR ('Trigger kind [%s] is not supported', 11, 1, @kind)
+        RETURN 3
+    END
+
+    SET @msg = 'Trigger [' + CAST(@trigger_id AS NVARCHAR) + '] ' + @kind + '=[' + @trigger_text + ']'
+    EXEC meta.debug @@PROCID, @msg
+
+    --| Determine update or insert trigger
+    DECLARE @count INT
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_trigger
+     WHERE agreement_id = @agreement_id
+       AND trigger_id = @trigger_id
+
+    IF @count = 0
+        INSERT INTO meta.agreement_trigger
+               (agreement_id, trigger_id, trigger_text, description, kind, timeout_secs, retries)
+        VALUES (@agreement_id, @trigger_id, @trigger_text, @description, @kind, @timeout_secs, @retries)
+    ELSE
+        UPDATE meta.agreement_trigger
+           SET trigger_text = @trigger_text,
+               kind         = @kind,
+               timeout_secs = @timeout_secs,
+               retries      = @retries
+         WHERE agreement_id = @agreement_id
+           AND trigger_id   = @trigger_id
+
+    -- | Return Success
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+ALTER
+PROCEDURE[meta].[delivery_trigger] --|
+--| ==========================================================================================
+--| Author:      Soren Bak Larsen
+--| Description: Call stored procedures for triggering external consumers of delivery.Only
+--|              SQL triggers are executed here - other kinds are executed by the daemon
+--|              
+--| Arguments:             
+(
+    @name NVARCHAR(250)  --| Name of file to match against the agreement pattern
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg          NVARCHAR(4000)
+    DECLARE @trigger_text NVARCHAR(1000)
+    DECLARE @count        INT
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id  BIGINT
+    DECLARE @audit_id     BIGINT
+    DECLARE @table_id     BIGINT
+
+    --| Based on name pattern, lookup the agreement from meta.agreement table
+    EXEC meta.debug @@PROCID, 'Lookup active agreement from delivery.name LIKE agreement.pattern'
+    EXEC meta.agreement_find @name, 3, @agreement_id OUT, @trigger_text OUT
+    IF @agreement_id IS NULL
+    BEGIN
+        RAISERROR ('Error looking up agreement [%s]', 11, 1, @name)
+        RETURN 2
+    END
+
+    --| (Re-)promote delivery to repo
+    EXEC meta.delivery_add @agreement_id, 3, @name, NULL, 0, 'TRIGGER', @delivery_id OUT, @audit_id OUT, @table_id OUT
+
+    IF @delivery_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] for repository not available', 11, 1, @name)
+        RETURN 4
+    END
+
+    --| Get al triggers for looping in order
+    DECLARE a_rec CURSOR FOR
+    SELECT REPLACE(trigger_text, '@delivery_id', CAST(@delivery_id AS NVARCHAR))
+      FROM meta.agreement_trigger
+     WHERE agreement_id = @agreement_id
+       AND kind = 'SQL'
+     ORDER BY trigger_id
+        
+    OPEN a_rec
+        
+    FETCH NEXT FROM a_rec INTO @trigger_text
+
+    EXEC meta.debug @@PROCID, 'Loop over triggers'
+    WHILE @@FETCH_STATUS = 0
+    BEGIN
+        BEGIN TRANSACTION
+
+        BEGIN TRY
+            EXEC meta.debug @@PROCID, @trigger_text
+            EXEC sp_executesql @trigger_text
+
+        END TRY
+        --| ERROR handling
+        BEGIN CATCH
+            --| Log in audit and rollback transaction
+            IF @@trancount > 0 ROLLBACK TRANSACTION
+            IF @audit_id IS NOT NULL EXEC meta.operation_add @audit_id, 3, @@PROCID, @trigger_text
+            EXEC meta.debug @@PROCID, 'Triggering delivery failed'
+
+            FETCH NEXT FROM a_rec INTO @trigger_text
+            CONTINUE
+        END CATCH
+
+        --| SUCCESS handling
+        EXEC meta.debug @@PROCID, 'DONE'
+        --| COMMIT controlled transaction
+        COMMIT TRANSACTION
+
+        FETCH NEXT FROM a_rec INTO @trigger_text
+    END
+    CLOSE a_rec
+    DEALLOCATE a_rec
+    
+    --| Return success
+    RETURN
+    --| END
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[trigger_result_add] --|
+--| ==========================================================================================
+--| Description: Log the result of a trigger executed by the daemon as an operation on the
+--|              latest repository audit of the delivery - exactly like meta.delivery_trigger
+--|              logs failing SQL triggers
+--| Arguments:
+(
+    @delivery_id BIGINT,        --| ID of delivery the trigger was executed for
+    @trigger_id  BIGINT,        --| Agreement specific trigger id
+    @status_id   BIGINT,        --| ID of status of the result (1 = OK, 3 = ERROR)
+    @description NVARCHAR(250)  --| Human readable result
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @audit_id BIGINT
+    DECLARE @pid      BIGINT
+
+    SELECT @audit_id = MAX(id)
+      FROM meta.audit
+     WHERE delivery_id = @delivery_id
+       AND stage_id = 3
+
+    IF @audit_id IS NULL
+    BEGIN
+        RAISERROR ('Delivery [%I64d] for repository not available', 11, 1, @delivery_id)
+        RETURN 2
+    END
+
+    --| Log as meta.delivery_trigger so alerts cover all trigger kinds
+    SET @pid = OBJECT_ID('meta.delivery_trigger')
+    EXEC meta.operation_add @audit_id, @status_id, @pid, @description
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+DROP PROCEDURE [meta].[trigger_result_add]
+;
+ALTER
+PROCEDURE[meta].[agreement_trigger_add] --|
+--| ==========================================================================================
+--| Author:      Soren Bak Larsen
+--| Description: Add a customized trigger call to a specific agreement for notifying consumers
+--| Arguments:
+(
+    @agreement_id BIGINT,        --| ID of meta.agreement the trigger should be linked to
+    @trigger_id   INT,           --| Agreement specific trigger id (for log in error table)
+    @trigger_text NVARCHAR(1000),--| Call to stored procedure (or SQL that gets executed)
+    @description  NVARCHAR(1000) --| Optional description for trigger
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(4000)
+    DECLARE @table  NVARCHAR(200)
+
+    --| Look up the init table from agreement_id
+    SELECT @table = '[' + table_schema + '].[' + table_name + ']'
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND stage_id = 0
+
+    IF @table IS NULL
+    BEGIN
+        RAISERROR ('Agreement [%I64d] does not exist', 11, 1, @agreement_id)
+        RETURN 2
+    END
+
+    SET @msg = 'Trigger [' + CAST(@trigger_id AS NVARCHAR) + ']=[' + @trigger_text + ']'
+    EXEC meta.debug @@PROCID, @msg
+
+    --| Determine update or insert trigger
+    DECLARE @count INT
+    SELECT @count = COUNT(*)
+      FROM meta.agreement_trigger
+     WHERE agreement_id = @agreement_id
+       AND trigger_id = @trigger_id
+
+    IF @count = 0
+        INSERT INTO meta.agreement_trigger
+               (agreement_id, trigger_id, trigger_text, description)
+        VALUES (@agreement_id, @trigger_id, @trigger_text, @description)
+    ELSE
+        UPDATE meta.agreement_trigger
+           SET trigger_text = @trigger_text
+         WHERE agreement_id = @agreement_id
+           AND trigger_id   = @trigger_id
+
+    -- | Return Success
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+ALTER
+PROCEDURE[meta].[delivery_trigger] --|
+--| ==========================================================================================
+--| Author:      Soren Bak Larsen
+--| Description: Call stored procedures for triggering external consumers of delivery.
+--|              
+--| Arguments:             
+(
+    @name NVARCHAR(250)  --| Name of file to match against the agreement pattern
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg          NVARCHAR(4000)
+    DECLARE @trigger_text NVARCHAR(1000)
+    DECLARE @count        INT
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id  BIGINT
+    DECLARE @audit_id     BIGINT
+    DECLARE @table_id     BIGINT
+
+    --| Based on name pattern, lookup the agreement from meta.agreement table
+    EXEC meta.debug @@PROCID, 'Lookup active agreement from delivery.name LIKE agreement.pattern'
+    EXEC meta.agreement_find @name, 3, @agreement_id OUT, @trigger_text OUT
+    IF @agreement_id IS NULL
+    BEGIN
+        RAISERROR ('Error looking up agreement [%s]', 11, 1, @name)
+        RETURN 2
+    END
+
+    --| (Re-)promote delivery to repo
+    EXEC meta.delivery_add @agreement_id, 3, @name, NULL, 0, 'TRIGGER', @delivery_id OUT, @audit_id OUT, @table_id OUT
+
+    IF @delivery_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] for repository not available', 11, 1, @name)
+        RETURN 4
+    END
+
+    --| Get al triggers for looping in order
+    DECLARE a_rec CURSOR FOR
+    SELECT REPLACE(trigger_text, '@delivery_id', CAST(@delivery_id AS NVARCHAR))
+      FROM meta.agreement_trigger
+     WHERE agreement_id = @agreement_id
+     ORDER BY trigger_id
+        
+    OPEN a_rec
+        
+    FETCH NEXT FROM a_rec INTO @trigger_text
+
+    EXEC meta.debug @@PROCID, 'Loop over triggers'
+    WHILE @@FETCH_STATUS = 0
+    BEGIN
+        BEGIN TRANSACTION
+
+        BEGIN TRY
+            EXEC meta.debug @@PROCID, @trigger_text
+            EXEC sp_executesql @trigger_text
+
+        END TRY
+        --| ERROR handling
+        BEGIN CATCH
+            --| Log in audit and rollback transaction
+            IF @@trancount > 0 ROLLBACK TRANSACTION
+            IF @audit_id IS NOT NULL EXEC meta.operation_add @audit_id, 3, @@PROCID, @trigger_text
+            EXEC meta.debug @@PROCID, 'Triggering delivery failed'
+
+            FETCH NEXT FROM a_rec INTO @trigger_text
+            CONTINUE
+        END CATCH
+
+        --| SUCCESS handling
+        EXEC meta.debug @@PROCID, 'DONE'
+        --| COMMIT controlled transaction
+        COMMIT TRANSACTION
+
+        FETCH NEXT FROM a_rec INTO @trigger_text
+    END
+    CLOSE a_rec
+    DEALLOCATE a_rec
+    
+    --| Return success
+    RETURN
+    --| END
+END
+--| ==========================================================================================
+;
+DELETE FROM [meta].[agreement_trigger]
+ WHERE kind <> 'SQL'
+;
+ALTER TABLE [meta].[agreement_trigger] DROP CONSTRAINT [DF_agreement_trigger_kind]
+;
+ALTER TABLE [meta].[agreement_trigger] DROP COLUMN [kind], [timeout_secs], [retries]
+;
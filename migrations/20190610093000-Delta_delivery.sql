@@ -0,0 +1,533 @@
+
+-- +migrate Up
+INSERT INTO [meta].[attribute] (name, description, default_value, options)
+SELECT 'DELIVERY_MODE',          'FULL deliveries are complete snapshots. DELTA deliveries only contain changed rows which are applied as upserts (and deletes) by BUSINESS_KEY onto the current state', 'FULL', 'FULL,DELTA' UNION ALL
+SELECT 'BUSINESS_KEY',           'Comma separated list of columns identifying a row across DELTA deliveries',                                                                                         NULL,   NULL UNION ALL
+SELECT 'DELTA_OPERATION_COLUMN', 'Column of DELTA deliveries marking deleted rows with the value D - other values (e.g. I or U) are upserts',                                                    NULL,   NULL
+;
+CREATE
+FUNCTION [meta].[get_delta_sql] --|
+--| ==========================================================================================
+--| Description: Return the FROM clause (aliased x) reconstructing the current state of a
+--|              DELTA agreement as of a delivery, i.e.the latest row per business key from
+--|              the deliveries in repo up until @delivery_id - excluding deleted rows.
+--|              NULL is returned if the agreement is not a DELTA agreement.
+--| Arguments:
+(
+    @agreement_id BIGINT,  --| ID of agreement
+    @delivery_id  BIGINT   --| ID of delivery to return the state as of - NULL => latest
+)
+RETURNS NVARCHAR(MAX)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @mode      NVARCHAR(1000)
+    DECLARE @keys      NVARCHAR(1000)
+    DECLARE @operation NVARCHAR(1000)
+    DECLARE @repo_name NVARCHAR(100)
+    DECLARE @partition NVARCHAR(2000)
+
+    SELECT @mode      = MAX(CASE WHEN attribute_name = 'DELIVERY_MODE'          THEN value END),
+           @keys      = MAX(CASE WHEN attribute_name = 'BUSINESS_KEY'           THEN value END),
+           @operation = MAX(CASE WHEN attribute_name = 'DELTA_OPERATION_COLUMN' THEN value END)
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+
+    SELECT @repo_name = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+
+    IF COALESCE(@mode, 'FULL') <> 'DELTA' OR NULLIF(@keys, '') IS NULL OR @repo_name IS NULL RETURN NULL
+
+    --| Business key columns => PARTITION BY list
+    SELECT @partition = COALESCE(@partition + ', ', '') + '[' + LTRIM(RTRIM(value)) + ']'
+      FROM STRING_SPLIT(@keys, ',')
+     WHERE LTRIM(RTRIM(value)) <> ''
+
+    RETURN CAST('(SELECT r.*, ROW_NUMBER() OVER (PARTITION BY ' AS NVARCHAR(MAX)) + @partition
+         + ' ORDER BY r.dw_delivery_id DESC, r.dw_row_id DESC) AS dw_rank'
+         + ' FROM [repo].[' + @repo_name + '] r'
+         + COALESCE(' WHERE r.dw_delivery_id <= ' + CAST(@delivery_id AS NVARCHAR), '')
+         + ') x WHERE x.dw_rank = 1'
+         + COALESCE(' AND COALESCE(UPPER(CAST(x.[' + NULLIF(@operation, '') + '] AS NVARCHAR(10))), '''') <> ''D''', '')
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[agreement_delta_add] --|
+--| ==========================================================================================
+--| Description: Make an agreement a DELTA agreement with the business key (comma separated
+--|              columns) and optional operation column marking deleted rows (value D).The
+--|              current state is available in the view [repo].[<repo table>_current] and as
+--|              of any delivery using meta.get_data.A NULL business key reverts to FULL.
+--|              Agreements partitioned by frequency (1, 30 or 365) cannot be DELTA - the
+--|              state is kept in the one repo table of the agreement.
+--| Arguments:
+(
+    @agreement_id     BIGINT,         --| ID of agreement
+    @business_key     NVARCHAR(1000), --| Comma separated list of key columns - NULL => FULL
+    @operation_column NVARCHAR(128)   --| Column marking deleted rows - NULL => no deletes
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg       NVARCHAR(4000)
+    DECLARE @repo_name NVARCHAR(100)
+    DECLARE @missing   NVARCHAR(1000)
+    DECLARE @columns   NVARCHAR(MAX)
+    DECLARE @sql       NVARCHAR(MAX)
+
+    --| Look up the repo table from agreement_id
+    SELECT @repo_name = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+
+    IF @repo_name IS NULL
+    BEGIN
+        RAISERROR ('Agreement [%I64d] does not exist', 11, 1, @agreement_id)
+        RETURN 2
+    END
+
+    --| Revert to FULL deliveries
+    IF NULLIF(@business_key, '') IS NULL
+    BEGIN
+        EXEC meta.debug @@PROCID, 'Revert to FULL deliveries'
+        EXEC meta.agreement_attribute_add @agreement_id, 'DELIVERY_MODE', 'FULL'
+        DELETE FROM meta.agreement_attribute
+         WHERE agreement_id = @agreement_id
+           AND attribute_id IN (SELECT id FROM meta.attribute WHERE name IN ('BUSINESS_KEY', 'DELTA_OPERATION_COLUMN'))
+        SET @sql = 'DROP VIEW IF EXISTS [repo].[' + @repo_name + '_current]'
+        EXEC sp_executesql @sql
+        RETURN
+    END
+
+    --| The current state spans all deliveries - these must be in the same repo table
+    IF EXISTS (SELECT 1 FROM meta.agreement WHERE id = @agreement_id AND frequency IN (1, 30, 365))
+    BEGIN
+        RAISERROR ('Agreement [%I64d] is partitioned by frequency and cannot be DELTA', 11, 1, @agreement_id)
+        RETURN 4
+    END
+
+    --| Check the business key and operation columns exist in repo
+    SELECT @missing = COALESCE(@missing + ',', '') + LTRIM(RTRIM(s.value))
+      FROM (SELECT value FROM STRING_SPLIT(@business_key, ',')
+             UNION ALL
+            SELECT @operation_column WHERE NULLIF(@operation_column, '') IS NOT NULL) s
+     WHERE NOT EXISTS (SELECT 1
+                         FROM meta.column_mapping_v c
+                        WHERE c.agreement_id = @agreement_id
+                          AND c.table_schema = 'repo'
+                          AND c.column_name  = '[' + LTRIM(RTRIM(s.value)) + ']')
+
+    IF @missing IS NOT NULL
+    BEGIN
+        RAISERROR ('Columns [%s] do not exist in repo table [%s]', 11, 1, @missing, @repo_name)
+        RETURN 3
+    END
+
+    SET @msg = 'DELTA [' + @repo_name + '] key [' + @business_key + '] operation [' + COALESCE(@operation_column, '') + ']'
+    EXEC meta.debug @@PROCID, @msg
+
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        EXEC meta.agreement_attribute_add @agreement_id, 'DELIVERY_MODE', 'DELTA'
+        EXEC meta.agreement_attribute_add @agreement_id, 'BUSINESS_KEY', @business_key
+        IF NULLIF(@operation_column, '') IS NOT NULL
+            EXEC meta.agreement_attribute_add @agreement_id, 'DELTA_OPERATION_COLUMN', @operation_column
+        ELSE
+            DELETE FROM meta.agreement_attribute
+             WHERE agreement_id = @agreement_id
+               AND attribute_id IN (SELECT id FROM meta.attribute WHERE name = 'DELTA_OPERATION_COLUMN')
+
+        --| (Re-)create the current state view with the repo columns
+        SELECT @columns = COALESCE(@columns + ', ', '') + 'x.' + column_name
+          FROM meta.column_mapping_v
+         WHERE agreement_id = @agreement_id
+           AND table_schema = 'repo'
+         ORDER BY ordinal_position
+
+        SET @sql = CAST('CREATE OR ALTER VIEW [repo].[' + @repo_name + '_current] AS SELECT ' AS NVARCHAR(MAX))
+                 + @columns + CAST(' FROM ' AS NVARCHAR(MAX)) + meta.get_delta_sql(@agreement_id, NULL)
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        IF @@trancount > 0 ROLLBACK TRANSACTION
+        SET @msg = ERROR_MESSAGE()
+        RAISERROR ('Making agreement [%I64d] DELTA failed: %s', 11, 1, @agreement_id, @msg)
+        RETURN 10
+    END CATCH
+
+    COMMIT TRANSACTION
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+ALTER
+PROCEDURE[meta].[get_data] --|
+--| ==========================================================================================
+--| Description: Return the dataset related to the delivery agreement matching the date/time,
+--|              i.e.delivered as the latest delivery BEFORE the @delivery_date provided.
+--|              Defaults to current date/time.If @delivery_id is provided, any date/time
+--|              constraints are overridden.For DELTA agreements the current state as of
+--|              the delivery is returned (see meta.get_delta_sql)
+--| Arguments:
+(
+    @username NVARCHAR(250), --| Username of requestor
+@name                  NVARCHAR(250), --| Name of agreement
+@external_id           BIGINT,        --| ID of external data item(e.g. in AAC)
+    @delivery_date NVARCHAR(25),  --| Date string (YYYY-MM-DD[HH24:MI:SS]) of latest
+                                          --| delivery up until
+    @delivery_id           BIGINT         --| ID of delivery - blank/NULL => latest based on 
+                                          --| @delivery_date
+)
+AS 
+SET NOCOUNT ON
+SET ANSI_WARNINGS OFF
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @table_schema NVARCHAR(50)
+    DECLARE @table_name   NVARCHAR(100)
+    DECLARE @agreement_id BIGINT
+    DECLARE @sql NVARCHAR(MAX)
+    DECLARE @date         DATETIME
+    DECLARE @user_id BIGINT
+    DECLARE @delta        NVARCHAR(MAX)
+
+    --| Setup the date for latest delivery
+    SET @date = COALESCE(CONVERT(DATETIME, @delivery_date, 120), GETDATE())
+
+    -- | Collect meta data
+    --+ Get the agreement_id based on name
+    SELECT @agreement_id = id
+      FROM meta.agreement
+     WHERE name = @name
+
+    -- | Check user permissions
+    SET @user_id = meta.user_access(@username, @agreement_id, 'VIEW')
+    IF COALESCE(@user_id, 0) = 0 
+    BEGIN
+        --+ Lookup the user(without VIEW permissions) in order to log retrieval attempt
+       SELECT @user_id = id
+         FROM meta.[user]
+        WHERE username = @username
+
+        --+ Insert the failed link in status error
+        INSERT INTO meta.[link]
+               (external_id, dw_delivery_id, user_id, status_id)
+        VALUES(@external_id, @delivery_id, @user_id, 2)
+
+
+        RAISERROR('User [%s] does not have VIEW permission on agreement [%I64d]', 11, 1, @username, @agreement_id)
+        RETURN 2
+    END
+
+    --+ TODO: ERROR-HANDLING
+
+    --| Get the MAX_AGE_DAYS attribute for limiting the retrieval back in time
+    DECLARE @max_age_days INT
+    DECLARE @max_age_days_c NVARCHAR(50)
+    SELECT @max_age_days_c = value
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+       AND attribute_name = 'MAX_AGE_DAYS'
+
+    -- + Set default 7
+    SET @max_age_days = 7
+    -- + Override with actual value if valid numeric
+    IF meta.check_numeric(@max_age_days_c, 12, 0) = 0 SET @max_age_days = CAST(@max_age_days_c AS INT)
+    EXEC meta.debug @@PROCID, @max_age_days
+    
+    --| Get MAX delivery id with[@date - @max_age_days <= createdtm <= @date] if no specific 
+    --| delivery id is provided
+    --+ NOTE: The state of DELTA agreements does not age, so any earlier delivery will do
+    SET @delta = meta.get_delta_sql(@agreement_id, NULL)
+    IF COALESCE(@delivery_id, 0) = 0
+        SELECT @delivery_id = MAX(id)
+          FROM meta.delivery
+         WHERE agreement_id = @agreement_id
+           AND (status_date BETWEEN @date - @max_age_days AND @date
+                OR (status_date <= @date AND @delta IS NOT NULL))
+
+    EXEC meta.debug @@PROCID, @agreement_id
+    EXEC meta.debug @@PROCID, @delivery_id
+    
+    --+ Get repo table name
+    SELECT @table_schema = table_schema,
+           @table_name   = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+
+    -- + TODO: ERROR-HANDLING
+
+    --+ Get the columns for the repo table
+    DECLARE rec CURSOR FOR
+    SELECT column_name,
+           data_type,
+           character_maximum_length,
+           numeric_precision,
+           numeric_scale
+      FROM meta.column_mapping_v
+     WHERE table_schema = @table_schema
+       AND table_name = @table_name
+     ORDER BY ordinal_position
+
+    --+ Open cursor
+    OPEN rec
+
+    --+ Prepare (daft MS SQL) variables
+    DECLARE @column_name NVARCHAR(128)
+    DECLARE @data_type                 NVARCHAR(128)
+    DECLARE @character_maximum_length  INT
+    DECLARE @numeric_precision TINYINT
+    DECLARE @numeric_scale             INT
+    DECLARE @col NVARCHAR(500)
+
+    SET @sql = CAST('SELECT ' AS NVARCHAR(MAX))
+
+    -- + Prepare(daft MS SQL) loop
+    FETCH NEXT FROM rec
+    INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+    --| Loop over columns in repo table for delivery
+    EXEC meta.debug @@PROCID, 'Loop over list of columns'
+    WHILE @@FETCH_STATUS = 0
+    BEGIN
+        --| MAP: 
+        SET @col =
+            CASE
+                --| NVARCHAR(MAX) => NVARCHAR(4000) due to GUI/AAC cannot handle MAX
+                WHEN @data_type = 'nvarchar' AND @character_maximum_length = -1 THEN 'CAST(' + @column_name + ' AS NVARCHAR(MAX)) ' + @column_name
+                --| <other types> => - No mapping -
+                ELSE @column_name
+            END
+
+        --+ Repeat(daft MS SQL) fetch
+       FETCH NEXT FROM rec
+       INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+       EXEC meta.debug @@PROCID, @col
+       SET @sql = @sql + CAST(@col AS NVARCHAR(MAX))
+        IF @@FETCH_STATUS = 0 SET @sql = @sql + CAST(',' AS NVARCHAR(MAX))
+    END
+
+    EXEC meta.debug @@PROCID, 'Close and deallocate cursor'
+    CLOSE rec
+    DEALLOCATE rec
+
+    
+    --| Insert the link
+    INSERT INTO meta.[link]
+           (external_id, dw_delivery_id, user_id, status_id)
+    VALUES (@external_id, @delivery_id, @user_id, 1)
+
+    --| Finish and execute SQL statement outputting delivery table (or DELTA state)
+    SET @delta = meta.get_delta_sql(@agreement_id, @delivery_id)
+    IF @delta IS NOT NULL
+        SET @sql = @sql + CAST(' FROM ' AS NVARCHAR(MAX)) + @delta
+                        + CAST(' ORDER BY dw_delivery_id ASC, dw_row_id ASC' AS NVARCHAR(MAX))
+    ELSE
+        SET @sql = @sql + CAST(' FROM [' + @table_schema + '].[' + @table_name + '] '
+                        + 'WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR) AS NVARCHAR(MAX))
+                        + CAST(' ORDER BY dw_row_id ASC' AS NVARCHAR(MAX))
+
+
+    EXEC meta.debug @@PROCID, 'Execute query to return proper dataset'
+    EXEC meta.debug @@PROCID, @sql
+    EXEC sp_executesql @sql
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+ALTER
+PROCEDURE[meta].[get_data] --|
+--| ==========================================================================================
+--| Description: Return the dataset related to the delivery agreement matching the date/time,
+--|              i.e.delivered as the latest delivery BEFORE the @delivery_date provided.
+--|              Defaults to current date/time.If @delivery_id is provided, any date/time
+--|              constraints are overridden.
+--| Arguments:
+(
+    @username NVARCHAR(250), --| Username of requestor
+@name                  NVARCHAR(250), --| Name of agreement
+@external_id           BIGINT,        --| ID of external data item(e.g. in AAC)
+    @delivery_date NVARCHAR(25),  --| Date string (YYYY-MM-DD[HH24:MI:SS]) of latest
+                                          --| delivery up until
+    @delivery_id           BIGINT         --| ID of delivery - blank/NULL => latest based on 
+                                          --| @delivery_date
+)
+AS 
+SET NOCOUNT ON
+SET ANSI_WARNINGS OFF
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @table_schema NVARCHAR(50)
+    DECLARE @table_name   NVARCHAR(100)
+    DECLARE @agreement_id BIGINT
+    DECLARE @sql NVARCHAR(MAX)
+    DECLARE @date         DATETIME
+    DECLARE @user_id BIGINT
+
+    --| Setup the date for latest delivery
+    SET @date = COALESCE(CONVERT(DATETIME, @delivery_date, 120), GETDATE())
+
+    -- | Collect meta data
+    --+ Get the agreement_id based on name
+    SELECT @agreement_id = id
+      FROM meta.agreement
+     WHERE name = @name
+
+    -- | Check user permissions
+    SET @user_id = meta.user_access(@username, @agreement_id, 'VIEW')
+    IF COALESCE(@user_id, 0) = 0 
+    BEGIN
+        --+ Lookup the user(without VIEW permissions) in order to log retrieval attempt
+       SELECT @user_id = id
+         FROM meta.[user]
+        WHERE username = @username
+
+        --+ Insert the failed link in status error
+        INSERT INTO meta.[link]
+               (external_id, dw_delivery_id, user_id, status_id)
+        VALUES(@external_id, @delivery_id, @user_id, 2)
+
+
+        RAISERROR('User [%s] does not have VIEW permission on agreement [%I64d]', 11, 1, @username, @agreement_id)
+        RETURN 2
+    END
+
+    --+ TODO: ERROR-HANDLING
+
+    --| Get the MAX_AGE_DAYS attribute for limiting the retrieval back in time
+    DECLARE @max_age_days INT
+    DECLARE @max_age_days_c NVARCHAR(50)
+    SELECT @max_age_days_c = value
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+       AND attribute_name = 'MAX_AGE_DAYS'
+
+    -- + Set default 7
+    SET @max_age_days = 7
+    -- + Override with actual value if valid numeric
+    IF meta.check_numeric(@max_age_days_c, 12, 0) = 0 SET @max_age_days = CAST(@max_age_days_c AS INT)
+    EXEC meta.debug @@PROCID, @max_age_days
+    
+    --| Get MAX delivery id with[@date - @max_age_days <= createdtm <= @date] if no specific 
+    --| delivery id is provided
+    IF COALESCE(@delivery_id, 0) = 0
+        SELECT @delivery_id = MAX(id)
+          FROM meta.delivery
+         WHERE agreement_id = @agreement_id
+           AND status_date BETWEEN @date - @max_age_days AND @date
+
+    EXEC meta.debug @@PROCID, @agreement_id
+    EXEC meta.debug @@PROCID, @delivery_id
+    
+    --+ Get repo table name
+    SELECT @table_schema = table_schema,
+           @table_name   = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+
+    -- + TODO: ERROR-HANDLING
+
+    --+ Get the columns for the repo table
+    DECLARE rec CURSOR FOR
+    SELECT column_name,
+           data_type,
+           character_maximum_length,
+           numeric_precision,
+           numeric_scale
+      FROM meta.column_mapping_v
+     WHERE table_schema = @table_schema
+       AND table_name = @table_name
+     ORDER BY ordinal_position
+
+    --+ Open cursor
+    OPEN rec
+
+    --+ Prepare (daft MS SQL) variables
+    DECLARE @column_name NVARCHAR(128)
+    DECLARE @data_type                 NVARCHAR(128)
+    DECLARE @character_maximum_length  INT
+    DECLARE @numeric_precision TINYINT
+    DECLARE @numeric_scale             INT
+    DECLARE @col NVARCHAR(500)
+
+    SET @sql = CAST('SELECT ' AS NVARCHAR(MAX))
+
+    -- + Prepare(daft MS SQL) loop
+    FETCH NEXT FROM rec
+    INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+    --| Loop over columns in repo table for delivery
+    EXEC meta.debug @@PROCID, 'Loop over list of columns'
+    WHILE @@FETCH_STATUS = 0
+    BEGIN
+        --| MAP: 
+        SET @col =
+            CASE
+                --| NVARCHAR(MAX) => NVARCHAR(4000) due to GUI/AAC cannot handle MAX
+                WHEN @data_type = 'nvarchar' AND @character_maximum_length = -1 THEN 'CAST(' + @column_name + ' AS NVARCHAR(MAX)) ' + @column_name
+                --| <other types> => - No mapping -
+                ELSE @column_name
+            END
+
+        --+ Repeat(daft MS SQL) fetch
+       FETCH NEXT FROM rec
+       INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+       EXEC meta.debug @@PROCID, @col
+       SET @sql = @sql + CAST(@col AS NVARCHAR(MAX))
+        IF @@FETCH_STATUS = 0 SET @sql = @sql + CAST(',' AS NVARCHAR(MAX))
+    END
+
+    EXEC meta.debug @@PROCID, 'Close and deallocate cursor'
+    CLOSE rec
+    DEALLOCATE rec
+
+    
+    --| Insert the link
+    INSERT INTO meta.[link]
+           (external_id, dw_delivery_id, user_id, status_id)
+    VALUES (@external_id, @delivery_id, @user_id, 1)
+
+    --| Finish and execute SQL statement outputting delivery table
+    SET @sql = @sql + CAST(' FROM [' + @table_schema + '].[' + @table_name + '] '
+                    + 'WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR) AS NVARCHAR(MAX))
+                    + CAST(' ORDER BY dw_row_id ASC' AS NVARCHAR(MAX))
+
+
+    EXEC meta.debug @@PROCID, 'Execute query to return proper dataset'
+    EXEC meta.debug @@PROCID, @sql
+    EXEC sp_executesql @sql
+END
+--| ==========================================================================================
+;
+DECLARE @sql NVARCHAR(MAX)
+SET @sql = ''
+SELECT @sql = @sql + 'DROP VIEW [repo].[' + t.table_name + '_current] '
+  FROM meta.agreement_stage_table_v t
+ WHERE t.table_schema = 'repo'
+   AND OBJECT_ID('[repo].[' + t.table_name + '_current]', 'V') IS NOT NULL
+EXEC sp_executesql @sql
+;
+DROP PROCEDURE [meta].[agreement_delta_add]
+;
+DROP FUNCTION [meta].[get_delta_sql]
+;
+DELETE FROM [meta].[agreement_attribute]
+ WHERE attribute_id IN (SELECT id FROM [meta].[attribute] WHERE name IN ('DELIVERY_MODE', 'BUSINESS_KEY', 'DELTA_OPERATION_COLUMN'))
+;
+DELETE FROM [meta].[attribute]
+ WHERE name IN ('DELIVERY_MODE', 'BUSINESS_KEY', 'DELTA_OPERATION_COLUMN')
+;
@@ -0,0 +1,259 @@
+
+-- +migrate Up
+INSERT INTO [meta].[attribute] (name, description, default_value, options)
+SELECT 'SCD2', 'Maintain slowly changing dimension (type 2) history by BUSINESS_KEY in [repo].[<repo table>_scd2] with valid_from/valid_to from the delivery status date', 'NO', 'NO,YES'
+;
+CREATE
+PROCEDURE[meta].[delivery_scd2] --|
+--| ==========================================================================================
+--| Description: Apply a delivery published to repo onto the SCD2 history of the agreement
+--|              (attribute SCD2 = YES) keyed on BUSINESS_KEY:
+--|                - new or changed entities get a new version valid from the status date
+--|                - the previous version of changed entities is valid until the status date
+--|                - entities missing in FULL deliveries (or deleted in DELTA deliveries)
+--|                  are valid until the status date
+--|              The history table [repo].[<repo table>_scd2] is created on first use.
+--| Arguments:
+(
+    @name NVARCHAR(250)  --| Name of delivery (file)
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg          NVARCHAR(4000)
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id  BIGINT
+    DECLARE @audit_id     BIGINT
+    DECLARE @valid        DATE
+    DECLARE @latest       DATE
+    DECLARE @scd2         NVARCHAR(3)
+    DECLARE @mode         NVARCHAR(10)
+    DECLARE @keys         NVARCHAR(1000)
+    DECLARE @operation    NVARCHAR(128)
+    DECLARE @repo_name    NVARCHAR(100)
+    DECLARE @hist         NVARCHAR(200)
+    DECLARE @cols         NVARCHAR(MAX)
+    DECLARE @scols        NVARCHAR(MAX)
+    DECLARE @hash         NVARCHAR(MAX)
+    DECLARE @join         NVARCHAR(MAX)
+    DECLARE @partition    NVARCHAR(MAX)
+    DECLARE @deleted      NVARCHAR(MAX)
+    DECLARE @sql          NVARCHAR(MAX)
+
+    --| Lookup the latest delivery by name and its repository audit
+    SELECT @delivery_id  = d.id,
+           @agreement_id = d.agreement_id,
+           @valid        = COALESCE(d.status_date, CAST(d.createdtm AS DATE))
+      FROM meta.delivery d
+     WHERE d.id = (SELECT MAX(id) FROM meta.delivery WHERE name = @name)
+
+    SELECT @audit_id = MAX(id)
+      FROM meta.audit
+     WHERE delivery_id = @delivery_id
+       AND stage_id = 3
+
+    IF @audit_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] for repository not available', 11, 1, @name)
+        RETURN 2
+    END
+
+    SELECT @scd2      = MAX(CASE WHEN attribute_name = 'SCD2'                   THEN value END),
+           @mode      = MAX(CASE WHEN attribute_name = 'DELIVERY_MODE'          THEN value END),
+           @keys      = MAX(CASE WHEN attribute_name = 'BUSINESS_KEY'           THEN value END),
+           @operation = MAX(CASE WHEN attribute_name = 'DELTA_OPERATION_COLUMN' THEN value END)
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+
+    --| Nothing to do unless enabled
+    IF COALESCE(@scd2, 'NO') <> 'YES' RETURN
+
+    IF NULLIF(@keys, '') IS NULL
+    BEGIN
+        EXEC meta.operation_add @audit_id, 2, @@PROCID, 'SCD2 requires the BUSINESS_KEY attribute'
+        RAISERROR('SCD2 of delivery [%s] requires the BUSINESS_KEY attribute', 11, 1, @name)
+        RETURN 3
+    END
+
+    SELECT @repo_name = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+    SET @hist = '[repo].[' + @repo_name + '_scd2]'
+
+    --| History has to be applied in status date order
+    IF OBJECT_ID(@hist, 'U') IS NOT NULL
+    BEGIN
+        SET @sql = 'SELECT @o_latest = MAX(valid_from) FROM ' + @hist
+        EXEC sp_executesql @sql, N'@o_latest DATE OUT', @o_latest = @latest OUT
+        IF @latest > @valid
+        BEGIN
+            SET @msg = 'SCD2 skipped - status date [' + CONVERT(NVARCHAR(10), @valid, 120) + '] before latest version [' + CONVERT(NVARCHAR(10), @latest, 120) + ']'
+            EXEC meta.operation_add @audit_id, 2, @@PROCID, @msg
+            RAISERROR('%s', 11, 1, @msg)
+            RETURN 4
+        END
+    END
+
+    --| Prepare column lists: data columns, row hash and business key join/partition
+    SELECT @cols  = COALESCE(@cols + ', ', '') + column_name,
+           @scols = COALESCE(@scols + ', ', '') + 's.' + column_name,
+           @hash  = COALESCE(@hash + ', ''|'', ', '') + 'CAST(' + column_name + ' AS NVARCHAR(MAX))'
+      FROM meta.column_mapping_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+       AND column_name NOT IN ('[dw_delivery_id]', '[dw_row_id]')
+     ORDER BY ordinal_position
+
+    SELECT @join      = COALESCE(@join + ' AND ', '') + 't.[' + LTRIM(RTRIM(value)) + '] = s.[' + LTRIM(RTRIM(value)) + ']',
+           @partition = COALESCE(@partition + ', ', '') + '[' + LTRIM(RTRIM(value)) + ']'
+      FROM STRING_SPLIT(@keys, ',')
+     WHERE LTRIM(RTRIM(value)) <> ''
+
+    SET @deleted = CASE
+                       WHEN @mode = 'DELTA' AND NULLIF(@operation, '') IS NOT NULL
+                       THEN 'CASE WHEN UPPER(CAST([' + @operation + '] AS NVARCHAR(10))) = ''D'' THEN 1 ELSE 0 END'
+                       ELSE '0'
+                   END
+
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        --| Create history table on first use (dw_row_id without IDENTITY)
+        IF OBJECT_ID(@hist, 'U') IS NULL
+        BEGIN
+            SET @sql = CAST('SELECT TOP 0 ' AS NVARCHAR(MAX)) + @cols
+                     + ', CAST(dw_delivery_id AS BIGINT) AS dw_delivery_id, CAST(dw_row_id AS BIGINT) AS dw_row_id'
+                     + ', CAST(NULL AS VARBINARY(32)) AS dw_hash, CAST(NULL AS DATE) AS valid_from, CAST(NULL AS DATE) AS valid_to'
+                     + ' INTO ' + @hist + ' FROM [repo].[' + @repo_name + ']'
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+        END
+
+        --| Latest row per business key of the delivery
+        SET @sql = CAST('SELECT * INTO #src FROM (SELECT ' AS NVARCHAR(MAX)) + @cols
+                 + ', dw_delivery_id, dw_row_id'
+                 + ', HASHBYTES(''SHA2_256'', CONCAT(' + @hash + ', '''')) AS dw_hash'
+                 + ', ' + @deleted + ' AS dw_deleted'
+                 + ', ROW_NUMBER() OVER (PARTITION BY ' + @partition + ' ORDER BY dw_row_id DESC) AS dw_rank'
+                 + ' FROM [repo].[' + @repo_name + '] WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+                 + ') x WHERE dw_rank = 1 '
+        --+ Close versions of changed and deleted entities
+                 + 'UPDATE t SET valid_to = @valid FROM ' + @hist + ' t, #src s WHERE ' + @join
+                 + ' AND t.valid_to IS NULL AND (t.dw_hash <> s.dw_hash OR s.dw_deleted = 1) '
+        --+ Close versions of entities missing in FULL deliveries
+                 + CASE WHEN COALESCE(@mode, 'FULL') <> 'DELTA'
+                        THEN 'UPDATE t SET valid_to = @valid FROM ' + @hist + ' t WHERE t.valid_to IS NULL'
+                           + ' AND NOT EXISTS (SELECT 1 FROM #src s WHERE ' + @join + ') '
+                        ELSE ''
+                   END
+        --+ Add new versions of new and changed entities
+                 + 'INSERT INTO ' + @hist + ' (' + @cols + ', dw_delivery_id, dw_row_id, dw_hash, valid_from, valid_to)'
+                 + ' SELECT ' + @scols + ', s.dw_delivery_id, s.dw_row_id, s.dw_hash, @valid, NULL FROM #src s'
+                 + ' WHERE s.dw_deleted = 0 AND NOT EXISTS (SELECT 1 FROM ' + @hist + ' t WHERE ' + @join + ' AND t.valid_to IS NULL)'
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql, N'@valid DATE', @valid = @valid
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        IF @@trancount > 0 ROLLBACK TRANSACTION
+        SET @msg = LEFT('SCD2 failed: ' + ERROR_MESSAGE(), 250)
+        EXEC meta.operation_add @audit_id, 2, @@PROCID, @msg
+        RAISERROR('%s', 11, 1, @msg)
+        RETURN 10
+    END CATCH
+
+    COMMIT TRANSACTION
+
+    SET @msg = 'SCD2 applied as of [' + CONVERT(NVARCHAR(10), @valid, 120) + ']'
+    EXEC meta.operation_add @audit_id, 1, @@PROCID, @msg
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[get_scd2] --|
+--| ==========================================================================================
+--| Description: Return the SCD2 history of an agreement - either the state as of a date
+--|              (versions valid on @as_of_date) or every version if @as_of_date is NULL.
+--|              @key limits the result to one entity (business key values comma separated
+--|              in BUSINESS_KEY order).
+--| Arguments:
+(
+    @username   NVARCHAR(250),  --| Username of requestor
+    @name       NVARCHAR(250),  --| Name of agreement
+    @key        NVARCHAR(1000), --| Business key of entity - NULL => all entities
+    @as_of_date NVARCHAR(25)    --| Date string (YYYY-MM-DD) - NULL => all versions
+)
+AS 
+SET NOCOUNT ON
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @agreement_id BIGINT
+    DECLARE @keys         NVARCHAR(1000)
+    DECLARE @repo_name    NVARCHAR(100)
+    DECLARE @hist         NVARCHAR(200)
+    DECLARE @concat       NVARCHAR(MAX)
+    DECLARE @date         DATE
+    DECLARE @sql          NVARCHAR(MAX)
+
+    SELECT @agreement_id = id
+      FROM meta.agreement
+     WHERE name = @name
+
+    IF COALESCE(meta.user_access(@username, @agreement_id, 'VIEW'), 0) = 0
+    BEGIN
+        RAISERROR('User [%s] does not have VIEW permission on agreement [%s]', 11, 1, @username, @name)
+        RETURN 2
+    END
+
+    SELECT @keys = value
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+       AND attribute_name = 'BUSINESS_KEY'
+
+    SELECT @repo_name = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+    SET @hist = '[repo].[' + @repo_name + '_scd2]'
+
+    IF NULLIF(@keys, '') IS NULL OR OBJECT_ID(@hist, 'U') IS NULL
+    BEGIN
+        RAISERROR('Agreement [%s] has no SCD2 history', 11, 1, @name)
+        RETURN 3
+    END
+
+    IF @as_of_date IS NOT NULL AND meta.check_date(@as_of_date, 120) <> 0
+    BEGIN
+        RAISERROR('Invalid date [%s] - expected YYYY-MM-DD', 11, 1, @as_of_date)
+        RETURN 4
+    END
+    SET @date = CONVERT(DATE, @as_of_date, 120)
+
+    SELECT @concat = COALESCE(@concat + ', ', '') + 'CAST([' + LTRIM(RTRIM(value)) + '] AS NVARCHAR(MAX))'
+      FROM STRING_SPLIT(@keys, ',')
+     WHERE LTRIM(RTRIM(value)) <> ''
+
+    SET @sql = CAST('SELECT * FROM ' AS NVARCHAR(MAX)) + @hist + ' WHERE 1 = 1'
+             + CASE WHEN @date IS NOT NULL THEN ' AND valid_from <= @date AND (valid_to IS NULL OR valid_to > @date)' ELSE '' END
+             + CASE WHEN @key IS NOT NULL THEN ' AND CONCAT_WS('','', ' + @concat + ') = @key' ELSE '' END
+             + ' ORDER BY ' + @concat + ', valid_from'
+    EXEC meta.debug @@PROCID, @sql
+    EXEC sp_executesql @sql, N'@date DATE, @key NVARCHAR(1000)', @date = @date, @key = @key
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+DROP PROCEDURE [meta].[get_scd2]
+;
+DROP PROCEDURE [meta].[delivery_scd2]
+;
+DELETE FROM [meta].[agreement_attribute]
+ WHERE attribute_id IN (SELECT id FROM [meta].[attribute] WHERE name = 'SCD2')
+;
+DELETE FROM [meta].[attribute]
+ WHERE name = 'SCD2'
+;
@@ -0,0 +1,1108 @@
+
+-- +migrate Up
+INSERT INTO [meta].[attribute] (name, description, default_value, options)
+SELECT 'LINEAGE', 'Keep the raw source line of every delivered row for lineage queries (/lineage) - set by meta.agreement_lineage_add', 'NO', 'NO,YES'
+;
+CREATE TABLE[meta].[delivery_source]
+(
+    [delivery_id] [bigint] NOT NULL,
+
+    [line_no] [bigint] NOT NULL,
+
+    [dw_line_no] [bigint] NOT NULL,
+
+    [dw_row_id] [bigint] NULL,
+
+    [raw] [nvarchar] (max) NULL,
+ CONSTRAINT[PK_delivery_source] PRIMARY KEY CLUSTERED
+(
+   [delivery_id] ASC,
+   [line_no] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[delivery_source] WITH CHECK ADD CONSTRAINT[FK_delivery_source_delivery] FOREIGN KEY([delivery_id])
+REFERENCES[meta].[delivery]
+        ([id])
+ON DELETE CASCADE
+;
+CREATE INDEX ix_delivery_source_did_rid ON meta.delivery_source (delivery_id, dw_row_id)
+;
+ALTER
+PROCEDURE[meta].[generic_file2temp] --|
+--| ==========================================================================================
+--| Author:      Soren Bak Larsen
+--| Description: Load the data from the delivery file into the load table in temp.
+--|              Use the provided name to lookup the agreement and other information from meta
+--|              data and make sure the temp schema table is created and populated from the
+--|              specified file name.
+--|              Agreements keeping lineage are loaded through the view [temp].[<table>_load]
+--|              so the IDENTITY column dw_line_no numbers the rows in the order of the file.
+--| Arguments:             
+(
+    @delivery_id BIGINT,
+    @path NVARCHAR(250)
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+
+    DECLARE @count INT
+    DECLARE @agreement_id  BIGINT
+    DECLARE @name NVARCHAR(250)
+    DECLARE @size          BIGINT
+
+    --| Lookup the essential arguments from the delivery
+    SELECT @agreement_id = agreement_id, @name = name, @size = size
+      FROM meta.delivery
+     WHERE id = @delivery_id
+
+    --| Lookup the type details from the delivery_id via the agreement
+    EXEC meta.debug @@PROCID, 'Lookup type details name in meta data via agreement'
+    DECLARE @schema                NVARCHAR(50)
+    DECLARE @table                 NVARCHAR(128)
+    -- BULK INSERT arguments
+    DECLARE @batchsize             INT
+    DECLARE @check_constraints     BIT
+    DECLARE @codepage              NVARCHAR(10)
+    DECLARE @datafiletype          NVARCHAR(10)
+    DECLARE @fieldterminator       NVARCHAR(10)
+    DECLARE @firstrow              INT
+    DECLARE @fire_triggers         BIT
+    DECLARE @format_file           NVARCHAR(250)
+    DECLARE @keepidentity          BIT
+    DECLARE @keepnulls             BIT
+    DECLARE @kilobytes_per_batch   INT
+    DECLARE @lastrow               INT
+    DECLARE @maxerrors             INT
+    DECLARE @order                 NVARCHAR(500)
+    DECLARE @rows_per_batch        INT
+    DECLARE @rowterminator         NVARCHAR(10)
+    DECLARE @tablock               BIT
+    DECLARE @errorfile             NVARCHAR(250)
+    DECLARE @data_source           NVARCHAR(50)
+    DECLARE @errorfile_data_source NVARCHAR(50)
+    DECLARE @nvarchar_max_load     NVARCHAR(1000)
+
+    SELECT @schema = t.table_schema,
+           @table = t.table_name,
+           @nvarchar_max_load = u.value,
+           -- BULK INSERT arguments
+           @batchsize = y.[batchsize],
+           @check_constraints = y.[check_constraints],
+           @codepage = y.[codepage],
+           @datafiletype = y.[datafiletype],
+           @fieldterminator = y.[fieldterminator],
+           @firstrow = y.[firstrow],
+           @fire_triggers = y.[fire_triggers],
+           @format_file = y.[format_file],
+           @keepidentity = y.[keepidentity],
+           @keepnulls = y.[keepnulls],
+           @kilobytes_per_batch = y.[kilobytes_per_batch],
+           @lastrow = y.[lastrow],
+           @maxerrors = y.[maxerrors],
+           @order = y.[order],
+           @rows_per_batch = y.[rows_per_batch],
+           @rowterminator = y.[rowterminator],
+           @tablock = y.[tablock],
+           @errorfile = y.[errorfile],
+           @data_source = y.[data_source],
+           @errorfile_data_source = y.[data_source]
+      FROM meta.[type]                  y,
+           meta.agreement               a,
+           meta.agreement_stage_table_v t,
+           meta.agreement_attribute_v   u
+     WHERE a.id = t.agreement_id
+       AND a.id = u.agreement_id
+       AND y.id = a.type_id
+       AND a.id = @agreement_id
+       AND t.table_schema   = 'temp'
+       AND u.attribute_name = 'NVARCHAR_MAX_LOAD'
+
+    --| Load through the load view (all columns but dw_line_no) if the agreement keeps lineage
+    DECLARE @load NVARCHAR(128) = @table
+    IF OBJECT_ID('[' + @schema + '].[' + @table + '_load]', 'V') IS NOT NULL SET @load = @table + '_load'
+
+    -- + Prepend path to form the full name of the delivery
+    IF COALESCE(@path, '') <> '' SET @name = @path + @name
+
+    -- + Set the field limiter to \0 if row size of target table exceeds 4000 (NVARCHAR) due to
+    --+ hard limit of 8060 in MS SQL. This is optional as some data deliveries manages - others
+    --+ dont - and changing the behavior has a great performance impact.
+    --+ The attribute NVARCHAR_MAX_LOAD controls the setting.
+    IF @nvarchar_max_load = 'YES' SET @fieldterminator = '\0'
+
+    -- | Prepare BULK INSERT statement from retrieved parameters (meta.type)
+    DECLARE @sql NVARCHAR(4000)
+    EXEC meta.debug @@PROCID, 'Prepare BULK INSERT statement'   
+    SET @sql = 'BULK INSERT [' + @schema + '].[' + @load + '] FROM ''' + @name + ''' WITH ('
+    
+    --+ Replace dynamic parameters
+    SET @errorfile   = REPLACE(@errorfile, '{datafile}', @name)
+    SET @format_file = REPLACE(@format_file, '{datafile}', @name)
+
+    IF @batchsize             IS NOT NULL SET @sql = @sql + 'BATCHSIZE='''             + @batchsize             + ''','
+    IF @codepage              IS NOT NULL SET @sql = @sql + 'CODEPAGE='''              + @codepage              + ''','
+    IF @datafiletype          IS NOT NULL SET @sql = @sql + 'DATAFILETYPE='''          + @datafiletype          + ''','
+    IF @fieldterminator       IS NOT NULL SET @sql = @sql + 'FIELDTERMINATOR='''       + @fieldterminator       + ''','
+    IF @format_file           IS NOT NULL SET @sql = @sql + 'FORMAT_FILE='''           + @format_file           + ''','
+    IF @order                 IS NOT NULL SET @sql = @sql + 'ORDER='''                 + @order                 + ''','
+    IF @rowterminator         IS NOT NULL SET @sql = @sql + 'ROWTERMINATOR='''         + @rowterminator         + ''','
+    IF @errorfile             IS NOT NULL SET @sql = @sql + 'ERRORFILE='''             + @errorfile             + ''','
+    IF @data_source           IS NOT NULL SET @sql = @sql + 'DATA_SOURCE='''           + @data_source           + ''','
+    IF @errorfile_data_source IS NOT NULL SET @sql = @sql + 'ERRORFILE_DATA_SOURCE=''' + @errorfile_data_source + ''','
+
+    IF @firstrow              IS NOT NULL SET @sql = @sql + 'FIRSTROW='                + CAST(@firstrow            AS NVARCHAR) + ','
+    IF @kilobytes_per_batch   IS NOT NULL SET @sql = @sql + 'KILOBYTES_PER_BATCH='     + CAST(@kilobytes_per_batch AS NVARCHAR) + ','
+    IF @lastrow               IS NOT NULL SET @sql = @sql + 'LASTROW='                 + CAST(@lastrow             AS NVARCHAR) + ','
+    IF @maxerrors             IS NOT NULL SET @sql = @sql + 'MAXERRORS='               + CAST(@maxerrors           AS NVARCHAR) + ','
+    IF @rows_per_batch        IS NOT NULL SET @sql = @sql + 'ROWS_PER_BATCH='          + CAST(@rows_per_batch      AS NVARCHAR) + ','
+
+    IF @check_constraints = 1 SET @sql = @sql + 'CHECKCONSTRAINTS,'
+    IF @fire_triggers = 1     SET @sql = @sql + 'FIRE_TRIGGERS,'
+    IF @keepidentity = 1      SET @sql = @sql + 'KEEPIDENTITY,'
+    IF @keepnulls = 1         SET @sql = @sql + 'KEEPNULLS,'
+    IF @tablock = 1           SET @sql = @sql + 'TABLOCK,'
+
+    SET @sql = LEFT(@sql, LEN(@sql) - 1) + ')'
+
+    -- + Execute the BULK INSERT statement
+    DECLARE @bulk_count INT
+    EXEC meta.debug @@PROCID, @sql
+    EXEC sp_executesql @sql
+
+    --+ Get the number of rows reported by BULK INSERT
+    SET @bulk_count = @@ROWCOUNT
+    EXEC meta.debug @@PROCID, 'Rows affected by BULK INSERT'
+    EXEC meta.debug @@PROCID, @bulk_count
+
+    --! --------------------------------------------------------------------------------------------------
+    --! NOTE: For some reason the number of errors encountered during BULK INSERT is impossible to
+    --!       identify using any best practices published by the vendor.So in order to break properly
+    --!       when encountering errors, the.Error.Txt file must be inspected manually
+    --! NOTE: The xp_fileexist extended (and undocumented) procedure suggested by many parties does not 
+    --!       work with network paths
+    --! NOTE: Apparently the only way to properly determine if any error rows were skipped is to try
+    --!       to load the.error file and break if it exists
+    --! --------------------------------------------------------------------------------------------------
+    
+    --| Inspect the .error files to retrieve (possible) errors and break properly if so
+    --+ Checking for errors is triggered by the @errorfile parameter
+    IF @errorfile IS NOT NULL
+    BEGIN
+        EXEC meta.debug @@PROCID, 'Performing error check of BULK LOAD'
+        
+        --+ Check if the.error file exists by trying to load it with no tolerance
+        --+ First prepare new BULK LOAD statement which will break no matter what - and handle the error
+        --+ code accordingly
+        SET @sql = REPLACE(@sql, ' FROM ''' + @name + ''' WITH', ' FROM ''' + @errorfile + ''' WITH')
+        SET @sql = REPLACE(@sql, 'ERRORFILE=''' + @errorfile + '''', 'ERRORFILE=''' + @errorfile + '.check''')
+        SET @sql = CASE WHEN @maxerrors IS NULL THEN REPLACE(@sql, 'WITH (', ' WITH (MAXERRORS=0,')
+                        ELSE REPLACE(@sql, 'MAXERRORS=' + CAST(@maxerrors AS NVARCHAR), 'MAXERRORS=0')
+                   END
+        SET @sql = CASE WHEN @firstrow IS NULL THEN REPLACE(@sql, 'WITH (', ' WITH (FIRSTROW=1,')
+                        ELSE REPLACE(@sql, 'FIRSTROW=' + CAST(@firstrow AS NVARCHAR), 'FIRSTROW=1')
+                   END
+        --+ Execute the error check in a nested TRY/CATCH block
+        BEGIN TRY
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+        END TRY
+        BEGIN CATCH
+            --+ Handle the 7330 (Cannot fetch row from OLE DB provider) - meaning error file exists
+            IF ERROR_NUMBER() = 7330 RAISERROR('Errors encountered in BULK LOAD - see [%s]', 11, 1, @errorfile)
+        END CATCH
+    END
+
+    --| Compare the number of rows inserted with rows read
+    --+ Get number of rows inserted
+    EXEC meta.debug @@PROCID, 'Get number of rows inserted into temp table'
+    SET @sql = N'SELECT @rows = COUNT(*) FROM [' + @schema + '].[' + @table + ']'
+    EXEC sp_executesql @sql, N'@rows BIGINT OUTPUT', @count OUTPUT
+    EXEC meta.debug @@PROCID, @count
+
+    --+ Error if the two counts do not match
+    IF @bulk_count <> @count
+        RAISERROR('Count mismatch, BULK INSERT [%d] and SELECT COUNT(*) [%d]', 11, 1, @bulk_count, @count)
+
+    --| Update the delivery meta data
+    EXEC meta.debug @@PROCID, 'Update meta.delivery'
+    UPDATE meta.delivery
+       SET size = @count
+     WHERE id = @delivery_id
+
+    RETURN
+END           
+--| ==========================================================================================
+;
+ALTER
+PROCEDURE[meta].[delivery_scd2] --|
+--| ==========================================================================================
+--| Description: Apply a delivery published to repo onto the SCD2 history of the agreement
+--|              (attribute SCD2 = YES) keyed on BUSINESS_KEY:
+--|                - new or changed entities get a new version valid from the status date
+--|                - the previous version of changed entities is valid until the status date
+--|                - entities missing in FULL deliveries (or deleted in DELTA deliveries)
+--|                  are valid until the status date
+--|              The history table [repo].[<repo table>_scd2] is created on first use.
+--|              The load order dw_line_no (see LINEAGE) is not part of the history.
+--| Arguments:
+(
+    @name NVARCHAR(250)  --| Name of delivery (file)
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg          NVARCHAR(4000)
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id  BIGINT
+    DECLARE @audit_id     BIGINT
+    DECLARE @valid        DATE
+    DECLARE @latest       DATE
+    DECLARE @scd2         NVARCHAR(3)
+    DECLARE @mode         NVARCHAR(10)
+    DECLARE @keys         NVARCHAR(1000)
+    DECLARE @operation    NVARCHAR(128)
+    DECLARE @repo_name    NVARCHAR(100)
+    DECLARE @hist         NVARCHAR(200)
+    DECLARE @cols         NVARCHAR(MAX)
+    DECLARE @scols        NVARCHAR(MAX)
+    DECLARE @hash         NVARCHAR(MAX)
+    DECLARE @join         NVARCHAR(MAX)
+    DECLARE @partition    NVARCHAR(MAX)
+    DECLARE @deleted      NVARCHAR(MAX)
+    DECLARE @sql          NVARCHAR(MAX)
+
+    --| Lookup the latest delivery by name and its repository audit
+    SELECT @delivery_id  = d.id,
+           @agreement_id = d.agreement_id,
+           @valid        = COALESCE(d.status_date, CAST(d.createdtm AS DATE))
+      FROM meta.delivery d
+     WHERE d.id = (SELECT MAX(id) FROM meta.delivery WHERE name = @name)
+
+    SELECT @audit_id = MAX(id)
+      FROM meta.audit
+     WHERE delivery_id = @delivery_id
+       AND stage_id = 3
+
+    IF @audit_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] for repository not available', 11, 1, @name)
+        RETURN 2
+    END
+
+    SELECT @scd2      = MAX(CASE WHEN attribute_name = 'SCD2'                   THEN value END),
+           @mode      = MAX(CASE WHEN attribute_name = 'DELIVERY_MODE'          THEN value END),
+           @keys      = MAX(CASE WHEN attribute_name = 'BUSINESS_KEY'           THEN value END),
+           @operation = MAX(CASE WHEN attribute_name = 'DELTA_OPERATION_COLUMN' THEN value END)
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+
+    --| Nothing to do unless enabled
+    IF COALESCE(@scd2, 'NO') <> 'YES' RETURN
+
+    IF NULLIF(@keys, '') IS NULL
+    BEGIN
+        EXEC meta.operation_add @audit_id, 2, @@PROCID, 'SCD2 requires the BUSINESS_KEY attribute'
+        RAISERROR('SCD2 of delivery [%s] requires the BUSINESS_KEY attribute', 11, 1, @name)
+        RETURN 3
+    END
+
+    SELECT @repo_name = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+    SET @hist = '[repo].[' + @repo_name + '_scd2]'
+
+    --| History has to be applied in status date order
+    IF OBJECT_ID(@hist, 'U') IS NOT NULL
+    BEGIN
+        SET @sql = 'SELECT @o_latest = MAX(valid_from) FROM ' + @hist
+        EXEC sp_executesql @sql, N'@o_latest DATE OUT', @o_latest = @latest OUT
+        IF @latest > @valid
+        BEGIN
+            SET @msg = 'SCD2 skipped - status date [' + CONVERT(NVARCHAR(10), @valid, 120) + '] before latest version [' + CONVERT(NVARCHAR(10), @latest, 120) + ']'
+            EXEC meta.operation_add @audit_id, 2, @@PROCID, @msg
+            RAISERROR('%s', 11, 1, @msg)
+            RETURN 4
+        END
+    END
+
+    --| Prepare column lists: data columns, row hash and business key join/partition
+    SELECT @cols  = COALESCE(@cols + ', ', '') + column_name,
+           @scols = COALESCE(@scols + ', ', '') + 's.' + column_name,
+           @hash  = COALESCE(@hash + ', ''|'', ', '') + 'CAST(' + column_name + ' AS NVARCHAR(MAX))'
+      FROM meta.column_mapping_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+       AND column_name NOT IN ('[dw_delivery_id]', '[dw_row_id]', '[dw_line_no]')
+     ORDER BY ordinal_position
+
+    SELECT @join      = COALESCE(@join + ' AND ', '') + 't.[' + LTRIM(RTRIM(value)) + '] = s.[' + LTRIM(RTRIM(value)) + ']',
+           @partition = COALESCE(@partition + ', ', '') + '[' + LTRIM(RTRIM(value)) + ']'
+      FROM STRING_SPLIT(@keys, ',')
+     WHERE LTRIM(RTRIM(value)) <> ''
+
+    SET @deleted = CASE
+                       WHEN @mode = 'DELTA' AND NULLIF(@operation, '') IS NOT NULL
+                       THEN 'CASE WHEN UPPER(CAST([' + @operation + '] AS NVARCHAR(10))) = ''D'' THEN 1 ELSE 0 END'
+                       ELSE '0'
+                   END
+
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        --| Create history table on first use (dw_row_id without IDENTITY)
+        IF OBJECT_ID(@hist, 'U') IS NULL
+        BEGIN
+            SET @sql = CAST('SELECT TOP 0 ' AS NVARCHAR(MAX)) + @cols
+                     + ', CAST(dw_delivery_id AS BIGINT) AS dw_delivery_id, CAST(dw_row_id AS BIGINT) AS dw_row_id'
+                     + ', CAST(NULL AS VARBINARY(32)) AS dw_hash, CAST(NULL AS DATE) AS valid_from, CAST(NULL AS DATE) AS valid_to'
+                     + ' INTO ' + @hist + ' FROM [repo].[' + @repo_name + ']'
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+        END
+
+        --| Latest row per business key of the delivery
+        SET @sql = CAST('SELECT * INTO #src FROM (SELECT ' AS NVARCHAR(MAX)) + @cols
+                 + ', dw_delivery_id, dw_row_id'
+                 + ', HASHBYTES(''SHA2_256'', CONCAT(' + @hash + ', '''')) AS dw_hash'
+                 + ', ' + @deleted + ' AS dw_deleted'
+                 + ', ROW_NUMBER() OVER (PARTITION BY ' + @partition + ' ORDER BY dw_row_id DESC) AS dw_rank'
+                 + ' FROM [repo].[' + @repo_name + '] WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+                 + ') x WHERE dw_rank = 1 '
+        --+ Close versions of changed and deleted entities
+                 + 'UPDATE t SET valid_to = @valid FROM ' + @hist + ' t, #src s WHERE ' + @join
+                 + ' AND t.valid_to IS NULL AND (t.dw_hash <> s.dw_hash OR s.dw_deleted = 1) '
+        --+ Close versions of entities missing in FULL deliveries
+                 + CASE WHEN COALESCE(@mode, 'FULL') <> 'DELTA'
+                        THEN 'UPDATE t SET valid_to = @valid FROM ' + @hist + ' t WHERE t.valid_to IS NULL'
+                           + ' AND NOT EXISTS (SELECT 1 FROM #src s WHERE ' + @join + ') '
+                        ELSE ''
+                   END
+        --+ Add new versions of new and changed entities
+                 + 'INSERT INTO ' + @hist + ' (' + @cols + ', dw_delivery_id, dw_row_id, dw_hash, valid_from, valid_to)'
+                 + ' SELECT ' + @scols + ', s.dw_delivery_id, s.dw_row_id, s.dw_hash, @valid, NULL FROM #src s'
+                 + ' WHERE s.dw_deleted = 0 AND NOT EXISTS (SELECT 1 FROM ' + @hist + ' t WHERE ' + @join + ' AND t.valid_to IS NULL)'
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql, N'@valid DATE', @valid = @valid
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        IF @@trancount > 0 ROLLBACK TRANSACTION
+        SET @msg = LEFT('SCD2 failed: ' + ERROR_MESSAGE(), 250)
+        EXEC meta.operation_add @audit_id, 2, @@PROCID, @msg
+        RAISERROR('%s', 11, 1, @msg)
+        RETURN 10
+    END CATCH
+
+    COMMIT TRANSACTION
+
+    SET @msg = 'SCD2 applied as of [' + CONVERT(NVARCHAR(10), @valid, 120) + ']'
+    EXEC meta.operation_add @audit_id, 1, @@PROCID, @msg
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[agreement_lineage_add] --|
+--| ==========================================================================================
+--| Description: Keep the lineage of the deliveries of an agreement (YES) or not (NO).
+--|              Lineage numbers the rows of temp in load order by the IDENTITY column
+--|              dw_line_no (loaded through the view [temp].[<temp table>_load]), which is
+--|              carried to stag and repo, so every repo row links to its source line.
+--|              Not available for agreements loaded with NVARCHAR_MAX_LOAD = YES.
+--| Arguments:
+(
+    @agreement_id BIGINT,      --| ID of agreement
+    @lineage      NVARCHAR(3)  --| YES => keep lineage, NO => stop keeping it
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg               NVARCHAR(4000)
+    DECLARE @temp_name         NVARCHAR(128)
+    DECLARE @stag_name         NVARCHAR(128)
+    DECLARE @repo_name         NVARCHAR(128)
+    DECLARE @nvarchar_max_load NVARCHAR(1000)
+    DECLARE @mode              NVARCHAR(10)
+    DECLARE @business_key      NVARCHAR(1000)
+    DECLARE @operation_column  NVARCHAR(128)
+    DECLARE @columns           NVARCHAR(MAX)
+    DECLARE @sql               NVARCHAR(MAX)
+
+    --| Look up the stage tables from agreement_id
+    SELECT @temp_name = MAX(CASE WHEN table_schema = 'temp' THEN table_name END),
+           @stag_name = MAX(CASE WHEN table_schema = 'stag' THEN table_name END),
+           @repo_name = MAX(CASE WHEN table_schema = 'repo' THEN table_name END)
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+
+    IF @temp_name IS NULL OR @stag_name IS NULL OR @repo_name IS NULL
+    BEGIN
+        RAISERROR ('Agreement [%I64d] does not exist', 11, 1, @agreement_id)
+        RETURN 2
+    END
+
+    IF COALESCE(@lineage, '') NOT IN ('YES', 'NO')
+    BEGIN
+        RAISERROR ('Lineage must be YES or NO - got [%s]', 11, 1, @lineage)
+        RETURN 3
+    END
+
+    SELECT @nvarchar_max_load = MAX(CASE WHEN attribute_name = 'NVARCHAR_MAX_LOAD'      THEN value END),
+           @mode              = MAX(CASE WHEN attribute_name = 'DELIVERY_MODE'          THEN value END),
+           @business_key      = MAX(CASE WHEN attribute_name = 'BUSINESS_KEY'           THEN value END),
+           @operation_column  = MAX(CASE WHEN attribute_name = 'DELTA_OPERATION_COLUMN' THEN value END)
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+
+    IF @lineage = 'YES' AND @nvarchar_max_load = 'YES'
+    BEGIN
+        RAISERROR ('Lineage is not available for agreement [%I64d] loaded with NVARCHAR_MAX_LOAD', 11, 1, @agreement_id)
+        RETURN 4
+    END
+
+    SET @msg = 'LINEAGE [' + @temp_name + '] ' + @lineage
+    EXEC meta.debug @@PROCID, @msg
+
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        EXEC meta.agreement_attribute_add @agreement_id, 'LINEAGE', @lineage
+
+        IF @lineage = 'YES'
+        BEGIN
+            --| Number the rows of temp in load order - loaded through the view of the other columns
+            IF COL_LENGTH('[temp].[' + @temp_name + ']', 'dw_line_no') IS NULL
+            BEGIN
+                SET @sql = 'ALTER TABLE [temp].[' + @temp_name + '] ADD dw_line_no BIGINT IDENTITY(1,1)'
+                EXEC meta.debug @@PROCID, @sql
+                EXEC sp_executesql @sql
+            END
+
+            SELECT @columns = COALESCE(@columns + ', ', '') + column_name
+              FROM meta.column_mapping_v
+             WHERE agreement_id = @agreement_id
+               AND table_schema = 'temp'
+               AND column_name <> '[dw_line_no]'
+             ORDER BY ordinal_position
+
+            SET @sql = CAST('CREATE OR ALTER VIEW [temp].[' + @temp_name + '_load] AS SELECT ' AS NVARCHAR(MAX))
+                     + @columns + CAST(' FROM [temp].[' + @temp_name + ']' AS NVARCHAR(MAX))
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+
+            --| Carry the line number to stag and repo (mapped by name like the other columns)
+            IF COL_LENGTH('[stag].[' + @stag_name + ']', 'dw_line_no') IS NULL
+            BEGIN
+                SET @sql = 'ALTER TABLE [stag].[' + @stag_name + '] ADD dw_line_no BIGINT'
+                EXEC meta.debug @@PROCID, @sql
+                EXEC sp_executesql @sql
+            END
+            IF COL_LENGTH('[repo].[' + @repo_name + ']', 'dw_line_no') IS NULL
+            BEGIN
+                SET @sql = 'ALTER TABLE [repo].[' + @repo_name + '] ADD dw_line_no BIGINT'
+                EXEC meta.debug @@PROCID, @sql
+                EXEC sp_executesql @sql
+            END
+        END
+        ELSE
+        BEGIN
+            --| Remove the line number from all stages (the links kept in meta.delivery_source remain)
+            SET @sql = 'DROP VIEW IF EXISTS [temp].[' + @temp_name + '_load]'
+            EXEC sp_executesql @sql
+            SET @sql = 'ALTER TABLE [temp].[' + @temp_name + '] DROP COLUMN IF EXISTS dw_line_no'
+            EXEC sp_executesql @sql
+            SET @sql = 'ALTER TABLE [stag].[' + @stag_name + '] DROP COLUMN IF EXISTS dw_line_no'
+            EXEC sp_executesql @sql
+            SET @sql = 'ALTER TABLE [repo].[' + @repo_name + '] DROP COLUMN IF EXISTS dw_line_no'
+            EXEC sp_executesql @sql
+        END
+
+        --| The current state view of DELTA agreements lists the repo columns
+        IF @mode = 'DELTA'
+            EXEC meta.agreement_delta_add @agreement_id, @business_key, @operation_column
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        IF @@trancount > 0 ROLLBACK TRANSACTION
+        SET @msg = ERROR_MESSAGE()
+        RAISERROR ('Setting lineage of agreement [%I64d] failed: %s', 11, 1, @agreement_id, @msg)
+        RETURN 10
+    END CATCH
+
+    COMMIT TRANSACTION
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[delivery_lineage] --|
+--| ==========================================================================================
+--| Description: Keep the lineage of a delivery (attribute LINEAGE = YES) in two steps:
+--|                stage 1 - after load: keep the raw (pre-mapping) rows of temp with their
+--|                          source line number (load order dw_line_no offset by FIRSTROW)
+--|                stage 3 - after publish: link the source lines to the repo dw_row_id
+--|              The load order dw_line_no is carried from temp through stag to repo (see
+--|              meta.agreement_lineage_add), so source lines and repo rows are joined on it.
+--| Arguments:
+(
+    @name     NVARCHAR(250), --| Name of delivery (file)
+    @stage_id INT            --| Stage just completed (1 = temp, 3 = repo)
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg          NVARCHAR(4000)
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id  BIGINT
+    DECLARE @audit_id     BIGINT
+    DECLARE @lineage      NVARCHAR(3)
+    DECLARE @firstrow     INT
+    DECLARE @table_name   NVARCHAR(128)
+    DECLARE @source_count BIGINT
+    DECLARE @repo_count   BIGINT
+    DECLARE @sql          NVARCHAR(MAX)
+
+    SELECT @delivery_id  = d.id,
+           @agreement_id = d.agreement_id,
+           @firstrow     = COALESCE(y.firstrow, 1)
+      FROM meta.delivery d,
+           meta.agreement a,
+           meta.[type] y
+     WHERE d.id = (SELECT MAX(id) FROM meta.delivery WHERE name = @name)
+       AND a.id = d.agreement_id
+       AND y.id = a.type_id
+
+    SELECT @audit_id = MAX(id)
+      FROM meta.audit
+     WHERE delivery_id = @delivery_id
+       AND stage_id = @stage_id
+
+    IF @audit_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] not available in stage [%d]', 11, 1, @name, @stage_id)
+        RETURN 2
+    END
+
+    SELECT @lineage = value
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+       AND attribute_name = 'LINEAGE'
+
+    --| Nothing to do unless enabled
+    IF COALESCE(@lineage, 'NO') <> 'YES' RETURN
+
+    SELECT @table_name = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = CASE WHEN @stage_id = 1 THEN 'temp' ELSE 'repo' END
+
+    BEGIN TRY
+        --| The load order is kept in dw_line_no (see meta.agreement_lineage_add)
+        SET @sql = CASE WHEN @stage_id = 1 THEN 'temp' ELSE 'repo' END
+        IF COL_LENGTH('[' + @sql + '].[' + @table_name + ']', 'dw_line_no') IS NULL
+            RAISERROR('No dw_line_no in [%s].[%s] - use meta.agreement_lineage_add to keep lineage', 11, 1, @sql, @table_name)
+
+        IF @stage_id = 1
+        BEGIN
+            --| Keep raw temp rows as JSON (all NVARCHAR columns - or the single data column)
+            DELETE FROM meta.delivery_source
+             WHERE delivery_id = @delivery_id
+
+            SET @sql = CAST('INSERT INTO meta.delivery_source (delivery_id, line_no, dw_line_no, raw)'
+                     + ' SELECT @delivery_id, t.dw_line_no - MIN(t.dw_line_no) OVER () + @firstrow, t.dw_line_no,'
+                     + ' JSON_MODIFY((SELECT t.* FOR JSON PATH, WITHOUT_ARRAY_WRAPPER, INCLUDE_NULL_VALUES), ''$.dw_line_no'', NULL)'
+                     + ' FROM [temp].[' + @table_name + '] t' AS NVARCHAR(MAX))
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql, N'@delivery_id BIGINT, @firstrow INT', @delivery_id = @delivery_id, @firstrow = @firstrow
+        END
+        ELSE
+        BEGIN
+            --| Link source lines to repo rows on the load order
+            SET @sql = CAST('UPDATE s SET dw_row_id = r.dw_row_id'
+                     + '  FROM meta.delivery_source s'
+                     + '       INNER JOIN'
+                     + '       [repo].[' + @table_name + '] r ON (r.dw_delivery_id = s.delivery_id AND r.dw_line_no = s.dw_line_no)'
+                     + ' WHERE s.delivery_id = @delivery_id' AS NVARCHAR(MAX))
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql, N'@delivery_id BIGINT', @delivery_id = @delivery_id
+
+            SELECT @source_count = COUNT(*)
+              FROM meta.delivery_source
+             WHERE delivery_id = @delivery_id
+               AND dw_row_id IS NULL
+
+            SET @sql = 'SELECT @o_count = COUNT(*) FROM [repo].[' + @table_name + '] WHERE dw_delivery_id = @delivery_id'
+            EXEC sp_executesql @sql, N'@delivery_id BIGINT, @o_count BIGINT OUT', @delivery_id = @delivery_id, @o_count = @repo_count OUT
+
+            --| Logged as operation by the error handling below
+            IF @source_count > 0
+                RAISERROR('[%I64d] source lines without repo row ([%I64d] repo rows)', 11, 1, @source_count, @repo_count)
+        END
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        SET @msg = LEFT('Lineage failed: ' + ERROR_MESSAGE(), 250)
+        EXEC meta.operation_add @audit_id, 2, @@PROCID, @msg
+        RAISERROR('%s', 11, 1, @msg)
+        RETURN 10
+    END CATCH
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[get_lineage] --|
+--| ==========================================================================================
+--| Description: Return the origin of a repo row: delivery (file), source line number and the
+--|              raw source line (if kept, see attribute LINEAGE)
+--| Arguments:
+(
+    @username  NVARCHAR(250), --| Username of requestor
+    @name      NVARCHAR(250), --| Name of agreement
+    @dw_row_id BIGINT         --| ID of row in repo
+)
+AS 
+SET NOCOUNT ON
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id  BIGINT
+    DECLARE @table_name   NVARCHAR(128)
+    DECLARE @sql          NVARCHAR(MAX)
+
+    SELECT @agreement_id = id
+      FROM meta.agreement
+     WHERE name = @name
+
+    IF COALESCE(meta.user_access(@username, @agreement_id, 'VIEW'), 0) = 0
+    BEGIN
+        RAISERROR('User [%s] does not have VIEW permission on agreement [%s]', 11, 1, @username, @name)
+        RETURN 2
+    END
+
+    SELECT @table_name = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+
+    SET @sql = 'SELECT @o_delivery_id = dw_delivery_id FROM [repo].[' + @table_name + '] WHERE dw_row_id = @dw_row_id'
+    EXEC sp_executesql @sql, N'@dw_row_id BIGINT, @o_delivery_id BIGINT OUT', @dw_row_id = @dw_row_id, @o_delivery_id = @delivery_id OUT
+
+    IF @delivery_id IS NULL
+    BEGIN
+        RAISERROR('Row [%I64d] does not exist in agreement [%s]', 11, 1, @dw_row_id, @name)
+        RETURN 3
+    END
+
+    SELECT a.id AS agreement_id,
+           a.name AS agreement_name,
+           d.id AS dw_delivery_id,
+           @dw_row_id AS dw_row_id,
+           d.name AS file_name,
+           d.status_date,
+           d.createdtm AS delivery_createdtm,
+           s.line_no,
+           s.raw
+      FROM meta.delivery d
+           INNER JOIN
+           meta.agreement a ON (a.id = d.agreement_id)
+           LEFT OUTER JOIN
+           meta.delivery_source s ON (s.delivery_id = d.id AND s.dw_row_id = @dw_row_id)
+     WHERE d.id = @delivery_id
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+DROP PROCEDURE [meta].[get_lineage]
+;
+DROP PROCEDURE [meta].[delivery_lineage]
+;
+DROP PROCEDURE [meta].[agreement_lineage_add]
+;
+ALTER
+PROCEDURE[meta].[generic_file2temp] --|
+--| ==========================================================================================
+--| Author:      Soren Bak Larsen
+--| Description: Load the data from the delivery file into the load table in temp.
+--|              Use the provided name to lookup the agreement and other information from meta
+--|              data and make sure the temp schema table is created and populated from the
+--|              specified file name.
+--| Arguments:             
+(
+    @delivery_id BIGINT,
+    @path NVARCHAR(250)
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+
+    DECLARE @count INT
+    DECLARE @agreement_id  BIGINT
+    DECLARE @name NVARCHAR(250)
+    DECLARE @size          BIGINT
+
+    --| Lookup the essential arguments from the delivery
+    SELECT @agreement_id = agreement_id, @name = name, @size = size
+      FROM meta.delivery
+     WHERE id = @delivery_id
+
+    --| Lookup the type details from the delivery_id via the agreement
+    EXEC meta.debug @@PROCID, 'Lookup type details name in meta data via agreement'
+    DECLARE @schema                NVARCHAR(50)
+    DECLARE @table                 NVARCHAR(128)
+    -- BULK INSERT arguments
+    DECLARE @batchsize             INT
+    DECLARE @check_constraints     BIT
+    DECLARE @codepage              NVARCHAR(10)
+    DECLARE @datafiletype          NVARCHAR(10)
+    DECLARE @fieldterminator       NVARCHAR(10)
+    DECLARE @firstrow              INT
+    DECLARE @fire_triggers         BIT
+    DECLARE @format_file           NVARCHAR(250)
+    DECLARE @keepidentity          BIT
+    DECLARE @keepnulls             BIT
+    DECLARE @kilobytes_per_batch   INT
+    DECLARE @lastrow               INT
+    DECLARE @maxerrors             INT
+    DECLARE @order                 NVARCHAR(500)
+    DECLARE @rows_per_batch        INT
+    DECLARE @rowterminator         NVARCHAR(10)
+    DECLARE @tablock               BIT
+    DECLARE @errorfile             NVARCHAR(250)
+    DECLARE @data_source           NVARCHAR(50)
+    DECLARE @errorfile_data_source NVARCHAR(50)
+    DECLARE @nvarchar_max_load     NVARCHAR(1000)
+
+    SELECT @schema = t.table_schema,
+           @table = t.table_name,
+           @nvarchar_max_load = u.value,
+           -- BULK INSERT arguments
+           @batchsize = y.[batchsize],
+           @check_constraints = y.[check_constraints],
+           @codepage = y.[codepage],
+           @datafiletype = y.[datafiletype],
+           @fieldterminator = y.[fieldterminator],
+           @firstrow = y.[firstrow],
+           @fire_triggers = y.[fire_triggers],
+           @format_file = y.[format_file],
+           @keepidentity = y.[keepidentity],
+           @keepnulls = y.[keepnulls],
+           @kilobytes_per_batch = y.[kilobytes_per_batch],
+           @lastrow = y.[lastrow],
+           @maxerrors = y.[maxerrors],
+           @order = y.[order],
+           @rows_per_batch = y.[rows_per_batch],
+           @rowterminator = y.[rowterminator],
+           @tablock = y.[tablock],
+           @errorfile = y.[errorfile],
+           @data_source = y.[data_source],
+           @errorfile_data_source = y.[data_source]
+      FROM meta.[type]                  y,
+           meta.agreement               a,
+           meta.agreement_stage_table_v t,
+           meta.agreement_attribute_v   u
+     WHERE a.id = t.agreement_id
+       AND a.id = u.agreement_id
+       AND y.id = a.type_id
+       AND a.id = @agreement_id
+       AND t.table_schema   = 'temp'
+       AND u.attribute_name = 'NVARCHAR_MAX_LOAD'
+
+    -- + Prepend path to form the full name of the delivery
+    IF COALESCE(@path, '') <> '' SET @name = @path + @name
+
+    -- + Set the field limiter to \0 if row size of target table exceeds 4000 (NVARCHAR) due to
+    --+ hard limit of 8060 in MS SQL. This is optional as some data deliveries manages - others
+    --+ dont - and changing the behavior has a great performance impact.
+    --+ The attribute NVARCHAR_MAX_LOAD controls the setting.
+    IF @nvarchar_max_load = 'YES' SET @fieldterminator = '\0'
+
+    -- | Prepare BULK INSERT statement from retrieved parameters (meta.type)
+    DECLARE @sql NVARCHAR(4000)
+    EXEC meta.debug @@PROCID, 'Prepare BULK INSERT statement'   
+    SET @sql = 'BULK INSERT [' + @schema + '].[' + @table + '] FROM ''' + @name + ''' WITH ('
+    
+    --+ Replace dynamic parameters
+    SET @errorfile   = REPLACE(@errorfile, '{datafile}', @name)
+    SET @format_file = REPLACE(@format_file, '{datafile}', @name)
+
+    IF @batchsize             IS NOT NULL SET @sql = @sql + 'BATCHSIZE='''             + @batchsize             + ''','
+    IF @codepage              IS NOT NULL SET @sql = @sql + 'CODEPAGE='''              + @codepage              + ''','
+    IF @datafiletype          IS NOT NULL SET @sql = @sql + 'DATAFILETYPE='''          + @datafiletype          + ''','
+    IF @fieldterminator       IS NOT NULL SET @sql = @sql + 'FIELDTERMINATOR='''       + @fieldterminator       + ''','
+    IF @format_file           IS NOT NULL SET @sql = @sql + 'FORMAT_FILE='''           + @format_file           + ''','
+    IF @order                 IS NOT NULL SET @sql = @sql + 'ORDER='''                 + @order                 + ''','
+    IF @rowterminator         IS NOT NULL SET @sql = @sql + 'ROWTERMINATOR='''         + @rowterminator         + ''','
+    IF @errorfile             IS NOT NULL SET @sql = @sql + 'ERRORFILE='''             + @errorfile             + ''','
+    IF @data_source           IS NOT NULL SET @sql = @sql + 'DATA_SOURCE='''           + @data_source           + ''','
+    IF @errorfile_data_source IS NOT NULL SET @sql = @sql + 'ERRORFILE_DATA_SOURCE=''' + @errorfile_data_source + ''','
+
+    IF @firstrow              IS NOT NULL SET @sql = @sql + 'FIRSTROW='                + CAST(@firstrow            AS NVARCHAR) + ','
+    IF @kilobytes_per_batch   IS NOT NULL SET @sql = @sql + 'KILOBYTES_PER_BATCH='     + CAST(@kilobytes_per_batch AS NVARCHAR) + ','
+    IF @lastrow               IS NOT NULL SET @sql = @sql + 'LASTROW='                 + CAST(@lastrow             AS NVARCHAR) + ','
+    IF @maxerrors             IS NOT NULL SET @sql = @sql + 'MAXERRORS='               + CAST(@maxerrors           AS NVARCHAR) + ','
+    IF @rows_per_batch        IS NOT NULL SET @sql = @sql + 'ROWS_PER_BATCH='          + CAST(@rows_per_batch      AS NVARCHAR) + ','
+
+    IF @check_constraints = 1 SET @sql = @sql + 'CHECKCONSTRAINTS,'
+    IF @fire_triggers = 1     SET @sql = @sql + 'FIRE_TRIGGERS,'
+    IF @keepidentity = 1      SET @sql = @sql + 'KEEPIDENTITY,'
+    IF @keepnulls = 1         SET @sql = @sql + 'KEEPNULLS,'
+    IF @tablock = 1           SET @sql = @sql + 'TABLOCK,'
+
+    SET @sql = LEFT(@sql, LEN(@sql) - 1) + ')'
+
+    -- + Execute the BULK INSERT statement
+    DECLARE @bulk_count INT
+    EXEC meta.debug @@PROCID, @sql
+    EXEC sp_executesql @sql
+
+    --+ Get the number of rows reported by BULK INSERT
+    SET @bulk_count = @@ROWCOUNT
+    EXEC meta.debug @@PROCID, 'Rows affected by BULK INSERT'
+    EXEC meta.debug @@PROCID, @bulk_count
+
+    --! --------------------------------------------------------------------------------------------------
+    --! NOTE: For some reason the number of errors encountered during BULK INSERT is impossible to
+    --!       identify using any best practices published by the vendor.So in order to break properly
+    --!       when encountering errors, the.Error.Txt file must be inspected manually
+    --! NOTE: The xp_fileexist extended (and undocumented) procedure suggested by many parties does not 
+    --!       work with network paths
+    --! NOTE: Apparently the only way to properly determine if any error rows were skipped is to try
+    --!       to load the.error file and break if it exists
+    --! --------------------------------------------------------------------------------------------------
+    
+    --| Inspect the .error files to retrieve (possible) errors and break properly if so
+    --+ Checking for errors is triggered by the @errorfile parameter
+    IF @errorfile IS NOT NULL
+    BEGIN
+        EXEC meta.debug @@PROCID, 'Performing error check of BULK LOAD'
+        
+        --+ Check if the.error file exists by trying to load it with no tolerance
+        --+ First prepare new BULK LOAD statement which will break no matter what - and handle the error
+        --+ code accordingly
+        SET @sql = REPLACE(@sql, ' FROM ''' + @name + ''' WITH', ' FROM ''' + @errorfile + ''' WITH')
+        SET @sql = REPLACE(@sql, 'ERRORFILE=''' + @errorfile + '''', 'ERRORFILE=''' + @errorfile + '.check''')
+        SET @sql = CASE WHEN @maxerrors IS NULL THEN REPLACE(@sql, 'WITH (', ' WITH (MAXERRORS=0,')
+                        ELSE REPLACE(@sql, 'MAXERRORS=' + CAST(@maxerrors AS NVARCHAR), 'MAXERRORS=0')
+                   END
+        SET @sql = CASE WHEN @firstrow IS NULL THEN REPLACE(@sql, 'WITH (', ' WITH (FIRSTROW=1,')
+                        ELSE REPLACE(@sql, 'FIRSTROW=' + CAST(@firstrow AS NVARCHAR), 'FIRSTROW=1')
+                   END
+        --+ Execute the error check in a nested TRY/CATCH block
+        BEGIN TRY
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+        END TRY
+        BEGIN CATCH
+            --+ Handle the 7330 (Cannot fetch row from OLE DB provider) - meaning error file exists
+            IF ERROR_NUMBER() = 7330 RAISERROR('Errors encountered in BULK LOAD - see [%s]', 11, 1, @errorfile)
+        END CATCH
+    END
+
+    --| Compare the number of rows inserted with rows read
+    --+ Get number of rows inserted
+    EXEC meta.debug @@PROCID, 'Get number of rows inserted into temp table'
+    SET @sql = N'SELECT @rows = COUNT(*) FROM [' + @schema + '].[' + @table + ']'
+    EXEC sp_executesql @sql, N'@rows BIGINT OUTPUT', @count OUTPUT
+    EXEC meta.debug @@PROCID, @count
+
+    --+ Error if the two counts do not match
+    IF @bulk_count <> @count
+        RAISERROR('Count mismatch, BULK INSERT [%d] and SELECT COUNT(*) [%d]', 11, 1, @bulk_count, @count)
+
+    --| Update the delivery meta data
+    EXEC meta.debug @@PROCID, 'Update meta.delivery'
+    UPDATE meta.delivery
+       SET size = @count
+     WHERE id = @delivery_id
+
+    RETURN
+END           
+--| ==========================================================================================
+;
+ALTER
+PROCEDURE[meta].[delivery_scd2] --|
+--| ==========================================================================================
+--| Description: Apply a delivery published to repo onto the SCD2 history of the agreement
+--|              (attribute SCD2 = YES) keyed on BUSINESS_KEY:
+--|                - new or changed entities get a new version valid from the status date
+--|                - the previous version of changed entities is valid until the status date
+--|                - entities missing in FULL deliveries (or deleted in DELTA deliveries)
+--|                  are valid until the status date
+--|              The history table [repo].[<repo table>_scd2] is created on first use.
+--| Arguments:
+(
+    @name NVARCHAR(250)  --| Name of delivery (file)
+)
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg          NVARCHAR(4000)
+    DECLARE @agreement_id BIGINT
+    DECLARE @delivery_id  BIGINT
+    DECLARE @audit_id     BIGINT
+    DECLARE @valid        DATE
+    DECLARE @latest       DATE
+    DECLARE @scd2         NVARCHAR(3)
+    DECLARE @mode         NVARCHAR(10)
+    DECLARE @keys         NVARCHAR(1000)
+    DECLARE @operation    NVARCHAR(128)
+    DECLARE @repo_name    NVARCHAR(100)
+    DECLARE @hist         NVARCHAR(200)
+    DECLARE @cols         NVARCHAR(MAX)
+    DECLARE @scols        NVARCHAR(MAX)
+    DECLARE @hash         NVARCHAR(MAX)
+    DECLARE @join         NVARCHAR(MAX)
+    DECLARE @partition    NVARCHAR(MAX)
+    DECLARE @deleted      NVARCHAR(MAX)
+    DECLARE @sql          NVARCHAR(MAX)
+
+    --| Lookup the latest delivery by name and its repository audit
+    SELECT @delivery_id  = d.id,
+           @agreement_id = d.agreement_id,
+           @valid        = COALESCE(d.status_date, CAST(d.createdtm AS DATE))
+      FROM meta.delivery d
+     WHERE d.id = (SELECT MAX(id) FROM meta.delivery WHERE name = @name)
+
+    SELECT @audit_id = MAX(id)
+      FROM meta.audit
+     WHERE delivery_id = @delivery_id
+       AND stage_id = 3
+
+    IF @audit_id IS NULL
+    BEGIN
+        RAISERROR('Delivery [%s] for repository not available', 11, 1, @name)
+        RETURN 2
+    END
+
+    SELECT @scd2      = MAX(CASE WHEN attribute_name = 'SCD2'                   THEN value END),
+           @mode      = MAX(CASE WHEN attribute_name = 'DELIVERY_MODE'          THEN value END),
+           @keys      = MAX(CASE WHEN attribute_name = 'BUSINESS_KEY'           THEN value END),
+           @operation = MAX(CASE WHEN attribute_name = 'DELTA_OPERATION_COLUMN' THEN value END)
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+
+    --| Nothing to do unless enabled
+    IF COALESCE(@scd2, 'NO') <> 'YES' RETURN
+
+    IF NULLIF(@keys, '') IS NULL
+    BEGIN
+        EXEC meta.operation_add @audit_id, 2, @@PROCID, 'SCD2 requires the BUSINESS_KEY attribute'
+        RAISERROR('SCD2 of delivery [%s] requires the BUSINESS_KEY attribute', 11, 1, @name)
+        RETURN 3
+    END
+
+    SELECT @repo_name = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+    SET @hist = '[repo].[' + @repo_name + '_scd2]'
+
+    --| History has to be applied in status date order
+    IF OBJECT_ID(@hist, 'U') IS NOT NULL
+    BEGIN
+        SET @sql = 'SELECT @o_latest = MAX(valid_from) FROM ' + @hist
+        EXEC sp_executesql @sql, N'@o_latest DATE OUT', @o_latest = @latest OUT
+        IF @latest > @valid
+        BEGIN
+            SET @msg = 'SCD2 skipped - status date [' + CONVERT(NVARCHAR(10), @valid, 120) + '] before latest version [' + CONVERT(NVARCHAR(10), @latest, 120) + ']'
+            EXEC meta.operation_add @audit_id, 2, @@PROCID, @msg
+            RAISERROR('%s', 11, 1, @msg)
+            RETURN 4
+        END
+    END
+
+    --| Prepare column lists: data columns, row hash and business key join/partition
+    SELECT @cols  = COALESCE(@cols + ', ', '') + column_name,
+           @scols = COALESCE(@scols + ', ', '') + 's.' + column_name,
+           @hash  = COALESCE(@hash + ', ''|'', ', '') + 'CAST(' + column_name + ' AS NVARCHAR(MAX))'
+      FROM meta.column_mapping_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+       AND column_name NOT IN ('[dw_delivery_id]', '[dw_row_id]')
+     ORDER BY ordinal_position
+
+    SELECT @join      = COALESCE(@join + ' AND ', '') + 't.[' + LTRIM(RTRIM(value)) + '] = s.[' + LTRIM(RTRIM(value)) + ']',
+           @partition = COALESCE(@partition + ', ', '') + '[' + LTRIM(RTRIM(value)) + ']'
+      FROM STRING_SPLIT(@keys, ',')
+     WHERE LTRIM(RTRIM(value)) <> ''
+
+    SET @deleted = CASE
+                       WHEN @mode = 'DELTA' AND NULLIF(@operation, '') IS NOT NULL
+                       THEN 'CASE WHEN UPPER(CAST([' + @operation + '] AS NVARCHAR(10))) = ''D'' THEN 1 ELSE 0 END'
+                       ELSE '0'
+                   END
+
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        --| Create history table on first use (dw_row_id without IDENTITY)
+        IF OBJECT_ID(@hist, 'U') IS NULL
+        BEGIN
+            SET @sql = CAST('SELECT TOP 0 ' AS NVARCHAR(MAX)) + @cols
+                     + ', CAST(dw_delivery_id AS BIGINT) AS dw_delivery_id, CAST(dw_row_id AS BIGINT) AS dw_row_id'
+                     + ', CAST(NULL AS VARBINARY(32)) AS dw_hash, CAST(NULL AS DATE) AS valid_from, CAST(NULL AS DATE) AS valid_to'
+                     + ' INTO ' + @hist + ' FROM [repo].[' + @repo_name + ']'
+            EXEC meta.debug @@PROCID, @sql
+            EXEC sp_executesql @sql
+        END
+
+        --| Latest row per business key of the delivery
+        SET @sql = CAST('SELECT * INTO #src FROM (SELECT ' AS NVARCHAR(MAX)) + @cols
+                 + ', dw_delivery_id, dw_row_id'
+                 + ', HASHBYTES(''SHA2_256'', CONCAT(' + @hash + ', '''')) AS dw_hash'
+                 + ', ' + @deleted + ' AS dw_deleted'
+                 + ', ROW_NUMBER() OVER (PARTITION BY ' + @partition + ' ORDER BY dw_row_id DESC) AS dw_rank'
+                 + ' FROM [repo].[' + @repo_name + '] WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+                 + ') x WHERE dw_rank = 1 '
+        --+ Close versions of changed and deleted entities
+                 + 'UPDATE t SET valid_to = @valid FROM ' + @hist + ' t, #src s WHERE ' + @join
+                 + ' AND t.valid_to IS NULL AND (t.dw_hash <> s.dw_hash OR s.dw_deleted = 1) '
+        --+ Close versions of entities missing in FULL deliveries
+                 + CASE WHEN COALESCE(@mode, 'FULL') <> 'DELTA'
+                        THEN 'UPDATE t SET valid_to = @valid FROM ' + @hist + ' t WHERE t.valid_to IS NULL'
+                           + ' AND NOT EXISTS (SELECT 1 FROM #src s WHERE ' + @join + ') '
+                        ELSE ''
+                   END
+        --+ Add new versions of new and changed entities
+                 + 'INSERT INTO ' + @hist + ' (' + @cols + ', dw_delivery_id, dw_row_id, dw_hash, valid_from, valid_to)'
+                 + ' SELECT ' + @scols + ', s.dw_delivery_id, s.dw_row_id, s.dw_hash, @valid, NULL FROM #src s'
+                 + ' WHERE s.dw_deleted = 0 AND NOT EXISTS (SELECT 1 FROM ' + @hist + ' t WHERE ' + @join + ' AND t.valid_to IS NULL)'
+        EXEC meta.debug @@PROCID, @sql
+        EXEC sp_executesql @sql, N'@valid DATE', @valid = @valid
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        IF @@trancount > 0 ROLLBACK TRANSACTION
+        SET @msg = LEFT('SCD2 failed: ' + ERROR_MESSAGE(), 250)
+        EXEC meta.operation_add @audit_id, 2, @@PROCID, @msg
+        RAISERROR('%s', 11, 1, @msg)
+        RETURN 10
+    END CATCH
+
+    COMMIT TRANSACTION
+
+    SET @msg = 'SCD2 applied as of [' + CONVERT(NVARCHAR(10), @valid, 120) + ']'
+    EXEC meta.operation_add @audit_id, 1, @@PROCID, @msg
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+DROP TABLE [meta].[delivery_source]
+;
+DELETE FROM [meta].[agreement_attribute]
+ WHERE attribute_id IN (SELECT id FROM [meta].[attribute] WHERE name = 'LINEAGE')
+;
+DELETE FROM [meta].[attribute]
+ WHERE name = 'LINEAGE'
+;
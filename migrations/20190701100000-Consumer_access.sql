@@ -0,0 +1,471 @@
+
+-- +migrate Up
+ALTER TABLE[meta].[link] ADD [agreement_id] [bigint] NULL
+;
+UPDATE l
+   SET agreement_id = d.agreement_id
+  FROM meta.link l,
+       meta.delivery d
+ WHERE d.id = l.dw_delivery_id
+;
+ALTER
+VIEW[meta].[link_v] --|
+--| ==========================================================================================
+--| Description: External link details of deliveries - the agreement is kept on the link, so
+--|              denied retrievals (without delivery) are related to the agreement too
+--| ==========================================================================================
+AS
+SELECT l.id,
+       l.external_id,
+       l.dw_delivery_id,
+       l.user_id,
+       l.status_id,
+       l.createdtm,
+       COALESCE(d.agreement_id, l.agreement_id, -1) AS agreement_id,
+       COALESCE(u.username, 'N/A')  AS user_username,
+       COALESCE(u.realname, 'N/A')  AS user_realname,
+       COALESCE(d.name, 'N/A')  AS delivery_name
+  FROM meta.link l
+       LEFT OUTER JOIN
+       meta.delivery d
+       ON (d.id = l.dw_delivery_id)
+       LEFT OUTER JOIN
+       meta.[user] u
+       ON (u.id = l.user_id)
+;
+CREATE
+VIEW[meta].[agreement_consumer_v] --|
+--| ==========================================================================================
+--| Description: Consumers (users) retrieving data of an agreement with number of successful
+--|              and denied retrievals and the first/last access
+--| ==========================================================================================
+AS
+SELECT l.agreement_id,
+       l.user_id,
+       l.user_username,
+       l.user_realname,
+       SUM(CASE WHEN l.status_id = 1 THEN 1 ELSE 0 END) AS retrieval_count,
+       SUM(CASE WHEN l.status_id = 1 THEN 0 ELSE 1 END) AS denied_count,
+       COUNT(DISTINCT CASE WHEN l.status_id = 1 THEN l.dw_delivery_id END) AS delivery_count,
+       MIN(l.createdtm) AS first_access,
+       MAX(CASE WHEN l.status_id = 1 THEN l.createdtm END) AS last_access,
+       MAX(CASE WHEN l.status_id = 1 THEN l.dw_delivery_id END) AS last_delivery_id
+  FROM meta.link_v l
+ GROUP BY l.agreement_id, l.user_id, l.user_username, l.user_realname
+;
+CREATE
+VIEW[meta].[agreement_usage_v] --|
+--| ==========================================================================================
+--| Description: Consumption of agreements for finding dead feeds: consumers, retrievals, last
+--|              access and the number of published deliveries never retrieved
+--| ==========================================================================================
+AS
+SELECT a.id AS agreement_id,
+       a.name AS agreement_name,
+       COALESCE(c.consumer_count, 0) AS consumer_count,
+       COALESCE(c.retrieval_count, 0) AS retrieval_count,
+       COALESCE(c.denied_count, 0) AS denied_count,
+       c.last_access,
+       (SELECT COUNT(*)
+          FROM meta.delivery d
+         WHERE d.agreement_id = a.id
+           AND EXISTS (SELECT 1 FROM meta.audit u WHERE u.delivery_id = d.id AND u.stage_id = 3)) AS published_count,
+       (SELECT COUNT(*)
+          FROM meta.delivery d
+         WHERE d.agreement_id = a.id
+           AND EXISTS (SELECT 1 FROM meta.audit u WHERE u.delivery_id = d.id AND u.stage_id = 3)
+           AND NOT EXISTS (SELECT 1 FROM meta.link l WHERE l.dw_delivery_id = d.id AND l.status_id = 1)) AS unconsumed_count
+  FROM meta.agreement a
+       LEFT OUTER JOIN
+       (SELECT agreement_id,
+               COUNT(DISTINCT CASE WHEN retrieval_count > 0 THEN user_id END) AS consumer_count,
+               SUM(retrieval_count) AS retrieval_count,
+               SUM(denied_count) AS denied_count,
+               MAX(last_access) AS last_access
+          FROM meta.agreement_consumer_v
+         GROUP BY agreement_id) c ON (c.agreement_id = a.id)
+;
+ALTER
+PROCEDURE[meta].[get_data] --|
+--| ==========================================================================================
+--| Description: Return the dataset related to the delivery agreement matching the date/time,
+--|              i.e.delivered as the latest delivery BEFORE the @delivery_date provided.
+--|              Defaults to current date/time.If @delivery_id is provided, any date/time
+--|              constraints are overridden.For DELTA agreements the current state as of
+--|              the delivery is returned (see meta.get_delta_sql)
+--| Arguments:
+(
+    @username NVARCHAR(250), --| Username of requestor
+@name                  NVARCHAR(250), --| Name of agreement
+@external_id           BIGINT,        --| ID of external data item(e.g. in AAC)
+    @delivery_date NVARCHAR(25),  --| Date string (YYYY-MM-DD[HH24:MI:SS]) of latest
+                                          --| delivery up until
+    @delivery_id           BIGINT         --| ID of delivery - blank/NULL => latest based on 
+                                          --| @delivery_date
+)
+AS 
+SET NOCOUNT ON
+SET ANSI_WARNINGS OFF
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @table_schema NVARCHAR(50)
+    DECLARE @table_name   NVARCHAR(100)
+    DECLARE @agreement_id BIGINT
+    DECLARE @sql NVARCHAR(MAX)
+    DECLARE @date         DATETIME
+    DECLARE @user_id BIGINT
+    DECLARE @delta        NVARCHAR(MAX)
+
+    --| Setup the date for latest delivery
+    SET @date = COALESCE(CONVERT(DATETIME, @delivery_date, 120), GETDATE())
+
+    -- | Collect meta data
+    --+ Get the agreement_id based on name
+    SELECT @agreement_id = id
+      FROM meta.agreement
+     WHERE name = @name
+
+    -- | Check user permissions
+    SET @user_id = meta.user_access(@username, @agreement_id, 'VIEW')
+    IF COALESCE(@user_id, 0) = 0 
+    BEGIN
+        --+ Lookup the user(without VIEW permissions) in order to log retrieval attempt
+       SELECT @user_id = id
+         FROM meta.[user]
+        WHERE username = @username
+
+        --+ Insert the failed link in status error
+        INSERT INTO meta.[link]
+               (external_id, dw_delivery_id, user_id, status_id, agreement_id)
+        VALUES(@external_id, @delivery_id, @user_id, 2, @agreement_id)
+
+
+        RAISERROR('User [%s] does not have VIEW permission on agreement [%I64d]', 11, 1, @username, @agreement_id)
+        RETURN 2
+    END
+
+    --+ TODO: ERROR-HANDLING
+
+    --| Get the MAX_AGE_DAYS attribute for limiting the retrieval back in time
+    DECLARE @max_age_days INT
+    DECLARE @max_age_days_c NVARCHAR(50)
+    SELECT @max_age_days_c = value
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+       AND attribute_name = 'MAX_AGE_DAYS'
+
+    -- + Set default 7
+    SET @max_age_days = 7
+    -- + Override with actual value if valid numeric
+    IF meta.check_numeric(@max_age_days_c, 12, 0) = 0 SET @max_age_days = CAST(@max_age_days_c AS INT)
+    EXEC meta.debug @@PROCID, @max_age_days
+    
+    --| Get MAX delivery id with[@date - @max_age_days <= createdtm <= @date] if no specific 
+    --| delivery id is provided
+    --+ NOTE: The state of DELTA agreements does not age, so any earlier delivery will do
+    SET @delta = meta.get_delta_sql(@agreement_id, NULL)
+    IF COALESCE(@delivery_id, 0) = 0
+        SELECT @delivery_id = MAX(id)
+          FROM meta.delivery
+         WHERE agreement_id = @agreement_id
+           AND (status_date BETWEEN @date - @max_age_days AND @date
+                OR (status_date <= @date AND @delta IS NOT NULL))
+
+    EXEC meta.debug @@PROCID, @agreement_id
+    EXEC meta.debug @@PROCID, @delivery_id
+    
+    --+ Get repo table name
+    SELECT @table_schema = table_schema,
+           @table_name   = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+
+    -- + TODO: ERROR-HANDLING
+
+    --+ Get the columns for the repo table
+    DECLARE rec CURSOR FOR
+    SELECT column_name,
+           data_type,
+           character_maximum_length,
+           numeric_precision,
+           numeric_scale
+      FROM meta.column_mapping_v
+     WHERE table_schema = @table_schema
+       AND table_name = @table_name
+     ORDER BY ordinal_position
+
+    --+ Open cursor
+    OPEN rec
+
+    --+ Prepare (daft MS SQL) variables
+    DECLARE @column_name NVARCHAR(128)
+    DECLARE @data_type                 NVARCHAR(128)
+    DECLARE @character_maximum_length  INT
+    DECLARE @numeric_precision TINYINT
+    DECLARE @numeric_scale             INT
+    DECLARE @col NVARCHAR(500)
+
+    SET @sql = CAST('SELECT ' AS NVARCHAR(MAX))
+
+    -- + Prepare(daft MS SQL) loop
+    FETCH NEXT FROM rec
+    INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+    --| Loop over columns in repo table for delivery
+    EXEC meta.debug @@PROCID, 'Loop over list of columns'
+    WHILE @@FETCH_STATUS = 0
+    BEGIN
+        --| MAP: 
+        SET @col =
+            CASE
+                --| NVARCHAR(MAX) => NVARCHAR(4000) due to GUI/AAC cannot handle MAX
+                WHEN @data_type = 'nvarchar' AND @character_maximum_length = -1 THEN 'CAST(' + @column_name + ' AS NVARCHAR(MAX)) ' + @column_name
+                --| <other types> => - No mapping -
+                ELSE @column_name
+            END
+
+        --+ Repeat(daft MS SQL) fetch
+       FETCH NEXT FROM rec
+       INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+       EXEC meta.debug @@PROCID, @col
+       SET @sql = @sql + CAST(@col AS NVARCHAR(MAX))
+        IF @@FETCH_STATUS = 0 SET @sql = @sql + CAST(',' AS NVARCHAR(MAX))
+    END
+
+    EXEC meta.debug @@PROCID, 'Close and deallocate cursor'
+    CLOSE rec
+    DEALLOCATE rec
+
+    
+    --| Insert the link
+    INSERT INTO meta.[link]
+           (external_id, dw_delivery_id, user_id, status_id, agreement_id)
+    VALUES (@external_id, @delivery_id, @user_id, 1, @agreement_id)
+
+    --| Finish and execute SQL statement outputting delivery table (or DELTA state)
+    SET @delta = meta.get_delta_sql(@agreement_id, @delivery_id)
+    IF @delta IS NOT NULL
+        SET @sql = @sql + CAST(' FROM ' AS NVARCHAR(MAX)) + @delta
+                        + CAST(' ORDER BY dw_delivery_id ASC, dw_row_id ASC' AS NVARCHAR(MAX))
+    ELSE
+        SET @sql = @sql + CAST(' FROM [' + @table_schema + '].[' + @table_name + '] '
+                        + 'WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR) AS NVARCHAR(MAX))
+                        + CAST(' ORDER BY dw_row_id ASC' AS NVARCHAR(MAX))
+
+
+    EXEC meta.debug @@PROCID, 'Execute query to return proper dataset'
+    EXEC meta.debug @@PROCID, @sql
+    EXEC sp_executesql @sql
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+ALTER
+PROCEDURE[meta].[get_data] --|
+--| ==========================================================================================
+--| Description: Return the dataset related to the delivery agreement matching the date/time,
+--|              i.e.delivered as the latest delivery BEFORE the @delivery_date provided.
+--|              Defaults to current date/time.If @delivery_id is provided, any date/time
+--|              constraints are overridden.For DELTA agreements the current state as of
+--|              the delivery is returned (see meta.get_delta_sql)
+--| Arguments:
+(
+    @username NVARCHAR(250), --| Username of requestor
+@name                  NVARCHAR(250), --| Name of agreement
+@external_id           BIGINT,        --| ID of external data item(e.g. in AAC)
+    @delivery_date NVARCHAR(25),  --| Date string (YYYY-MM-DD[HH24:MI:SS]) of latest
+                                          --| delivery up until
+    @delivery_id           BIGINT         --| ID of delivery - blank/NULL => latest based on 
+                                          --| @delivery_date
+)
+AS 
+SET NOCOUNT ON
+SET ANSI_WARNINGS OFF
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @table_schema NVARCHAR(50)
+    DECLARE @table_name   NVARCHAR(100)
+    DECLARE @agreement_id BIGINT
+    DECLARE @sql NVARCHAR(MAX)
+    DECLARE @date         DATETIME
+    DECLARE @user_id BIGINT
+    DECLARE @delta        NVARCHAR(MAX)
+
+    --| Setup the date for latest delivery
+    SET @date = COALESCE(CONVERT(DATETIME, @delivery_date, 120), GETDATE())
+
+    -- | Collect meta data
+    --+ Get the agreement_id based on name
+    SELECT @agreement_id = id
+      FROM meta.agreement
+     WHERE name = @name
+
+    -- | Check user permissions
+    SET @user_id = meta.user_access(@username, @agreement_id, 'VIEW')
+    IF COALESCE(@user_id, 0) = 0 
+    BEGIN
+        --+ Lookup the user(without VIEW permissions) in order to log retrieval attempt
+       SELECT @user_id = id
+         FROM meta.[user]
+        WHERE username = @username
+
+        --+ Insert the failed link in status error
+        INSERT INTO meta.[link]
+               (external_id, dw_delivery_id, user_id, status_id)
+        VALUES(@external_id, @delivery_id, @user_id, 2)
+
+
+        RAISERROR('User [%s] does not have VIEW permission on agreement [%I64d]', 11, 1, @username, @agreement_id)
+        RETURN 2
+    END
+
+    --+ TODO: ERROR-HANDLING
+
+    --| Get the MAX_AGE_DAYS attribute for limiting the retrieval back in time
+    DECLARE @max_age_days INT
+    DECLARE @max_age_days_c NVARCHAR(50)
+    SELECT @max_age_days_c = value
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+       AND attribute_name = 'MAX_AGE_DAYS'
+
+    -- + Set default 7
+    SET @max_age_days = 7
+    -- + Override with actual value if valid numeric
+    IF meta.check_numeric(@max_age_days_c, 12, 0) = 0 SET @max_age_days = CAST(@max_age_days_c AS INT)
+    EXEC meta.debug @@PROCID, @max_age_days
+    
+    --| Get MAX delivery id with[@date - @max_age_days <= createdtm <= @date] if no specific 
+    --| delivery id is provided
+    --+ NOTE: The state of DELTA agreements does not age, so any earlier delivery will do
+    SET @delta = meta.get_delta_sql(@agreement_id, NULL)
+    IF COALESCE(@delivery_id, 0) = 0
+        SELECT @delivery_id = MAX(id)
+          FROM meta.delivery
+         WHERE agreement_id = @agreement_id
+           AND (status_date BETWEEN @date - @max_age_days AND @date
+                OR (status_date <= @date AND @delta IS NOT NULL))
+
+    EXEC meta.debug @@PROCID, @agreement_id
+    EXEC meta.debug @@PROCID, @delivery_id
+    
+    --+ Get repo table name
+    SELECT @table_schema = table_schema,
+           @table_name   = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+
+    -- + TODO: ERROR-HANDLING
+
+    --+ Get the columns for the repo table
+    DECLARE rec CURSOR FOR
+    SELECT column_name,
+           data_type,
+           character_maximum_length,
+           numeric_precision,
+           numeric_scale
+      FROM meta.column_mapping_v
+     WHERE table_schema = @table_schema
+       AND table_name = @table_name
+     ORDER BY ordinal_position
+
+    --+ Open cursor
+    OPEN rec
+
+    --+ Prepare (daft MS SQL) variables
+    DECLARE @column_name NVARCHAR(128)
+    DECLARE @data_type                 NVARCHAR(128)
+    DECLARE @character_maximum_length  INT
+    DECLARE @numeric_precision TINYINT
+    DECLARE @numeric_scale             INT
+    DECLARE @col NVARCHAR(500)
+
+    SET @sql = CAST('SELECT ' AS NVARCHAR(MAX))
+
+    -- + Prepare(daft MS SQL) loop
+    FETCH NEXT FROM rec
+    INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+    --| Loop over columns in repo table for delivery
+    EXEC meta.debug @@PROCID, 'Loop over list of columns'
+    WHILE @@FETCH_STATUS = 0
+    BEGIN
+        --| MAP: 
+        SET @col =
+            CASE
+                --| NVARCHAR(MAX) => NVARCHAR(4000) due to GUI/AAC cannot handle MAX
+                WHEN @data_type = 'nvarchar' AND @character_maximum_length = -1 THEN 'CAST(' + @column_name + ' AS NVARCHAR(MAX)) ' + @column_name
+                --| <other types> => - No mapping -
+                ELSE @column_name
+            END
+
+        --+ Repeat(daft MS SQL) fetch
+       FETCH NEXT FROM rec
+       INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+       EXEC meta.debug @@PROCID, @col
+       SET @sql = @sql + CAST(@col AS NVARCHAR(MAX))
+        IF @@FETCH_STATUS = 0 SET @sql = @sql + CAST(',' AS NVARCHAR(MAX))
+    END
+
+    EXEC meta.debug @@PROCID, 'Close and deallocate cursor'
+    CLOSE rec
+    DEALLOCATE rec
+
+    
+    --| Insert the link
+    INSERT INTO meta.[link]
+           (external_id, dw_delivery_id, user_id, status_id)
+    VALUES (@external_id, @delivery_id, @user_id, 1)
+
+    --| Finish and execute SQL statement outputting delivery table (or DELTA state)
+    SET @delta = meta.get_delta_sql(@agreement_id, @delivery_id)
+    IF @delta IS NOT NULL
+        SET @sql = @sql + CAST(' FROM ' AS NVARCHAR(MAX)) + @delta
+                        + CAST(' ORDER BY dw_delivery_id ASC, dw_row_id ASC' AS NVARCHAR(MAX))
+    ELSE
+        SET @sql = @sql + CAST(' FROM [' + @table_schema + '].[' + @table_name + '] '
+                        + 'WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR) AS NVARCHAR(MAX))
+                        + CAST(' ORDER BY dw_row_id ASC' AS NVARCHAR(MAX))
+
+
+    EXEC meta.debug @@PROCID, 'Execute query to return proper dataset'
+    EXEC meta.debug @@PROCID, @sql
+    EXEC sp_executesql @sql
+END
+--| ==========================================================================================
+;
+DROP VIEW [meta].[agreement_usage_v]
+;
+DROP VIEW [meta].[agreement_consumer_v]
+;
+ALTER
+VIEW[meta].[link_v] --|
+--| ==========================================================================================
+--| Description: External link details of deliveries
+--| ==========================================================================================
+AS
+SELECT l.id,
+       l.external_id,
+       l.dw_delivery_id,
+       l.user_id,
+       l.status_id,
+       l.createdtm,
+       COALESCE(d.agreement_id, -1) AS agreement_id,
+       COALESCE(u.username, 'N/A')  AS user_username,
+       COALESCE(u.realname, 'N/A')  AS user_realname,
+       COALESCE(d.name, 'N/A')  AS delivery_name
+  FROM meta.link l
+       LEFT OUTER JOIN
+       meta.delivery d
+       ON (d.id = l.dw_delivery_id)
+       LEFT OUTER JOIN
+       meta.[user] u
+       ON (u.id = l.user_id)
+;
+ALTER TABLE [meta].[link] DROP COLUMN [agreement_id]
+;
@@ -0,0 +1,401 @@
+
+-- +migrate Up
+CREATE
+FUNCTION [meta].[get_max_age_days] --|
+--| ==========================================================================================
+--| Description: Return the MAX_AGE_DAYS attribute of an agreement, i.e.the number of days
+--|              back in time a delivery is fetched by get_data - 7 if not a valid number
+--| Arguments:
+(
+    @agreement_id BIGINT  --| ID of agreement
+)
+RETURNS INT
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @max_age_days_c NVARCHAR(50)
+
+    SELECT @max_age_days_c = value
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+       AND attribute_name = 'MAX_AGE_DAYS'
+
+    IF meta.check_numeric(@max_age_days_c, 12, 0) = 0 RETURN CAST(@max_age_days_c AS INT)
+    RETURN 7
+END
+--| ==========================================================================================
+;
+CREATE
+FUNCTION [meta].[get_data_delivery_id] --|
+--| ==========================================================================================
+--| Description: Return the delivery get_data returns as of a date/time, i.e.the latest
+--|              delivery with[@date - MAX_AGE_DAYS <= status_date <= @date].The state of
+--|              DELTA agreements does not age, so any earlier delivery will do.
+--|              NULL is returned if no delivery matches.
+--| Arguments:
+(
+    @agreement_id BIGINT,   --| ID of agreement
+    @date         DATETIME  --| Date/time of latest delivery up until - NULL => now
+)
+RETURNS BIGINT
+AS 
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @delivery_id  BIGINT
+    DECLARE @max_age_days INT
+    DECLARE @delta        NVARCHAR(MAX)
+
+    SET @date = COALESCE(@date, GETDATE())
+    SET @max_age_days = meta.get_max_age_days(@agreement_id)
+    SET @delta = meta.get_delta_sql(@agreement_id, NULL)
+
+    SELECT @delivery_id = MAX(id)
+      FROM meta.delivery
+     WHERE agreement_id = @agreement_id
+       AND (status_date BETWEEN @date - @max_age_days AND @date
+            OR (status_date <= @date AND @delta IS NOT NULL))
+
+    RETURN @delivery_id
+END
+--| ==========================================================================================
+;
+ALTER
+PROCEDURE[meta].[get_data] --|
+--| ==========================================================================================
+--| Description: Return the dataset related to the delivery agreement matching the date/time,
+--|              i.e.delivered as the latest delivery BEFORE the @delivery_date provided.
+--|              Defaults to current date/time.If @delivery_id is provided, any date/time
+--|              constraints are overridden.For DELTA agreements the current state as of
+--|              the delivery is returned (see meta.get_delta_sql)
+--| Arguments:
+(
+    @username NVARCHAR(250), --| Username of requestor
+@name                  NVARCHAR(250), --| Name of agreement
+@external_id           BIGINT,        --| ID of external data item(e.g. in AAC)
+    @delivery_date NVARCHAR(25),  --| Date string (YYYY-MM-DD[HH24:MI:SS]) of latest
+                                          --| delivery up until
+    @delivery_id           BIGINT         --| ID of delivery - blank/NULL => latest based on 
+                                          --| @delivery_date
+)
+AS 
+SET NOCOUNT ON
+SET ANSI_WARNINGS OFF
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @table_schema NVARCHAR(50)
+    DECLARE @table_name   NVARCHAR(100)
+    DECLARE @agreement_id BIGINT
+    DECLARE @sql NVARCHAR(MAX)
+    DECLARE @date         DATETIME
+    DECLARE @user_id BIGINT
+    DECLARE @delta        NVARCHAR(MAX)
+
+    --| Setup the date for latest delivery
+    SET @date = COALESCE(CONVERT(DATETIME, @delivery_date, 120), GETDATE())
+
+    -- | Collect meta data
+    --+ Get the agreement_id based on name
+    SELECT @agreement_id = id
+      FROM meta.agreement
+     WHERE name = @name
+
+    -- | Check user permissions
+    SET @user_id = meta.user_access(@username, @agreement_id, 'VIEW')
+    IF COALESCE(@user_id, 0) = 0 
+    BEGIN
+        --+ Lookup the user(without VIEW permissions) in order to log retrieval attempt
+       SELECT @user_id = id
+         FROM meta.[user]
+        WHERE username = @username
+
+        --+ Insert the failed link in status error
+        INSERT INTO meta.[link]
+               (external_id, dw_delivery_id, user_id, status_id, agreement_id)
+        VALUES(@external_id, @delivery_id, @user_id, 2, @agreement_id)
+
+
+        RAISERROR('User [%s] does not have VIEW permission on agreement [%I64d]', 11, 1, @username, @agreement_id)
+        RETURN 2
+    END
+
+    --+ TODO: ERROR-HANDLING
+
+    --| Get MAX delivery id as of @date(within MAX_AGE_DAYS) if no specific delivery id
+    --| is provided
+    IF COALESCE(@delivery_id, 0) = 0
+        SET @delivery_id = meta.get_data_delivery_id(@agreement_id, @date)
+
+    EXEC meta.debug @@PROCID, @agreement_id
+    EXEC meta.debug @@PROCID, @delivery_id
+    
+    --+ Get repo table name
+    SELECT @table_schema = table_schema,
+           @table_name   = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+
+    -- + TODO: ERROR-HANDLING
+
+    --+ Get the columns for the repo table
+    DECLARE rec CURSOR FOR
+    SELECT column_name,
+           data_type,
+           character_maximum_length,
+           numeric_precision,
+           numeric_scale
+      FROM meta.column_mapping_v
+     WHERE table_schema = @table_schema
+       AND table_name = @table_name
+     ORDER BY ordinal_position
+
+    --+ Open cursor
+    OPEN rec
+
+    --+ Prepare (daft MS SQL) variables
+    DECLARE @column_name NVARCHAR(128)
+    DECLARE @data_type                 NVARCHAR(128)
+    DECLARE @character_maximum_length  INT
+    DECLARE @numeric_precision TINYINT
+    DECLARE @numeric_scale             INT
+    DECLARE @col NVARCHAR(500)
+
+    SET @sql = CAST('SELECT ' AS NVARCHAR(MAX))
+
+    -- + Prepare(daft MS SQL) loop
+    FETCH NEXT FROM rec
+    INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+    --| Loop over columns in repo table for delivery
+    EXEC meta.debug @@PROCID, 'Loop over list of columns'
+    WHILE @@FETCH_STATUS = 0
+    BEGIN
+        --| MAP: 
+        SET @col =
+            CASE
+                --| NVARCHAR(MAX) => NVARCHAR(4000) due to GUI/AAC cannot handle MAX
+                WHEN @data_type = 'nvarchar' AND @character_maximum_length = -1 THEN 'CAST(' + @column_name + ' AS NVARCHAR(MAX)) ' + @column_name
+                --| <other types> => - No mapping -
+                ELSE @column_name
+            END
+
+        --+ Repeat(daft MS SQL) fetch
+       FETCH NEXT FROM rec
+       INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+       EXEC meta.debug @@PROCID, @col
+       SET @sql = @sql + CAST(@col AS NVARCHAR(MAX))
+        IF @@FETCH_STATUS = 0 SET @sql = @sql + CAST(',' AS NVARCHAR(MAX))
+    END
+
+    EXEC meta.debug @@PROCID, 'Close and deallocate cursor'
+    CLOSE rec
+    DEALLOCATE rec
+
+    
+    --| Insert the link
+    INSERT INTO meta.[link]
+           (external_id, dw_delivery_id, user_id, status_id, agreement_id)
+    VALUES (@external_id, @delivery_id, @user_id, 1, @agreement_id)
+
+    --| Finish and execute SQL statement outputting delivery table (or DELTA state)
+    SET @delta = meta.get_delta_sql(@agreement_id, @delivery_id)
+    IF @delta IS NOT NULL
+        SET @sql = @sql + CAST(' FROM ' AS NVARCHAR(MAX)) + @delta
+                        + CAST(' ORDER BY dw_delivery_id ASC, dw_row_id ASC' AS NVARCHAR(MAX))
+    ELSE
+        SET @sql = @sql + CAST(' FROM [' + @table_schema + '].[' + @table_name + '] '
+                        + 'WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR) AS NVARCHAR(MAX))
+                        + CAST(' ORDER BY dw_row_id ASC' AS NVARCHAR(MAX))
+
+
+    EXEC meta.debug @@PROCID, 'Execute query to return proper dataset'
+    EXEC meta.debug @@PROCID, @sql
+    EXEC sp_executesql @sql
+END
+--| ==========================================================================================
+;
+
+
+-- +migrate Down
+ALTER
+PROCEDURE[meta].[get_data] --|
+--| ==========================================================================================
+--| Description: Return the dataset related to the delivery agreement matching the date/time,
+--|              i.e.delivered as the latest delivery BEFORE the @delivery_date provided.
+--|              Defaults to current date/time.If @delivery_id is provided, any date/time
+--|              constraints are overridden.For DELTA agreements the current state as of
+--|              the delivery is returned (see meta.get_delta_sql)
+--| Arguments:
+(
+    @username NVARCHAR(250), --| Username of requestor
+@name                  NVARCHAR(250), --| Name of agreement
+@external_id           BIGINT,        --| ID of external data item(e.g. in AAC)
+    @delivery_date NVARCHAR(25),  --| Date string (YYYY-MM-DD[HH24:MI:SS]) of latest
+                                          --| delivery up until
+    @delivery_id           BIGINT         --| ID of delivery - blank/NULL => latest based on 
+                                          --| @delivery_date
+)
+AS 
+SET NOCOUNT ON
+SET ANSI_WARNINGS OFF
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @table_schema NVARCHAR(50)
+    DECLARE @table_name   NVARCHAR(100)
+    DECLARE @agreement_id BIGINT
+    DECLARE @sql NVARCHAR(MAX)
+    DECLARE @date         DATETIME
+    DECLARE @user_id BIGINT
+    DECLARE @delta        NVARCHAR(MAX)
+
+    --| Setup the date for latest delivery
+    SET @date = COALESCE(CONVERT(DATETIME, @delivery_date, 120), GETDATE())
+
+    -- | Collect meta data
+    --+ Get the agreement_id based on name
+    SELECT @agreement_id = id
+      FROM meta.agreement
+     WHERE name = @name
+
+    -- | Check user permissions
+    SET @user_id = meta.user_access(@username, @agreement_id, 'VIEW')
+    IF COALESCE(@user_id, 0) = 0 
+    BEGIN
+        --+ Lookup the user(without VIEW permissions) in order to log retrieval attempt
+       SELECT @user_id = id
+         FROM meta.[user]
+        WHERE username = @username
+
+        --+ Insert the failed link in status error
+        INSERT INTO meta.[link]
+               (external_id, dw_delivery_id, user_id, status_id, agreement_id)
+        VALUES(@external_id, @delivery_id, @user_id, 2, @agreement_id)
+
+
+        RAISERROR('User [%s] does not have VIEW permission on agreement [%I64d]', 11, 1, @username, @agreement_id)
+        RETURN 2
+    END
+
+    --+ TODO: ERROR-HANDLING
+
+    --| Get the MAX_AGE_DAYS attribute for limiting the retrieval back in time
+    DECLARE @max_age_days INT
+    DECLARE @max_age_days_c NVARCHAR(50)
+    SELECT @max_age_days_c = value
+      FROM meta.agreement_attribute_v
+     WHERE agreement_id = @agreement_id
+       AND attribute_name = 'MAX_AGE_DAYS'
+
+    -- + Set default 7
+    SET @max_age_days = 7
+    -- + Override with actual value if valid numeric
+    IF meta.check_numeric(@max_age_days_c, 12, 0) = 0 SET @max_age_days = CAST(@max_age_days_c AS INT)
+    EXEC meta.debug @@PROCID, @max_age_days
+    
+    --| Get MAX delivery id with[@date - @max_age_days <= createdtm <= @date] if no specific 
+    --| delivery id is provided
+    --+ NOTE: The state of DELTA agreements does not age, so any earlier delivery will do
+    SET @delta = meta.get_delta_sql(@agreement_id, NULL)
+    IF COALESCE(@delivery_id, 0) = 0
+        SELECT @delivery_id = MAX(id)
+          FROM meta.delivery
+         WHERE agreement_id = @agreement_id
+           AND (status_date BETWEEN @date - @max_age_days AND @date
+                OR (status_date <= @date AND @delta IS NOT NULL))
+
+    EXEC meta.debug @@PROCID, @agreement_id
+    EXEC meta.debug @@PROCID, @delivery_id
+    
+    --+ Get repo table name
+    SELECT @table_schema = table_schema,
+           @table_name   = table_name
+      FROM meta.agreement_stage_table_v
+     WHERE agreement_id = @agreement_id
+       AND table_schema = 'repo'
+
+    -- + TODO: ERROR-HANDLING
+
+    --+ Get the columns for the repo table
+    DECLARE rec CURSOR FOR
+    SELECT column_name,
+           data_type,
+           character_maximum_length,
+           numeric_precision,
+           numeric_scale
+      FROM meta.column_mapping_v
+     WHERE table_schema = @table_schema
+       AND table_name = @table_name
+     ORDER BY ordinal_position
+
+    --+ Open cursor
+    OPEN rec
+
+    --+ Prepare (daft MS SQL) variables
+    DECLARE @column_name NVARCHAR(128)
+    DECLARE @data_type                 NVARCHAR(128)
+    DECLARE @character_maximum_length  INT
+    DECLARE @numeric_precision TINYINT
+    DECLARE @numeric_scale             INT
+    DECLARE @col NVARCHAR(500)
+
+    SET @sql = CAST('SELECT ' AS NVARCHAR(MAX))
+
+    -- + Prepare(daft MS SQL) loop
+    FETCH NEXT FROM rec
+    INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+    --| Loop over columns in repo table for delivery
+    EXEC meta.debug @@PROCID, 'Loop over list of columns'
+    WHILE @@FETCH_STATUS = 0
+    BEGIN
+        --| MAP: 
+        SET @col =
+            CASE
+                --| NVARCHAR(MAX) => NVARCHAR(4000) due to GUI/AAC cannot handle MAX
+                WHEN @data_type = 'nvarchar' AND @character_maximum_length = -1 THEN 'CAST(' + @column_name + ' AS NVARCHAR(MAX)) ' + @column_name
+                --| <other types> => - No mapping -
+                ELSE @column_name
+            END
+
+        --+ Repeat(daft MS SQL) fetch
+       FETCH NEXT FROM rec
+       INTO @column_name, @data_type, @character_maximum_length, @numeric_precision, @numeric_scale
+
+       EXEC meta.debug @@PROCID, @col
+       SET @sql = @sql + CAST(@col AS NVARCHAR(MAX))
+        IF @@FETCH_STATUS = 0 SET @sql = @sql + CAST(',' AS NVARCHAR(MAX))
+    END
+
+    EXEC meta.debug @@PROCID, 'Close and deallocate cursor'
+    CLOSE rec
+    DEALLOCATE rec
+
+    
+    --| Insert the link
+    INSERT INTO meta.[link]
+           (external_id, dw_delivery_id, user_id, status_id, agreement_id)
+    VALUES (@external_id, @delivery_id, @user_id, 1, @agreement_id)
+
+    --| Finish and execute SQL statement outputting delivery table (or DELTA state)
+    SET @delta = meta.get_delta_sql(@agreement_id, @delivery_id)
+    IF @delta IS NOT NULL
+        SET @sql = @sql + CAST(' FROM ' AS NVARCHAR(MAX)) + @delta
+                        + CAST(' ORDER BY dw_delivery_id ASC, dw_row_id ASC' AS NVARCHAR(MAX))
+    ELSE
+        SET @sql = @sql + CAST(' FROM [' + @table_schema + '].[' + @table_name + '] '
+                        + 'WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR) AS NVARCHAR(MAX))
+                        + CAST(' ORDER BY dw_row_id ASC' AS NVARCHAR(MAX))
+
+
+    EXEC meta.debug @@PROCID, 'Execute query to return proper dataset'
+    EXEC meta.debug @@PROCID, @sql
+    EXEC sp_executesql @sql
+END
+--| ==========================================================================================
+;
+
+DROP FUNCTION[meta].[get_data_delivery_id]
+;
+DROP FUNCTION[meta].[get_max_age_days]
+;
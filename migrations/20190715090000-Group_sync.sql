@@ -0,0 +1,77 @@
+-- +migrate Up
+CREATE
+PROCEDURE[meta].[user_group_sync] --|
+--| ==========================================================================================
+--| Description: Synchronize the group memberships of a user with an identity provider. The
+--|              user is made member of the @groups (missing groups are created) and removed
+--|              from the other @managed groups. Memberships of groups not managed by the
+--|              identity provider are left as they are.
+--| Arguments:
+(
+    @username NVARCHAR(50),   --| Username
+    @groups   NVARCHAR(MAX),  --| Groups (comma separated) the user is member of
+    @managed  NVARCHAR(MAX)   --| Groups (comma separated) managed by the identity provider
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @user_id BIGINT
+    DECLARE @member TABLE (name NVARCHAR(50))
+
+    SELECT @user_id = id
+      FROM meta.[user]
+     WHERE username = @username
+
+    IF @user_id IS NULL
+    BEGIN
+        EXEC meta.debug @@PROCID, 'Unknown user - nothing to synchronize'
+        RETURN
+    END
+
+    --| Only managed groups are synchronized
+    INSERT INTO @member (name)
+    SELECT DISTINCT LTRIM(RTRIM(g.value))
+      FROM string_split(@groups, ',') g
+     WHERE LTRIM(RTRIM(g.value)) IN (SELECT LTRIM(RTRIM(value)) FROM string_split(@managed, ','))
+
+    --| Create missing groups
+    EXEC meta.debug @@PROCID, 'Create missing groups'
+    INSERT INTO meta.[group]
+           (name, description)
+    SELECT m.name, 'Managed by identity provider'
+      FROM @member m
+     WHERE NOT EXISTS (SELECT 1 FROM meta.[group] g WHERE g.name = m.name)
+
+    --| Add memberships
+    EXEC meta.debug @@PROCID, 'Add memberships'
+    INSERT INTO meta.user_group
+           (user_id, group_id)
+    SELECT @user_id, g.id
+      FROM meta.[group] g
+     WHERE g.name IN (SELECT name FROM @member)
+       AND NOT EXISTS (SELECT 1
+                         FROM meta.user_group ug
+                        WHERE ug.user_id  = @user_id
+                          AND ug.group_id = g.id)
+
+    --| Remove memberships of the other managed groups
+    EXEC meta.debug @@PROCID, 'Remove memberships'
+    DELETE ug
+      FROM meta.user_group ug
+           JOIN
+           meta.[group] g
+           ON (g.id = ug.group_id)
+     WHERE ug.user_id = @user_id
+       AND g.name IN (SELECT LTRIM(RTRIM(value)) FROM string_split(@managed, ','))
+       AND g.name NOT IN (SELECT name FROM @member)
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    --| Return success
+    RETURN
+END
+--| ==========================================================================================
+;
+
+-- +migrate Down
+DROP PROCEDURE [meta].[user_group_sync]
+;
@@ -0,0 +1,413 @@
+-- +migrate Up
+CREATE TABLE[meta].[service_account]
+(
+    [user_id] [bigint] NOT NULL,
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_service_account_createdtm] DEFAULT(getdate()),
+ CONSTRAINT[PK_service_account] PRIMARY KEY CLUSTERED
+(
+   [user_id] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[service_account] WITH CHECK ADD CONSTRAINT[FK_service_account_user] FOREIGN KEY([user_id])
+REFERENCES[meta].[user]
+        ([id])
+ON DELETE CASCADE
+;
+CREATE TABLE[meta].[service_account_scope]
+(
+    [user_id] [bigint] NOT NULL,
+
+    [agreement_id] [bigint] NULL,
+
+    [access_id] [bigint] NOT NULL,
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_service_account_scope_createdtm] DEFAULT(getdate())
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[service_account_scope] WITH CHECK ADD CONSTRAINT[FK_service_account_scope_service_account] FOREIGN KEY([user_id])
+REFERENCES[meta].[service_account]
+        ([user_id])
+ON DELETE CASCADE
+;
+CREATE TABLE[meta].[api_key]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [user_id] [bigint] NOT NULL,
+
+    [prefix] [nvarchar] (20) NOT NULL,
+
+    [key_hash] [nvarchar] (64) NOT NULL,
+
+    [expiredtm] [datetime] NOT NULL,
+
+    [lastuseddtm] [datetime] NULL,
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_api_key_createdtm] DEFAULT(getdate()),
+ CONSTRAINT[PK_api_key] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+ALTER TABLE[meta].[api_key] WITH CHECK ADD CONSTRAINT[FK_api_key_service_account] FOREIGN KEY([user_id])
+REFERENCES[meta].[service_account]
+        ([user_id])
+ON DELETE CASCADE
+;
+CREATE UNIQUE INDEX ux_api_key_prefix ON meta.api_key (prefix)
+;
+CREATE
+VIEW[meta].[api_key_v] --|
+--| ==========================================================================================
+--| Description: API keys of service accounts (without the hash of the key)
+--| ==========================================================================================
+AS
+SELECT k.id,
+       k.user_id,
+       u.username,
+       k.prefix,
+       k.expiredtm,
+       CASE WHEN k.expiredtm > GETDATE() THEN 1 ELSE 0 END AS active,
+       k.lastuseddtm,
+       k.createdtm
+  FROM meta.api_key k,
+       meta.[user] u
+ WHERE u.id = k.user_id
+;
+CREATE
+VIEW[meta].[service_account_v] --|
+--| ==========================================================================================
+--| Description: Service accounts with their number of active keys
+--| ==========================================================================================
+AS
+SELECT u.id,
+       u.username,
+       u.realname,
+       u.description,
+       s.createdtm,
+       (SELECT COUNT(*) FROM meta.api_key k WHERE k.user_id = u.id AND k.expiredtm > GETDATE()) AS active_keys,
+       (SELECT MAX(k.lastuseddtm) FROM meta.api_key k WHERE k.user_id = u.id) AS lastuseddtm
+  FROM meta.service_account s,
+       meta.[user] u
+ WHERE u.id = s.user_id
+;
+CREATE
+VIEW[meta].[service_account_scope_v] --|
+--| ==========================================================================================
+--| Description: Scopes of service accounts - agreement NULL means all agreements
+--| ==========================================================================================
+AS
+SELECT s.user_id,
+       u.username,
+       s.agreement_id,
+       a.name AS agreementname,
+       s.access_id,
+       c.name AS accessname,
+       s.createdtm
+  FROM meta.service_account_scope s
+       JOIN
+       meta.[user] u
+       ON (u.id = s.user_id)
+       JOIN
+       meta.[access] c
+       ON (c.id = s.access_id)
+       LEFT OUTER JOIN
+       meta.agreement a
+       ON (a.id = s.agreement_id)
+;
+CREATE
+PROCEDURE[meta].[service_account_add] --|
+--| ==========================================================================================
+--| Description: Create (or update) a user as a service account. Service accounts get their
+--|              access via group memberships like other users but are limited to their
+--|              scopes (see service_account_scope_add) and authenticate by API keys.
+--| Arguments:
+(
+    @username    NVARCHAR(50),   --| Username
+    @realname    NVARCHAR(100),  --| Realname
+    @description NVARCHAR(1000), --| Description
+    @user_id     BIGINT OUTPUT   --| Returned ID of user
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    EXEC meta.user_add @username, @realname, @description
+
+    SELECT @user_id = id
+      FROM meta.[user]
+     WHERE username = @username
+
+    IF NOT EXISTS (SELECT 1 FROM meta.service_account WHERE user_id = @user_id)
+    BEGIN
+        INSERT INTO meta.service_account
+               (user_id)
+        VALUES (@user_id)
+        EXEC meta.debug @@PROCID, 'Service account inserted'
+    END
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[service_account_scope_add] --|
+--| ==========================================================================================
+--| Description: Allow an access to an agreement (or all agreements) to a service account -
+--|              checking for doublets
+--| Arguments:
+(
+    @user_id      BIGINT,        --| ID of the service account (user)
+    @agreement_id BIGINT,        --| ID of the agreement - NULL => all
+    @accessname   NVARCHAR(50)   --| Access (UPLOAD, VIEW, APPROVE or DELETE)
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @access_id BIGINT
+
+    SELECT @access_id = id
+      FROM meta.access
+     WHERE name = @accessname
+
+    IF @access_id IS NULL
+    BEGIN
+        RAISERROR ('Access [%s] does not exist', 11, 1, @accessname)
+        RETURN 2
+    END
+    IF NOT EXISTS (SELECT 1 FROM meta.service_account WHERE user_id = @user_id)
+    BEGIN
+        RAISERROR ('User [%I64d] is not a service account', 11, 1, @user_id)
+        RETURN 3
+    END
+
+    IF NOT EXISTS (SELECT 1
+                     FROM meta.service_account_scope
+                    WHERE user_id   = @user_id
+                      AND access_id = @access_id
+                      AND (agreement_id = @agreement_id OR (agreement_id IS NULL AND @agreement_id IS NULL)))
+    BEGIN
+        INSERT INTO meta.service_account_scope
+               (user_id, agreement_id, access_id)
+        VALUES (@user_id, @agreement_id, @access_id)
+        EXEC meta.debug @@PROCID, 'Scope inserted'
+    END
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[service_account_scope_delete] --|
+--| ==========================================================================================
+--| Description: Remove an access to an agreement (or all agreements) from a service account
+--| Arguments:
+(
+    @user_id      BIGINT,        --| ID of the service account (user)
+    @agreement_id BIGINT,        --| ID of the agreement - NULL => all
+    @accessname   NVARCHAR(50)   --| Access (UPLOAD, VIEW, APPROVE or DELETE)
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DELETE s
+      FROM meta.service_account_scope s
+           JOIN
+           meta.access c
+           ON (c.id = s.access_id)
+     WHERE s.user_id = @user_id
+       AND c.name    = @accessname
+       AND (s.agreement_id = @agreement_id OR (s.agreement_id IS NULL AND @agreement_id IS NULL))
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[api_key_add] --|
+--| ==========================================================================================
+--| Description: Add an API key to a service account. Only the hash of the key is kept - the
+--|              key itself is shown once to the caller.
+--| Arguments:
+(
+    @user_id    BIGINT,        --| ID of the service account (user)
+    @prefix     NVARCHAR(20),  --| Public prefix identifying the key
+    @key_hash   NVARCHAR(64),  --| SHA-256 (hex) of the key
+    @expiredtm  DATETIME,      --| Time the key expires
+    @api_key_id BIGINT OUTPUT  --| Returned ID of key
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM meta.service_account WHERE user_id = @user_id)
+    BEGIN
+        RAISERROR ('User [%I64d] is not a service account', 11, 1, @user_id)
+        RETURN 2
+    END
+
+    INSERT INTO meta.api_key
+           (user_id, prefix, key_hash, expiredtm)
+    VALUES (@user_id, @prefix, LOWER(@key_hash), @expiredtm)
+    SET @api_key_id = IDENT_CURRENT('meta.api_key')
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[api_key_expire] --|
+--| ==========================================================================================
+--| Description: Expire an API key (revoke) - now or at a later time (rotation grace period).
+--|              Keys already expiring earlier are left as they are.
+--| Arguments:
+(
+    @api_key_id BIGINT,   --| ID of key
+    @expiredtm  DATETIME  --| Time the key expires - NULL => now
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    UPDATE meta.api_key
+       SET expiredtm = COALESCE(@expiredtm, GETDATE())
+     WHERE id = @api_key_id
+       AND expiredtm > COALESCE(@expiredtm, GETDATE())
+
+    EXEC meta.debug @@PROCID, 'DONE'
+    RETURN
+END
+--| ==========================================================================================
+;
+CREATE
+PROCEDURE[meta].[api_key_use] --|
+--| ==========================================================================================
+--| Description: Authenticate by an API key returning the username of its service account
+--|              (no rows if the key is unknown or expired). The use is recorded in
+--|              meta.usage_log.
+--| Arguments:
+(
+    @prefix   NVARCHAR(20),   --| Public prefix of the key
+    @key_hash NVARCHAR(64),   --| SHA-256 (hex) of the key
+    @path     NVARCHAR(250),  --| Path requested
+    @query    NVARCHAR(1000)  --| Parameters
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @api_key_id BIGINT
+    DECLARE @username   NVARCHAR(50)
+
+    SELECT @api_key_id = k.id,
+           @username   = u.username
+      FROM meta.api_key k,
+           meta.[user] u
+     WHERE u.id        = k.user_id
+       AND k.prefix    = @prefix
+       AND k.key_hash  = LOWER(@key_hash)
+       AND k.expiredtm > GETDATE()
+
+    IF @api_key_id IS NULL
+    BEGIN
+        EXEC meta.debug @@PROCID, 'Unknown or expired key'
+        RETURN
+    END
+
+    UPDATE meta.api_key
+       SET lastuseddtm = GETDATE()
+     WHERE id = @api_key_id
+    EXEC meta.log @username, @path, @query
+
+    SELECT @api_key_id AS api_key_id,
+           @username   AS username
+END
+--| ==========================================================================================
+;
+ALTER FUNCTION [meta].[user_access] --|
+--| ==========================================================================================
+--| Description: Check if a user has specific access to an agreement - service accounts
+--|              only within their scopes
+--|              0    => not access to agreement
+--|              <> 0 => userid is returned as 'true' value (BIGINT - user ids exceed 255)
+--| Arguments:
+(
+    @username      NVARCHAR(50), --| Username to check
+    @agreement_id  BIGINT, --| Agreement to check access against
+    @accessname    NVARCHAR(50)-- | Access to check
+)
+RETURNS BIGINT
+AS
+BEGIN
+--| ------------------------------------------------------------------------------------------
+    --| Return value of lookup in meta data
+    RETURN COALESCE((
+    SELECT TOP 1 v.user_id
+      FROM meta.user_access_v v
+     WHERE UPPER(v.username) = UPPER(@username)
+       AND v.agreement_id = @agreement_id
+       AND v.accessname = @accessname
+       --+ Service accounts are limited to their scopes
+       AND (NOT EXISTS (SELECT 1 FROM meta.service_account s WHERE s.user_id = v.user_id)
+            OR EXISTS (SELECT 1
+                         FROM meta.service_account_scope c
+                        WHERE c.user_id   = v.user_id
+                          AND c.access_id = v.access_id
+                          AND (c.agreement_id IS NULL OR c.agreement_id = v.agreement_id)))), 0)
+--| ==========================================================================================
+END
+;
+
+-- +migrate Down
+ALTER FUNCTION [meta].[user_access] --|
+--| ==========================================================================================
+--| Description: Check if a user has specific access to an agreement
+--|              0    => not access to agreement
+--|              <> 0 => userid is returned as 'true' value
+--| Arguments:
+(
+    @username      NVARCHAR(50), --| Username to check
+    @agreement_id  BIGINT, --| Agreement to check access against
+    @accessname    NVARCHAR(50)-- | Access to check
+)
+RETURNS TINYINT
+AS
+BEGIN
+--| ------------------------------------------------------------------------------------------
+    --| Return value of lookup in meta data
+    RETURN COALESCE((
+    SELECT TOP 1 user_id
+      FROM meta.user_access_v
+     WHERE UPPER(username) = UPPER(@username)
+       AND agreement_id = @agreement_id
+       AND accessname = @accessname), 0)
+--| ==========================================================================================
+END
+;
+DROP PROCEDURE [meta].[api_key_use]
+;
+DROP PROCEDURE [meta].[api_key_expire]
+;
+DROP PROCEDURE [meta].[api_key_add]
+;
+DROP PROCEDURE [meta].[service_account_scope_delete]
+;
+DROP PROCEDURE [meta].[service_account_scope_add]
+;
+DROP PROCEDURE [meta].[service_account_add]
+;
+DROP VIEW [meta].[service_account_scope_v]
+;
+DROP VIEW [meta].[service_account_v]
+;
+DROP VIEW [meta].[api_key_v]
+;
+DROP TABLE [meta].[api_key]
+;
+DROP TABLE [meta].[service_account_scope]
+;
+DROP TABLE [meta].[service_account]
+;
@@ -0,0 +1,363 @@
+-- +migrate Up
+CREATE TABLE[meta].[api_audit]
+(
+    [id] [bigint] IDENTITY(1,1) NOT NULL,
+
+    [createdtm] [datetime] NOT NULL CONSTRAINT[DF_api_audit_createdtm] DEFAULT(getdate()),
+
+    [username] [nvarchar] (50) NULL,
+
+    [api_key_id] [bigint] NULL,
+
+    [ip] [nvarchar] (50) NULL,
+
+    [method] [nvarchar] (10) NOT NULL,
+
+    [endpoint] [nvarchar] (250) NULL,
+
+    [path] [nvarchar] (1000) NOT NULL,
+
+    [params] [nvarchar] (max) NULL,
+
+    [status] [int] NOT NULL,
+
+    [outcome] [nvarchar] (20) NOT NULL,
+
+    [duration_ms] [bigint] NOT NULL,
+ CONSTRAINT[PK_api_audit] PRIMARY KEY CLUSTERED
+(
+   [id] ASC
+)
+) ON[PRIMARY]
+;
+CREATE INDEX ix_api_audit_createdtm ON meta.api_audit (createdtm)
+;
+CREATE INDEX ix_api_audit_username ON meta.api_audit (username, createdtm)
+;
+CREATE
+TRIGGER[meta].[api_audit_append_only] --|
+--| ==========================================================================================
+--| Description: The audit trail is append-only - entries can be neither changed nor deleted
+--| ==========================================================================================
+ON [meta].[api_audit]
+INSTEAD OF UPDATE, DELETE
+AS
+BEGIN
+    RAISERROR ('The audit trail (meta.api_audit) is append-only', 16, 1)
+    ROLLBACK TRANSACTION
+END
+;
+CREATE
+PROCEDURE[meta].[api_audit_add] --|
+--| ==========================================================================================
+--| Description: Append an API call (mutating or sensitive read) to the audit trail
+--| Arguments:
+(
+    @username    NVARCHAR(50),   --| Authenticated user - NULL if not authenticated
+    @api_key_id  BIGINT,         --| API key used - NULL if none
+    @ip          NVARCHAR(50),   --| Source IP
+    @method      NVARCHAR(10),   --| HTTP method
+    @endpoint    NVARCHAR(250),  --| Route (e.g. /api/delivery/delete/{delivery_id:int64})
+    @path        NVARCHAR(1000), --| Path requested
+    @params      NVARCHAR(MAX),  --| Parameters (query and body) as JSON
+    @status      INT,            --| HTTP status of the response
+    @outcome     NVARCHAR(20),   --| success, denied or failed
+    @duration_ms BIGINT          --| Duration of the call in milliseconds
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    INSERT INTO meta.api_audit
+           (username, api_key_id, ip, method, endpoint, path, params, status, outcome, duration_ms)
+    VALUES (@username, @api_key_id, @ip, @method, @endpoint, @path, @params, @status, @outcome, @duration_ms)
+END
+--| ==========================================================================================
+;
+
+ALTER
+PROCEDURE[meta].[delivery_delete]-- |
+--| ==========================================================================================
+--| Description: Delete a delivery from the meta data
+--| This is a very destructive procedure and should be called by authorities only
+--| The operations of the delivery are deleted with it, so the deletion (and the user
+--| deleting) is kept in meta.usage_log
+--| Arguments:             
+(
+    @delivery_id   BIGINT,               -- | ID of delivery to delete / flush from database
+    @username      NVARCHAR(50) = NULL   -- | Username of the user deleting
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg   NVARCHAR(1000)
+    DECLARE @query NVARCHAR(1000)
+
+    --| Check the delivery_id (and describe the delivery for meta.usage_log)
+    EXEC meta.debug @@PROCID, 'Check delivery ID'
+    SELECT @query = (SELECT d.id AS delivery_id, d.name AS delivery_name, d.agreement_id, a.name AS agreement_name
+                       FROM meta.delivery d
+                            LEFT OUTER JOIN
+                            meta.agreement a ON (a.id = d.agreement_id)
+                      WHERE d.id = @delivery_id
+                        FOR JSON PATH, WITHOUT_ARRAY_WRAPPER)
+
+    IF @query IS NULL
+    BEGIN
+        RAISERROR ('Delivery ID [%I64d] does not exist', 11, 1, @delivery_id)
+        RETURN 2
+    END
+     
+    --| BEGIN controlled transaction
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        --| Keep who deleted what
+        EXEC meta.log @username, 'meta.delivery_delete', @query
+
+        --| Delete operations
+        EXEC meta.debug @@PROCID, 'Delete operations'
+        DELETE FROM meta.operation
+         WHERE audit_id IN (SELECT id
+                              FROM meta.audit
+                             WHERE delivery_id = @delivery_id)
+
+        --+ Prepare delete audit trail
+        DECLARE @audit_id    BIGINT
+        DECLARE @stage_id BIGINT
+        DECLARE @table_id    BIGINT
+        DECLARE @count INT
+        DECLARE @type        NVARCHAR(15)
+        DECLARE @table_name  NVARCHAR(200)
+        DECLARE @sql         NVARCHAR(300)
+        DECLARE rec CURSOR FOR
+        SELECT u.id, u.stage_id, u.table_id, '[' + t.[schema] + '].[' + t.[name] + ']' AS table_name
+          FROM meta.audit u,
+               meta.[table] t
+         WHERE u.delivery_id = @delivery_id
+           AND u.table_id    = t.id
+         ORDER BY u.stage_id DESC
+
+        --+ Open cursor
+        OPEN rec
+
+        --+ Prepare (daft MS SQL) loop
+        FETCH NEXT FROM rec INTO @audit_id, @stage_id, @table_id, @table_name
+
+        --| Loop over audit and delete tables/views and meta data one by one
+        EXEC meta.debug @@PROCID, 'Loop over audit'
+        WHILE @@FETCH_STATUS = 0
+        BEGIN
+            --+ Delete delivery from repo
+            IF @stage_id = 3
+            BEGIN
+                SET @sql = 'DELETE FROM ' + @table_name + ' WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+                EXEC sp_executesql @sql
+            END
+
+            --+ Check if any other audit trail on the table exists
+            SELECT @count = COUNT(*)
+              FROM meta.audit
+             WHERE table_id = @table_id
+               AND id <> @audit_id
+
+            DELETE FROM meta.audit
+             WHERE id = @audit_id
+
+            -- + If count is 0 
+            IF @count = 0
+            BEGIN
+                --+ Drop table
+                IF OBJECT_ID(@table_name) IS NOT NULL
+                BEGIN
+                    --+ Determine if view or table
+                    SELECT @type = TABLE_TYPE
+                      FROM INFORMATION_SCHEMA.TABLES
+                     WHERE UPPER(@table_name) = UPPER('[' + TABLE_SCHEMA + '].[' + TABLE_NAME + ']')
+
+
+                    SET @sql = 'DROP ' + CASE @type WHEN 'VIEW' THEN 'VIEW' ELSE 'TABLE' END + ' ' + @table_name
+                    EXEC meta.debug @@PROCID, @sql
+                    EXEC sp_executesql @sql
+                END
+                
+                --+ Delete table meta data
+                DELETE FROM meta.[table]
+                 WHERE id = @table_id
+            END
+                                            
+            --+ Repeat (daft MS SQL) loop
+            FETCH NEXT FROM rec INTO @audit_id, @stage_id, @table_id, @table_name
+        END
+        CLOSE rec
+        DEALLOCATE rec
+
+        --| Delete the delivery entry
+        EXEC meta.debug @@PROCID, 'Delete delivery'
+        DELETE FROM meta.delivery
+         WHERE id = @delivery_id
+
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        --| Rollback transaction
+        ROLLBACK TRANSACTION
+        SET @msg = ERROR_MESSAGE()
+        EXEC meta.debug @@PROCID, 'Deleting delivery failed'
+        RAISERROR ('Deleting delivery [%I64d] failed: %s', 11, 1, @delivery_id, @msg)
+        --| Return error code
+        RETURN 10
+    END CATCH
+
+    --| SUCCESS handling
+    EXEC meta.debug @@PROCID, 'DONE'
+        --| COMMIT controlled transaction
+    COMMIT TRANSACTION
+        --| Return success
+    RETURN
+    --| END
+END
+--| ==========================================================================================
+
+;
+
+-- +migrate Down
+ALTER
+PROCEDURE[meta].[delivery_delete]-- |
+--| ==========================================================================================
+--| Description: Delete a delivery from the meta data
+--| This is a very destructive procedure and should be called by authorities only
+--| Arguments:             
+(
+    @delivery_id   BIGINT-- | ID of delivery to delete / flush from database
+)
+AS
+--| ------------------------------------------------------------------------------------------
+BEGIN
+    DECLARE @msg NVARCHAR(1000)
+
+    --| Ckeck the agreement_id
+    EXEC meta.debug @@PROCID, 'Check delivery ID'
+    SELECT @delivery_id = id
+      FROM meta.agreement
+     WHERE @delivery_id = id
+
+    IF @delivery_id IS NULL
+    BEGIN
+        RAISERROR ('Delivery ID [%I64d] does not exist', 11, 1, @delivery_id)
+        RETURN 2
+    END
+     
+    --| BEGIN controlled transaction
+    BEGIN TRANSACTION
+
+    BEGIN TRY
+        --| Delete operations
+        EXEC meta.debug @@PROCID, 'Delete operations'
+        DELETE FROM meta.operation
+         WHERE audit_id IN (SELECT id
+                              FROM meta.audit
+                             WHERE delivery_id = @delivery_id)
+
+        --+ Prepare delete audit trail
+        DECLARE @audit_id    BIGINT
+        DECLARE @stage_id BIGINT
+        DECLARE @table_id    BIGINT
+        DECLARE @count INT
+        DECLARE @type        NVARCHAR(15)
+        DECLARE @table_name  NVARCHAR(200)
+        DECLARE @sql         NVARCHAR(300)
+        DECLARE rec CURSOR FOR
+        SELECT u.id, u.stage_id, u.table_id, '[' + t.[schema] + '].[' + t.[name] + ']' AS table_name
+          FROM meta.audit u,
+               meta.[table] t
+         WHERE u.delivery_id = @delivery_id
+           AND u.table_id    = t.id
+         ORDER BY u.stage_id DESC
+
+        --+ Open cursor
+        OPEN rec
+
+        --+ Prepare (daft MS SQL) loop
+        FETCH NEXT FROM rec INTO @audit_id, @stage_id, @table_id, @table_name
+
+        --| Loop over audit and delete tables/views and meta data one by one
+        EXEC meta.debug @@PROCID, 'Loop over audit'
+        WHILE @@FETCH_STATUS = 0
+        BEGIN
+            --+ Delete delivery from repo
+            IF @stage_id = 3
+            BEGIN
+                SET @sql = 'DELETE FROM ' + @table_name + ' WHERE dw_delivery_id = ' + CAST(@delivery_id AS NVARCHAR)
+                EXEC sp_executesql @sql
+            END
+
+            --+ Check if any other audit trail on the table exists
+            SELECT @count = COUNT(*)
+              FROM meta.audit
+             WHERE table_id = @table_id
+               AND id <> @audit_id
+
+            DELETE FROM meta.audit
+             WHERE id = @audit_id
+
+            -- + If count is 0 
+            IF @count = 0
+            BEGIN
+                --+ Drop table
+                IF OBJECT_ID(@table_name) IS NOT NULL
+                BEGIN
+                    --+ Determine if view or table
+                    SELECT @type = TABLE_TYPE
+                      FROM INFORMATION_SCHEMA.TABLES
+                     WHERE UPPER(@table_name) = UPPER('[' + TABLE_SCHEMA + '].[' + TABLE_NAME + ']')
+
+
+                    SET @sql = 'DROP ' + CASE @type WHEN 'VIEW' THEN 'VIEW' ELSE 'TABLE' END + ' ' + @table_name
+                    EXEC meta.debug @@PROCID, @sql
+                    EXEC sp_executesql @sql
+                END
+                
+                --+ Delete table meta data
+                DELETE FROM meta.[table]
+                 WHERE id = @table_id
+            END
+                                            
+            --+ Repeat (daft MS SQL) loop
+            FETCH NEXT FROM rec INTO @audit_id, @stage_id, @table_id, @table_name
+        END
+        CLOSE rec
+        DEALLOCATE rec
+
+        --| Delete the delivery entry
+        EXEC meta.debug @@PROCID, 'Delete delivery'
+        DELETE FROM meta.delivery
+         WHERE id = @delivery_id
+
+    END TRY
+    --| ERROR handling
+    BEGIN CATCH
+        --| Rollback transaction
+        ROLLBACK TRANSACTION
+        PRINT ERROR_MESSAGE()
+        EXEC meta.debug @@PROCID, 'Deleting delivery failed'
+        --| Return error code
+        RETURN 10
+    END CATCH
+
+    --| SUCCESS handling
+    EXEC meta.debug @@PROCID, 'DONE'
+        --| COMMIT controlled transaction
+    COMMIT TRANSACTION
+        --| Return success
+    RETURN
+    --| END
+END
+--| ==========================================================================================
+
+;
+DROP PROCEDURE [meta].[api_audit_add]
+;
+DROP TRIGGER [meta].[api_audit_append_only]
+;
+DROP TABLE [meta].[api_audit]
+;
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"flag"
@@ -19,18 +20,40 @@ var singleDb *sql.DB
 var migrate_flag = flag.Bool("migrate", false, "Flag specifying if migrations should be applied")
 var migrations migrate.MigrationSource
 
+// Querier runs queries - either directly on the database or within a transaction
+type Querier interface {
+	Query(ctx context.Context, query string, limit int, args ...interface{}) (results []interface{}, err error)
+	QueryStruct(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	Rows(ctx context.Context, query string, args ...interface{}) (Rows, error)
+}
+
 type Dber interface {
-	Query(query string, limit int, args ...interface{}) (results []interface{}, err error)
-	Begin() error
+	Querier
+	Begin(ctx context.Context) (Tx, error)
+}
+
+// Tx is a transaction - queries run on it are committed or rolled back together
+type Tx interface {
+	Querier
 	Commit() error
 	Rollback() error
 }
 
-// Db is a singleton holding connection
+// Db is a handle to the singleton connection pool - safe for concurrent use
 type Db struct {
-	db    *sql.DB
-	tx    *sql.Tx
-	retry int64
+	db *sql.DB
+}
+
+// dbTx is a transaction on the connection pool
+type dbTx struct {
+	tx *sql.Tx
+}
+
+// Retries on known issue with connections being reset (due to idle?)
+const retries = 2
+
+func reset(err error) bool {
+	return err != nil && strings.HasSuffix(err.Error(), "connection reset by peer")
 }
 
 // Enable different sources of migrations
@@ -94,59 +117,98 @@ func NewDb() *Db {
 		}
 		singleDb = db
 	})
-	return &Db{db: singleDb, retry: 0}
+	return &Db{db: singleDb}
 }
 
-func (db *Db) Commit() (err error) {
-	return db.tx.Commit()
-}
-
-func (db *Db) Begin() (err error) {
-	db.tx, err = db.db.Begin()
-	if err != nil {
-		// Known issue with connections being reset (due to idle?)
-		if strings.HasSuffix(err.Error(), "connection reset by peer") && db.retry < 2 {
-			db.retry += 1
-			return db.Begin()
+// Begin starts a transaction - it is rolled back if ctx is cancelled before Commit
+func (db *Db) Begin(ctx context.Context) (Tx, error) {
+	var tx *sql.Tx
+	var err error
+	for retry := 0; ; retry++ {
+		if tx, err = db.db.BeginTx(ctx, nil); !reset(err) || retry >= retries {
+			break
 		}
-		db.retry = 0
+	}
+	if err != nil {
 		log.Printf("Begin() failed: [%s]\n", err)
+		return nil, err
 	}
-	return err
+	return &dbTx{tx: tx}, nil
 }
 
-func (db *Db) Rollback() (err error) {
-	return db.tx.Rollback()
+func (tx *dbTx) Commit() error   { return tx.tx.Commit() }
+func (tx *dbTx) Rollback() error { return tx.tx.Rollback() }
+
+// queryer is the part of *sql.DB and *sql.Tx used for queries
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
 }
 
 // Generic Query function returning result set based on any command
-func (db *Db) Query(query string, limit int, args ...interface{}) (results []interface{}, err error) {
-	rows, err := db.db.Query(query, args...)
+func (db *Db) Query(ctx context.Context, query string, limit int, args ...interface{}) (results []interface{}, err error) {
+	return queryMaps(ctx, db.db, true, query, limit, args...)
+}
+
+// QueryStruct scans the result set into dest - a pointer to a slice of structs (see scanStruct)
+func (db *Db) QueryStruct(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return queryStruct(ctx, db.db, true, dest, query, args...)
+}
+
+// Rows fires off query returning an iterator over the result set
+func (db *Db) Rows(ctx context.Context, query string, args ...interface{}) (Rows, error) {
+	return newRows(ctx, db.db, true, query, args...)
+}
+
+// Query within the transaction
+func (tx *dbTx) Query(ctx context.Context, query string, limit int, args ...interface{}) (results []interface{}, err error) {
+	return queryMaps(ctx, tx.tx, false, query, limit, args...)
+}
+
+// QueryStruct within the transaction
+func (tx *dbTx) QueryStruct(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return queryStruct(ctx, tx.tx, false, dest, query, args...)
+}
+
+// Rows within the transaction
+func (tx *dbTx) Rows(ctx context.Context, query string, args ...interface{}) (Rows, error) {
+	return newRows(ctx, tx.tx, false, query, args...)
+}
+
+// queryRows fires off the query - retrying on connections being reset unless in a transaction
+func queryRows(ctx context.Context, q queryer, retry bool, query string, args ...interface{}) (*sql.Rows, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	for i := 0; retry && reset(err) && i < retries; i++ {
+		rows, err = q.QueryContext(ctx, query, args...)
+	}
 	if err != nil {
-		// Known issue with connections being reset (due to idle?)
-		if strings.HasSuffix(err.Error(), "connection reset by peer") && db.retry < 2 {
-			db.retry += 1
-			return db.Query(query, limit, args...)
-		}
-		db.retry = 0
 		log.Printf("Query [%s]([%s]) failed: [%s]\n", query, args, err)
 		return nil, err
 	}
+	return rows, nil
+}
+
+// queryMaps returns each row as a map of column name to value keeping the type of the
+// column: NULL is nil, integers are int64, dates are time.Time, exact numerics are Decimal
+// and text is string
+func queryMaps(ctx context.Context, q queryer, retry bool, query string, limit int, args ...interface{}) (results []interface{}, err error) {
+	rows, err := queryRows(ctx, q, retry, query, args...)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
 
-	db.retry = 0
 	cols, err := rows.Columns()
 	if err != nil {
 		log.Printf("Could not get columns from query [%s]: [%s]\n", query, err)
 		return nil, err
 	}
 
+	decimal := decimals(rows)
 	rowcount := 0
 	for rows.Next() {
-		// auto-deferring string->interface{} is disabled by design in Go, so need to copy values
-		r := make(map[string]interface{})      // Hash of key/values (to be populated)
-		t := make([]sql.NullString, len(cols)) // Target array of string
-		p := make([]interface{}, len(cols))    // Scan array (list of pointers to string)
+		r := make(map[string]interface{})   // Hash of key/values (to be populated)
+		t := make([]interface{}, len(cols)) // Target array of values
+		p := make([]interface{}, len(cols)) // Scan array (list of pointers to values)
 		for i := 0; i < len(cols); i++ {
 			p[i] = &t[i]
 		}
@@ -156,7 +218,7 @@ func (db *Db) Query(query string, limit int, args ...interface{}) (results []int
 			break
 		}
 		for i := 0; i < len(cols); i++ {
-			r[cols[i]] = t[i].String
+			r[cols[i]] = value(t[i], i < len(decimal) && decimal[i])
 		}
 		results = append(results, r)
 
@@ -167,9 +229,25 @@ func (db *Db) Query(query string, limit int, args ...interface{}) (results []int
 			}
 		}
 	}
+	if err == nil {
+		// Cancellation (timeout) while reading rows
+		err = rows.Err()
+	}
 	return results, err
 }
 
+func queryStruct(ctx context.Context, q queryer, retry bool, dest interface{}, query string, args ...interface{}) error {
+	rows, err := queryRows(ctx, q, retry, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if err = scanStruct(rows, dest); err != nil {
+		log.Printf("Scan error [%s]\n", err)
+	}
+	return err
+}
+
 // _create_database helper allow creating database if non-existing prior to migrating
 // It is absolutely last resort so panic in case of any errors
 func _create_database() {
@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default and max number of rows in a page of a list
+const (
+	ListLimit    = 100
+	ListMaxLimit = 1000
+)
+
+// ListSpec describes a list source and what may be sorted and filtered on. Column names are
+// only ever taken from the spec - never from the request - so they are safe to build SQL from.
+type ListSpec struct {
+	// Table, view or table valued function to list (may refer to caller arguments $1..$n)
+	From string
+	// Fixed predicate (e.g. access check) - may refer to caller arguments $1..$n
+	Where string
+	// Unique column used as tie-breaker when sorting and for cursors
+	Key string
+	// Columns (as named in the result) that may be sorted on
+	Sort []string
+	// Default sort - column name prefixed by - for descending
+	DefaultSort string
+	// Filter parameter => column filtered on equality (comma separated values mean IN)
+	Filters map[string]string
+	// Column filtered by the date range parameters from/to (inclusive)
+	Date string
+}
+
+// ListParams are the paging, sorting and filtering parameters of a list request
+type ListParams struct {
+	Limit   int
+	Offset  int
+	Cursor  string
+	Sort    string
+	Filters map[string]string
+	From    string
+	To      string
+}
+
+// ListPage is the envelope returned for every list
+type ListPage struct {
+	Data []interface{} `json:"data"`
+	// Count of rows matching the filters (0 when paging past the last row)
+	Total  int64  `json:"total"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+	Sort   string `json:"sort"`
+	// Cursor of the next page (use instead of offset for stable paging)
+	Cursor string `json:"cursor,omitempty"`
+	// Query string of the next page (empty on last page)
+	Next string `json:"next,omitempty"`
+}
+
+// ParseList reads list parameters from a query string: limit, offset (or legacy page),
+// cursor, sort (-column for descending), from, to and the filters of the spec
+func ParseList(values url.Values, spec ListSpec) (p ListParams, err error) {
+	p.Limit = ListLimit
+	if v := values.Get("limit"); v != "" {
+		if p.Limit, err = strconv.Atoi(v); err != nil || p.Limit < 1 || p.Limit > ListMaxLimit {
+			return p, fmt.Errorf("limit must be between 1 and %d", ListMaxLimit)
+		}
+	}
+	if v := values.Get("offset"); v != "" {
+		if p.Offset, err = strconv.Atoi(v); err != nil || p.Offset < 0 {
+			return p, fmt.Errorf("offset must be a positive integer")
+		}
+	} else if v := values.Get("page"); v != "" {
+		page, err := strconv.Atoi(v)
+		if err != nil || page < 0 {
+			return p, fmt.Errorf("page must be a positive integer")
+		}
+		p.Offset = page * p.Limit
+	}
+	p.Cursor = values.Get("cursor")
+	p.Sort = values.Get("sort")
+	if p.Sort == "" {
+		p.Sort = spec.DefaultSort
+	}
+	if _, _, err = spec.order(p.Sort); err != nil {
+		return p, err
+	}
+	p.Filters = make(map[string]string)
+	for name := range spec.Filters {
+		if v := values.Get(name); v != "" {
+			p.Filters[name] = v
+		}
+	}
+	if spec.Date != "" {
+		p.From, p.To = values.Get("from"), values.Get("to")
+	}
+	return p, nil
+}
+
+// order validates the sort parameter against the whitelist
+func (s ListSpec) order(sort string) (column string, desc bool, err error) {
+	column = strings.TrimPrefix(sort, "-")
+	desc = strings.HasPrefix(sort, "-")
+	if column == s.Key {
+		return column, desc, nil
+	}
+	for _, c := range s.Sort {
+		if c == column {
+			return column, desc, nil
+		}
+	}
+	return "", false, fmt.Errorf("cannot sort on [%s] - use one of [%s]", column, strings.Join(s.Sort, ",")+","+s.Key)
+}
+
+// Query builds the page query. The caller arguments (referred to by From and Where) come
+// first and the list arguments are appended. One row more than the limit is fetched to tell
+// if there is a next page and list_total holds the count of rows matching the filters.
+func (s ListSpec) Query(p ListParams, args ...interface{}) (string, []interface{}, error) {
+	column, desc, err := s.order(p.Sort)
+	if err != nil {
+		return "", nil, err
+	}
+	where, args := s.Filter(p, args...)
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	dir, cmp := "ASC", ">"
+	if desc {
+		dir, cmp = "DESC", "<"
+	}
+	order := column + " " + dir
+	if column != s.Key {
+		order += ", " + s.Key + " " + dir
+	}
+	outer := "1 = 1"
+	offset := p.Offset
+	if p.Cursor != "" {
+		last, err := decodeCursor(p.Cursor)
+		if err != nil {
+			return "", nil, err
+		}
+		if column == s.Key {
+			outer = fmt.Sprintf("%s %s %s", s.Key, cmp, arg(last[1]))
+		} else {
+			v, k := arg(last[0]), arg(last[1])
+			outer = fmt.Sprintf("(%s %s %s OR (%s = %s AND %s %s %s))", column, cmp, v, column, v, s.Key, cmp, k)
+		}
+		offset = 0
+	}
+
+	query := fmt.Sprintf(`
+    SELECT *
+      FROM (SELECT *, COUNT(*) OVER () AS list_total
+              FROM %s
+             WHERE %s) l
+     WHERE %s
+     ORDER BY %s
+    OFFSET %s ROWS FETCH NEXT %s ROWS ONLY`,
+		s.From, where, outer, order, arg(offset), arg(p.Limit+1))
+	return query, args, nil
+}
+
+// Filter builds the predicate of the fixed Where, the filters and the date range of the
+// list - without paging - appending the list arguments to the caller arguments
+func (s ListSpec) Filter(p ListParams, args ...interface{}) (string, []interface{}) {
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	where := []string{"1 = 1"}
+	if s.Where != "" {
+		where = append(where, "("+s.Where+")")
+	}
+	names := make([]string, 0, len(p.Filters))
+	for name := range s.Filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v, ok := p.Filters[name]
+		if !ok {
+			continue
+		}
+		var in []string
+		for _, value := range strings.Split(v, ",") {
+			in = append(in, arg(strings.TrimSpace(value)))
+		}
+		where = append(where, fmt.Sprintf("%s IN (%s)", s.Filters[name], strings.Join(in, ", ")))
+	}
+	if s.Date != "" && p.From != "" {
+		where = append(where, s.Date+" >= "+arg(p.From))
+	}
+	if s.Date != "" && p.To != "" {
+		where = append(where, s.Date+" < DATEADD(DAY, 1, CAST("+arg(p.To)+" AS DATE))")
+	}
+	return strings.Join(where, "\n               AND "), args
+}
+
+// Page turns the rows of the page query into the list envelope
+func (s ListSpec) Page(p ListParams, rows []interface{}) *ListPage {
+	page := &ListPage{Data: []interface{}{}, Limit: p.Limit, Offset: p.Offset, Sort: p.Sort}
+	if p.Cursor != "" {
+		page.Offset = 0
+	}
+	column, _, _ := s.order(p.Sort)
+	for i, row := range rows {
+		r, ok := row.(map[string]interface{})
+		if ok {
+			if n, err := strconv.ParseInt(fmt.Sprint(r["list_total"]), 10, 64); err == nil {
+				page.Total = n
+			}
+			delete(r, "list_total")
+		}
+		if i == p.Limit {
+			// The extra row tells there is a next page
+			last := page.Data[len(page.Data)-1]
+			if l, ok := last.(map[string]interface{}); ok {
+				page.Cursor = encodeCursor(l[column], l[s.Key])
+			}
+			page.Next = s.next(p, page.Cursor).Encode()
+			break
+		}
+		page.Data = append(page.Data, row)
+	}
+	return page
+}
+
+func (s ListSpec) next(p ListParams, cursor string) url.Values {
+	v := url.Values{}
+	v.Set("limit", strconv.Itoa(p.Limit))
+	v.Set("sort", p.Sort)
+	if p.Cursor != "" {
+		v.Set("cursor", cursor)
+	} else {
+		v.Set("offset", strconv.Itoa(p.Offset+p.Limit))
+	}
+	for name, value := range p.Filters {
+		v.Set(name, value)
+	}
+	if p.From != "" {
+		v.Set("from", p.From)
+	}
+	if p.To != "" {
+		v.Set("to", p.To)
+	}
+	return v
+}
+
+// Cursors are opaque to clients: the sort and key values of the last row of the page. The
+// values are typed, so times and integers are bound as such again (not as text or floats).
+func encodeCursor(value, key interface{}) string {
+	b, _ := json.Marshal([]interface{}{cursorValue(value), cursorValue(key)})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(cursor string) (last []interface{}, err error) {
+	var values [][2]interface{}
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil {
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber()
+		err = d.Decode(&values)
+	}
+	if err == nil && len(values) == 2 {
+		for _, v := range values {
+			value, e := parseCursorValue(v)
+			if e != nil {
+				err = e
+				break
+			}
+			last = append(last, value)
+		}
+	}
+	if err != nil || len(last) != 2 {
+		return nil, fmt.Errorf("invalid cursor [%s]", cursor)
+	}
+	return last, nil
+}
+
+// cursorValue pairs the value with its kind (time, int or empty for anything else)
+func cursorValue(v interface{}) [2]interface{} {
+	switch t := v.(type) {
+	case time.Time:
+		return [2]interface{}{"time", t.Format(time.RFC3339Nano)}
+	case int64, int32, int16, int8, int:
+		return [2]interface{}{"int", fmt.Sprint(t)}
+	}
+	return [2]interface{}{"", v}
+}
+
+func parseCursorValue(v [2]interface{}) (interface{}, error) {
+	kind, _ := v[0].(string)
+	switch kind {
+	case "time":
+		s, _ := v[1].(string)
+		return time.Parse(time.RFC3339Nano, s)
+	case "int":
+		s, _ := v[1].(string)
+		return strconv.ParseInt(s, 10, 64)
+	}
+	if n, ok := v[1].(json.Number); ok {
+		return n.Float64()
+	}
+	return v[1], nil
+}
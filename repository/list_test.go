@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSpec = ListSpec{
+	From:        "meta.delivery_v",
+	Where:       "meta.user_access($1, agreement_id, 'VIEW') > 0",
+	Key:         "delivery_id",
+	Sort:        []string{"delivery_name", "audit_createdtm"},
+	DefaultSort: "-audit_createdtm",
+	Filters:     map[string]string{"status": "status_id", "owner": "delivery_owner"},
+	Date:        "audit_createdtm",
+}
+
+func TestParseList(t *testing.T) {
+	p, err := ParseList(url.Values{"page": {"2"}, "status": {"1,3"}, "bogus": {"x"}}, testSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Limit != ListLimit || p.Offset != 2*ListLimit || p.Sort != "-audit_createdtm" {
+		t.Errorf("Unexpected params %v", p)
+	}
+	if len(p.Filters) != 1 || p.Filters["status"] != "1,3" {
+		t.Errorf("Expected only status filter, got %v", p.Filters)
+	}
+	for _, v := range []url.Values{{"sort": {"password"}}, {"limit": {"0"}}, {"limit": {"5000"}}, {"offset": {"-1"}}} {
+		if _, err := ParseList(v, testSpec); err == nil {
+			t.Errorf("Expected error for %v", v)
+		}
+	}
+}
+
+func TestListQuery(t *testing.T) {
+	p, _ := ParseList(url.Values{"status": {"1,3"}, "from": {"2019-01-01"}, "limit": {"10"}, "offset": {"20"}}, testSpec)
+	q, args, err := testSpec.Query(p, "system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"status_id IN ($2, $3)", "audit_createdtm >= $4", "ORDER BY audit_createdtm DESC, delivery_id DESC", "OFFSET $5 ROWS FETCH NEXT $6 ROWS ONLY"} {
+		if !strings.Contains(q, s) {
+			t.Errorf("Expected [%s] in query [%s]", s, q)
+		}
+	}
+	if len(args) != 6 || args[0] != "system" || args[4] != 20 || args[5] != 11 {
+		t.Errorf("Unexpected args %v", args)
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	p, _ := ParseList(url.Values{"owner": {"sbk"}, "to": {"2019-12-31"}, "limit": {"10"}}, testSpec)
+	where, args := testSpec.Filter(p, "system")
+	for _, s := range []string{"(meta.user_access($1, agreement_id, 'VIEW') > 0)", "delivery_owner IN ($2)", "audit_createdtm < DATEADD(DAY, 1, CAST($3 AS DATE))"} {
+		if !strings.Contains(where, s) {
+			t.Errorf("Expected [%s] in predicate [%s]", s, where)
+		}
+	}
+	if strings.Contains(where, "OFFSET") || len(args) != 3 || args[1] != "sbk" || args[2] != "2019-12-31" {
+		t.Errorf("Unexpected predicate [%s] args %v", where, args)
+	}
+}
+
+func TestListPage(t *testing.T) {
+	rows := []interface{}{
+		map[string]interface{}{"delivery_id": "3", "audit_createdtm": "2019-03-03", "list_total": "3"},
+		map[string]interface{}{"delivery_id": "2", "audit_createdtm": "2019-03-02", "list_total": "3"},
+		map[string]interface{}{"delivery_id": "1", "audit_createdtm": "2019-03-01", "list_total": "3"},
+	}
+	rep := New(NewMockDb(rows))
+	p, _ := ParseList(url.Values{"limit": {"2"}}, testSpec)
+	page, err := rep.List(testSpec, p, "system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Data) != 2 || page.Total != 3 || page.Cursor == "" {
+		t.Fatalf("Unexpected page %v", page)
+	}
+	if _, ok := page.Data[0].(map[string]interface{})["list_total"]; ok {
+		t.Error("Expected list_total removed from rows")
+	}
+	next, _ := url.ParseQuery(page.Next)
+	if next.Get("offset") != "2" || next.Get("limit") != "2" {
+		t.Errorf("Unexpected next page [%s]", page.Next)
+	}
+
+	// The cursor continues after the last row of the page
+	p, _ = ParseList(url.Values{"limit": {"2"}, "cursor": {page.Cursor}}, testSpec)
+	q, args, err := testSpec.Query(p, "system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(q, "(audit_createdtm < $2 OR (audit_createdtm = $2 AND delivery_id < $3))") || args[1] != "2019-03-02" || args[2] != "2" {
+		t.Errorf("Unexpected cursor query [%s] %v", q, args)
+	}
+}
+
+func TestListCursorTypes(t *testing.T) {
+	// Times and integers are bound as such - not as text (nvarchar) or floats
+	createdtm := time.Date(2019, 3, 2, 10, 30, 0, 123000000, time.UTC)
+	cursor := encodeCursor(createdtm, int64(9007199254740993))
+	last, err := decodeCursor(cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tm, ok := last[0].(time.Time); !ok || !tm.Equal(createdtm) {
+		t.Errorf("Expected time [%s], got [%#v]", createdtm, last[0])
+	}
+	if last[1] != int64(9007199254740993) {
+		t.Errorf("Expected int64 key, got [%#v]", last[1])
+	}
+	for _, c := range []string{"bm9wZQ", encodeCursor("x", "y")[:4], "W1sidGltZSIsIngiXSxbIiIsMV1d"} {
+		if _, err := decodeCursor(c); err == nil {
+			t.Errorf("Expected invalid cursor [%s]", c)
+		}
+	}
+}
@@ -1,20 +1,59 @@
 package repository
 
+import (
+	"context"
+	"encoding/json"
+)
+
 type MockDb struct {
 	count map[string]int
 	rows  []interface{}
+	err   error
 }
 
 func NewMockDb(data []interface{}) MockDb {
 	return MockDb{count: map[string]int{"Query": 0}, rows: data}
 }
 
-func (db MockDb) Query(query string, limit int, args ...interface{}) ([]interface{}, error) {
+// NewFailingMockDb returns err from every query
+func NewFailingMockDb(err error) MockDb {
+	return MockDb{count: map[string]int{"Query": 0}, err: err}
+}
+
+func (db MockDb) Query(ctx context.Context, query string, limit int, args ...interface{}) ([]interface{}, error) {
 	db.count["Query"]++
-	return db.rows, nil
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return db.rows, db.err
+}
+
+// QueryStruct converts the mock rows into dest via their JSON representation
+func (db MockDb) QueryStruct(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	db.count["Query"]++
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b, err := json.Marshal(db.rows)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dest)
+}
+
+func (db MockDb) Rows(ctx context.Context, query string, args ...interface{}) (Rows, error) {
+	db.count["Query"]++
+	return NewSliceRows(ctx, db.rows), ctx.Err()
+}
+
+func (db MockDb) Begin(ctx context.Context) (Tx, error) {
+	db.count["Begin"]++
+	return mockTx{db}, ctx.Err()
 }
-func (db MockDb) Commit() error         { return nil }
-func (db MockDb) Begin() error          { return nil }
-func (db MockDb) Rollback() error       { return nil }
 func (db MockDb) Migrate() error        { return nil }
 func (db MockDb) Stats() map[string]int { return db.count }
+
+type mockTx struct{ MockDb }
+
+func (tx mockTx) Commit() error   { tx.count["Commit"]++; return nil }
+func (tx mockTx) Rollback() error { tx.count["Rollback"]++; return nil }
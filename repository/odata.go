@@ -0,0 +1,283 @@
+package repository
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ParseOData reads the OData query options $select, $filter, $orderby, $top and $skip into a
+// select. $filter supports the comparison operators eq, ne, lt, le, gt, ge and in, the
+// functions contains, startswith and endswith, and/or/not and parentheses. Literals are
+// 'strings', numbers, true, false, null and dates (e.g. 2019-01-31 or 2019-01-31T12:00:00Z).
+// Unsupported options ($expand, $apply, $search...) are rejected.
+func ParseOData(values url.Values) (s SelectSpec, err error) {
+	for name := range values {
+		switch name {
+		case "$select", "$filter", "$orderby", "$top", "$skip", "$format":
+		default:
+			if strings.HasPrefix(name, "$") {
+				return s, fmt.Errorf("query option [%s] is not supported", name)
+			}
+		}
+	}
+	if f := values.Get("$format"); f != "" && f != "json" && !strings.HasPrefix(f, "application/json") {
+		return s, fmt.Errorf("$format [%s] is not supported - use json", f)
+	}
+	for _, col := range strings.Split(values.Get("$select"), ",") {
+		if col = strings.TrimSpace(col); col != "" && col != "*" {
+			s.Select = append(s.Select, col)
+		}
+	}
+	for _, o := range strings.Split(values.Get("$orderby"), ",") {
+		fields := strings.Fields(o)
+		switch {
+		case len(fields) == 0:
+		case len(fields) == 1 || (len(fields) == 2 && strings.ToLower(fields[1]) == "asc"):
+			s.OrderBy = append(s.OrderBy, fields[0])
+		case len(fields) == 2 && strings.ToLower(fields[1]) == "desc":
+			s.OrderBy = append(s.OrderBy, "-"+fields[0])
+		default:
+			return s, fmt.Errorf("invalid $orderby [%s]", o)
+		}
+	}
+	if v := values.Get("$top"); v != "" {
+		if s.Limit, err = strconv.Atoi(v); err != nil || s.Limit < 1 {
+			return s, fmt.Errorf("$top must be a positive integer")
+		}
+	}
+	if v := values.Get("$skip"); v != "" {
+		if s.Offset, err = strconv.Atoi(v); err != nil || s.Offset < 0 {
+			return s, fmt.Errorf("$skip must be a positive integer")
+		}
+	}
+	if v := values.Get("$filter"); v != "" {
+		p := &odataParser{tokens: odataTokens(v)}
+		f, err := p.or()
+		if err == nil && p.pos < len(p.tokens) {
+			err = fmt.Errorf("unexpected [%s]", p.tokens[p.pos])
+		}
+		if err != nil {
+			return s, fmt.Errorf("invalid $filter [%s]: %s", v, err)
+		}
+		s.Where = &f
+	}
+	return s, nil
+}
+
+// odataTokens splits a filter into tokens: 'strings' (quotes kept), punctuation and words
+func odataTokens(filter string) (tokens []string) {
+	r := []rune(filter)
+	for i := 0; i < len(r); {
+		switch {
+		case unicode.IsSpace(r[i]):
+			i++
+		case r[i] == '(' || r[i] == ')' || r[i] == ',':
+			tokens = append(tokens, string(r[i]))
+			i++
+		case r[i] == '\'':
+			j := i + 1
+			for ; j < len(r); j++ {
+				if r[j] == '\'' {
+					if j+1 < len(r) && r[j+1] == '\'' {
+						j++ // escaped quote
+						continue
+					}
+					break
+				}
+			}
+			if j < len(r) {
+				j++
+			}
+			tokens = append(tokens, string(r[i:j]))
+			i = j
+		default:
+			j := i
+			for j < len(r) && !unicode.IsSpace(r[j]) && !strings.ContainsRune("(),'", r[j]) {
+				j++
+			}
+			tokens = append(tokens, string(r[i:j]))
+			i = j
+		}
+	}
+	return tokens
+}
+
+var odataComparisons = map[string]bool{"eq": true, "ne": true, "lt": true, "le": true, "gt": true, "ge": true}
+
+// odataLike maps the string functions to LIKE patterns
+var odataLike = map[string]string{"contains": "%%%s%%", "startswith": "%s%%", "endswith": "%%%s"}
+
+// odataParser is a recursive descent parser of $filter
+type odataParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *odataParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *odataParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *odataParser) expect(t string) error {
+	if got := p.next(); got != t {
+		return fmt.Errorf("expected [%s] got [%s]", t, got)
+	}
+	return nil
+}
+
+func (p *odataParser) or() (Filter, error) {
+	f, err := p.and()
+	var or []Filter
+	for err == nil && strings.ToLower(p.peek()) == "or" {
+		p.next()
+		var g Filter
+		if g, err = p.and(); err == nil {
+			or = append(or, g)
+		}
+	}
+	if len(or) > 0 {
+		return Filter{Or: append([]Filter{f}, or...)}, err
+	}
+	return f, err
+}
+
+func (p *odataParser) and() (Filter, error) {
+	f, err := p.unary()
+	var and []Filter
+	for err == nil && strings.ToLower(p.peek()) == "and" {
+		p.next()
+		var g Filter
+		if g, err = p.unary(); err == nil {
+			and = append(and, g)
+		}
+	}
+	if len(and) > 0 {
+		return Filter{And: append([]Filter{f}, and...)}, err
+	}
+	return f, err
+}
+
+func (p *odataParser) unary() (Filter, error) {
+	if strings.ToLower(p.peek()) == "not" {
+		p.next()
+		f, err := p.unary()
+		return Filter{Not: &f}, err
+	}
+	return p.primary()
+}
+
+func (p *odataParser) primary() (Filter, error) {
+	t := p.next()
+	if t == "(" {
+		f, err := p.or()
+		if err == nil {
+			err = p.expect(")")
+		}
+		return f, err
+	}
+	if pattern, ok := odataLike[strings.ToLower(t)]; ok {
+		// contains(column,'value')
+		if err := p.expect("("); err != nil {
+			return Filter{}, err
+		}
+		column := p.next()
+		if err := p.expect(","); err != nil {
+			return Filter{}, err
+		}
+		v, err := odataLiteral(p.next())
+		if err != nil {
+			return Filter{}, err
+		}
+		s, ok := v.(string)
+		if !ok {
+			return Filter{}, fmt.Errorf("%s of [%s] requires a string", t, column)
+		}
+		if err := p.expect(")"); err != nil {
+			return Filter{}, err
+		}
+		return Filter{Column: column, Op: "like", Value: fmt.Sprintf(pattern, likeEscape(s))}, nil
+	}
+
+	column, op := t, strings.ToLower(p.next())
+	if op == "in" {
+		// column in ('a','b')
+		if err := p.expect("("); err != nil {
+			return Filter{}, err
+		}
+		var values []interface{}
+		for {
+			v, err := odataLiteral(p.next())
+			if err != nil {
+				return Filter{}, err
+			}
+			values = append(values, v)
+			if sep := p.next(); sep == ")" {
+				break
+			} else if sep != "," {
+				return Filter{}, fmt.Errorf("expected [,] or [)] got [%s]", sep)
+			}
+		}
+		return Filter{Column: column, Op: "in", Value: values}, nil
+	}
+	if !odataComparisons[op] {
+		return Filter{}, fmt.Errorf("operator [%s] is not supported", op)
+	}
+	v, err := odataLiteral(p.next())
+	if err != nil {
+		return Filter{}, err
+	}
+	if v == nil {
+		// eq null => IS NULL, ne null => IS NOT NULL
+		if op != "eq" && op != "ne" {
+			return Filter{}, fmt.Errorf("null can only be compared by eq and ne")
+		}
+		return Filter{Column: column, Op: "null", Value: op == "eq"}, nil
+	}
+	return Filter{Column: column, Op: op, Value: v}, nil
+}
+
+// odataLiteral converts a literal token into a value (typed like decoded JSON)
+func odataLiteral(t string) (interface{}, error) {
+	switch {
+	case t == "":
+		return nil, fmt.Errorf("value is missing")
+	case strings.HasPrefix(t, "'"):
+		if len(t) < 2 || !strings.HasSuffix(t, "'") {
+			return nil, fmt.Errorf("unterminated string [%s]", t)
+		}
+		return strings.Replace(t[1:len(t)-1], "''", "'", -1), nil
+	case t == "null":
+		return nil, nil
+	case t == "true" || t == "false":
+		return t == "true", nil
+	}
+	if f, err := strconv.ParseFloat(t, 64); err == nil {
+		return f, nil
+	}
+	// Dates are compared as text (converted by the database) - date/times are returned in UTC
+	// by the repository, so they are filtered in UTC too
+	if d, err := time.Parse("2006-01-02", t); err == nil {
+		return d.Format("2006-01-02"), nil
+	}
+	if d, err := time.Parse(time.RFC3339Nano, t); err == nil {
+		return d.UTC().Format("2006-01-02 15:04:05.999"), nil
+	}
+	return nil, fmt.Errorf("invalid literal [%s]", t)
+}
+
+// likeEscape makes the LIKE wildcards of s match literally
+func likeEscape(s string) string {
+	return strings.NewReplacer("[", "[[]", "%", "[%]", "_", "[_]").Replace(s)
+}
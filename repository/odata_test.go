@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParseOData(t *testing.T) {
+	values, _ := url.ParseQuery("$select=customer,amount&$orderby=amount desc,customer&$top=10&$skip=20" +
+		"&$filter=" + url.QueryEscape("customer in ('a','b''c') and (amount gt 1.5 or not contains(name,'5%')) and date ge 2019-01-01T12:00:00+01:00 and note eq null"))
+	s, err := ParseOData(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s.Select, []string{"customer", "amount"}) || !reflect.DeepEqual(s.OrderBy, []string{"-amount", "customer"}) || s.Limit != 10 || s.Offset != 20 {
+		t.Errorf("Unexpected select %v", s)
+	}
+	not := Filter{Column: "name", Op: "like", Value: "%5[%]%"}
+	expected := Filter{And: []Filter{
+		{Column: "customer", Op: "in", Value: []interface{}{"a", "b'c"}},
+		{Or: []Filter{{Column: "amount", Op: "gt", Value: 1.5}, {Not: &not}}},
+		{Column: "date", Op: "ge", Value: "2019-01-01 11:00:00"},
+		{Column: "note", Op: "null", Value: true},
+	}}
+	if !reflect.DeepEqual(*s.Where, expected) {
+		t.Errorf("Expected filter %+v, got %+v", expected, *s.Where)
+	}
+	// The filter translates into a select
+	src := SelectSource{From: "x", Columns: []string{"customer", "amount", "name", "date", "note"}}
+	if _, _, err := src.Query(s); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseODataInvalid(t *testing.T) {
+	for _, q := range []string{
+		"$expand=x",
+		"$top=-1",
+		"$skip=x",
+		"$orderby=a sideways",
+		"$format=xml",
+		"$filter=" + url.QueryEscape("a eq"),
+		"$filter=" + url.QueryEscape("a eq 'x"),
+		"$filter=" + url.QueryEscape("a like 'x'"),
+		"$filter=" + url.QueryEscape("(a eq 1"),
+		"$filter=" + url.QueryEscape("a eq 1 b"),
+		"$filter=" + url.QueryEscape("a gt null"),
+		"$filter=" + url.QueryEscape("contains(a,1)"),
+		"$filter=" + url.QueryEscape("a eq x"),
+	} {
+		values, _ := url.ParseQuery(q)
+		if _, err := ParseOData(values); err == nil {
+			t.Errorf("Expected error for %s", q)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package repository
 
 import (
+	"context"
 	"encoding/json"
 	"log"
+	"time"
 )
 
 // Repository pattern combined with database singleton
@@ -11,43 +13,92 @@ type Repository interface {
 	Exec(sql string, args ...interface{}) ([]interface{}, error)
 	QueryJson(query string, limit int, args ...interface{}) (string, error)
 	Query(query string, limit int, args ...interface{}) ([]interface{}, error)
+	QueryStruct(dest interface{}, query string, args ...interface{}) error
+	List(spec ListSpec, params ListParams, args ...interface{}) (*ListPage, error)
+	Select(src SelectSource, spec SelectSpec, args ...interface{}) (*SelectPage, error)
+	// Rows streams the result set - it runs under the context but not the timeout
+	Rows(query string, args ...interface{}) (Rows, error)
+	// WithContext returns the repository running every call under ctx (e.g. of the HTTP request)
+	WithContext(ctx context.Context) Repository
+	// WithTimeout returns the repository limiting every call to timeout (0 means no limit)
+	WithTimeout(timeout time.Duration) Repository
+	// Tx runs fn in a transaction which is committed if fn returns nil and rolled back otherwise
+	Tx(fn func(rep Repository) error) error
 }
 
 type dbRepository struct {
-	db Dber
+	db      Dber
+	q       Querier // db or the transaction in progress
+	ctx     context.Context
+	timeout time.Duration
 }
 
 func New(db Dber) Repository {
-	return &dbRepository{db: db}
+	return &dbRepository{db: db, q: db, ctx: context.Background()}
 }
 
 // Legacy - deprecated
 func NewRepository(db Dber) Repository {
-	return &dbRepository{db: db}
+	return New(db)
 }
 
-// Exec fires off a stored procedure expecting only OK/err
-func (r *dbRepository) Exec(sql string, args ...interface{}) ([]interface{}, error) {
-	// Start Tx
-	err := r.db.Begin()
-	if err != nil {
-		return nil, err
+func (r *dbRepository) WithContext(ctx context.Context) Repository {
+	c := *r
+	c.ctx = ctx
+	return &c
+}
+
+func (r *dbRepository) WithTimeout(timeout time.Duration) Repository {
+	c := *r
+	c.timeout = timeout
+	return &c
+}
+
+// context of a single call
+func (r *dbRepository) context() (context.Context, context.CancelFunc) {
+	if r.timeout > 0 {
+		return context.WithTimeout(r.ctx, r.timeout)
 	}
-	// Do update
-	res, err := r.db.Query(sql, 0, args...)
+	return context.WithCancel(r.ctx)
+}
+
+func (r *dbRepository) Tx(fn func(rep Repository) error) (err error) {
+	if _, ok := r.q.(Tx); ok {
+		// Already in a transaction
+		return fn(r)
+	}
+	// The timeout applies to the transaction as a whole
+	ctx, cancel := r.context()
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		// Rollback on error
-		_ = r.db.Rollback()
-		return res, err
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	c := *r
+	c.q, c.ctx, c.timeout = tx, ctx, 0
+	if err = fn(&c); err != nil {
+		_ = tx.Rollback()
+		return err
 	}
-	// Commit
-	err = r.db.Commit()
-	return res, err
+	return tx.Commit()
+}
+
+// Exec fires off a stored procedure expecting only OK/err. It runs in autocommit (or in the
+// transaction of Tx) - procedures logging a failure before raising an error keep the log and
+// handle their own transactions.
+func (r *dbRepository) Exec(sql string, args ...interface{}) ([]interface{}, error) {
+	return r.Query(sql, 0, args...)
 }
 
 // QueryJson fires off query and converts results to json
 func (r *dbRepository) QueryJson(query string, limit int, args ...interface{}) (string, error) {
-	results, err := r.db.Query(query, limit, args...)
+	results, err := r.Query(query, limit, args...)
 	if err != nil {
 		return "", err
 	}
@@ -61,5 +112,46 @@ func (r *dbRepository) QueryJson(query string, limit int, args ...interface{}) (
 }
 
 func (r *dbRepository) Query(query string, limit int, args ...interface{}) ([]interface{}, error) {
-	return r.db.Query(query, limit, args...)
+	ctx, cancel := r.context()
+	defer cancel()
+	return r.q.Query(ctx, query, limit, args...)
+}
+
+// QueryStruct fires off query scanning the results into dest (pointer to slice of DTOs)
+func (r *dbRepository) QueryStruct(dest interface{}, query string, args ...interface{}) error {
+	ctx, cancel := r.context()
+	defer cancel()
+	return r.q.QueryStruct(ctx, dest, query, args...)
+}
+
+// Rows fires off query returning an iterator - large results are not kept in memory and
+// the query ends when the context (e.g. of the HTTP request) is cancelled
+func (r *dbRepository) Rows(query string, args ...interface{}) (Rows, error) {
+	return r.q.Rows(r.ctx, query, args...)
+}
+
+// List fetches a page of the list described by spec - args are referred to by spec.From/Where
+func (r *dbRepository) List(spec ListSpec, params ListParams, args ...interface{}) (*ListPage, error) {
+	query, args, err := spec.Query(params, args...)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.Query(query, 0, args...)
+	if err != nil {
+		return nil, err
+	}
+	return spec.Page(params, rows), nil
+}
+
+// Select runs the ad-hoc select against the source - args are referred to by src.From/Where
+func (r *dbRepository) Select(src SelectSource, spec SelectSpec, args ...interface{}) (*SelectPage, error) {
+	query, args, err := src.Query(spec, args...)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.Query(query, 0, args...)
+	if err != nil {
+		return nil, err
+	}
+	return spec.Page(rows), nil
 }
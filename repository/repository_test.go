@@ -1,7 +1,16 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestRepository(t *testing.T) {
@@ -33,3 +42,193 @@ func TestRepository(t *testing.T) {
 		t.Errorf("Got [%d], expected [%d] calls to Query", got, 1)
 	}
 }
+
+type testDto struct {
+	Id      int64   `json:"id"`
+	Name    *string `json:"name"`
+	Ignored string  `json:"-"`
+	hidden  string
+}
+
+// stubDriver is a database/sql driver returning the result set registered under the query
+// text - scanning is tested on the values as a driver returns them
+type stubDriver struct{}
+
+type stubResult struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+var stubResults = map[string]stubResult{}
+
+type stubConn struct{}
+type stubStmt struct{ query string }
+type stubRows struct {
+	stubResult
+	next int
+}
+
+func (stubDriver) Open(name string) (driver.Conn, error) { return stubConn{}, nil }
+
+func (stubConn) Prepare(query string) (driver.Stmt, error) { return stubStmt{query}, nil }
+func (stubConn) Close() error                              { return nil }
+func (stubConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+func (s stubStmt) Close() error  { return nil }
+func (s stubStmt) NumInput() int { return -1 }
+func (s stubStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s stubStmt) Query(args []driver.Value) (driver.Rows, error) {
+	res, ok := stubResults[s.query]
+	if !ok {
+		return nil, fmt.Errorf("no result of [%s]", s.query)
+	}
+	return &stubRows{stubResult: res}, nil
+}
+
+func (r *stubRows) Columns() []string { return r.columns }
+func (r *stubRows) Close() error      { return nil }
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
+
+func init() {
+	sql.Register("stub", stubDriver{})
+}
+
+// stubRepository is a repository on the stub driver
+func stubRepository(t *testing.T) Repository {
+	db, err := sql.Open("stub", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(&Db{db: db})
+}
+
+func TestQueryStruct(t *testing.T) {
+	created := time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC)
+	stubResults["dto"] = stubResult{
+		columns: []string{"id", "name", "createdtm", "unknown"},
+		rows: [][]driver.Value{
+			{int64(1), []byte("blu"), created, "x"},
+			{int64(2), nil, nil, nil},
+		},
+	}
+	rep := stubRepository(t)
+
+	type createdDto struct {
+		testDto
+		Createdtm *time.Time `json:"createdtm"`
+	}
+	var res []createdDto
+	if err := rep.QueryStruct(&res, "dto"); err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || res[0].Id != 1 || *res[0].Name != "blu" || !res[0].Createdtm.Equal(created) {
+		t.Errorf("Unexpected result %v", res)
+	}
+	if res[1].Id != 2 || res[1].Name != nil || res[1].Createdtm != nil {
+		t.Error("Expected NULL name and createdtm to stay nil")
+	}
+	var ptrs []*testDto
+	if err := rep.QueryStruct(&ptrs, "dto"); err != nil || len(ptrs) != 2 || ptrs[1].Id != 2 || *ptrs[0].Name != "blu" {
+		t.Errorf("Unexpected result %v (%v)", ptrs, err)
+	}
+}
+
+func TestQueryStructNull(t *testing.T) {
+	stubResults["null"] = stubResult{
+		columns: []string{"id", "name"},
+		rows:    [][]driver.Value{{nil, []byte("blu")}},
+	}
+	rep := stubRepository(t)
+
+	// NULL cannot be kept by a non-pointer field
+	var res []testDto
+	if err := rep.QueryStruct(&res, "null"); err == nil {
+		t.Errorf("Expected error scanning NULL into int64, got %v", res)
+	}
+	var wrong []int64
+	if err := rep.QueryStruct(&wrong, "null"); err == nil {
+		t.Error("Expected error scanning into a slice of non-structs")
+	}
+}
+
+func TestColumns(t *testing.T) {
+	type embedding struct {
+		testDto
+		Extra string
+	}
+	cols := columns(reflect.TypeOf(embedding{}))
+	if len(cols) != 3 {
+		t.Errorf("Expected columns id, name and Extra - got %v", cols)
+	}
+	if index := cols["name"]; len(index) != 2 || index[0] != 0 || index[1] != 1 {
+		t.Errorf("Unexpected index of embedded field name %v", index)
+	}
+	if value([]byte("abc"), false) != "abc" {
+		t.Error("Expected bytes as string")
+	}
+	if b, _ := json.Marshal(value([]byte("12.50"), true)); string(b) != "12.50" {
+		t.Errorf("Expected decimal as JSON number, got [%s]", b)
+	}
+}
+
+func TestTx(t *testing.T) {
+	moq := NewMockDb(nil)
+	rep := New(moq)
+
+	if _, err := rep.Exec("EXEC meta.x"); err != nil {
+		t.Fatal(err)
+	}
+	failed := errors.New("failed")
+	err := rep.Tx(func(tx Repository) error {
+		if _, err := tx.Exec("EXEC meta.x"); err != nil {
+			return err
+		}
+		return failed
+	})
+	if err != failed {
+		t.Errorf("Expected error from transaction, got [%v]", err)
+	}
+	// Exec runs in autocommit - or in the transaction in progress
+	stats := moq.Stats()
+	if stats["Begin"] != 1 || stats["Commit"] != 0 || stats["Rollback"] != 1 || stats["Query"] != 2 {
+		t.Errorf("Unexpected calls %v", stats)
+	}
+}
+
+func TestExecFailure(t *testing.T) {
+	// Procedures log failures before raising errors - the log must not be rolled back
+	failed := errors.New("Validation failed")
+	moq := NewFailingMockDb(failed)
+	if _, err := New(moq).Exec("EXEC meta.delivery_validate $1", "x"); err != failed {
+		t.Errorf("Expected error of procedure, got [%v]", err)
+	}
+	if stats := moq.Stats(); stats["Begin"] != 0 || stats["Rollback"] != 0 {
+		t.Errorf("Expected no transaction, got calls %v", stats)
+	}
+}
+
+func TestContext(t *testing.T) {
+	rep := New(NewMockDb(nil))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := rep.WithContext(ctx).Query("1", 0); err != context.Canceled {
+		t.Errorf("Expected cancelled query, got [%v]", err)
+	}
+	if _, err := rep.WithTimeout(-time.Second).Exec("1"); err != nil {
+		t.Errorf("Expected no limit for timeout <= 0, got [%v]", err)
+	}
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	if _, err := rep.WithContext(ctx).WithTimeout(time.Hour).Query("1", 0); err != context.DeadlineExceeded {
+		t.Errorf("Expected timed out query, got [%v]", err)
+	}
+}
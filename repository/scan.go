@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact numeric (DECIMAL, NUMERIC, MONEY) kept as text to avoid rounding. It
+// is encoded as a JSON number.
+type Decimal string
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if _, err := strconv.ParseFloat(string(d), 64); err != nil {
+		return json.Marshal(string(d))
+	}
+	return []byte(d), nil
+}
+
+// decimals flags the exact numeric columns of the result set
+func decimals(rows *sql.Rows) []bool {
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return nil
+	}
+	flags := make([]bool, len(types))
+	for i, t := range types {
+		switch strings.ToUpper(t.DatabaseTypeName()) {
+		case "DECIMAL", "NUMERIC", "MONEY", "SMALLMONEY":
+			flags[i] = true
+		}
+	}
+	return flags
+}
+
+// value converts a scanned driver value into its JSON friendly type
+func value(v interface{}, decimal bool) interface{} {
+	if b, ok := v.([]byte); ok {
+		if decimal {
+			return Decimal(b)
+		}
+		// Text (and binary)
+		return string(b)
+	}
+	return v
+}
+
+// columns maps the column names of the struct type to field indexes. The column name of a
+// field is the name of its json tag (or the field name) - fields tagged json:"-" are skipped.
+func columns(t reflect.Type) map[string][]int {
+	cols := make(map[string][]int)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && name == "" {
+			// Embedded DTOs contribute their columns (like encoding/json)
+			for col, index := range columns(f.Type) {
+				cols[col] = append([]int{i}, index...)
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue // unexported
+		}
+		if name == "" {
+			name = f.Name
+		}
+		cols[name] = []int{i}
+	}
+	return cols
+}
+
+// scanStruct scans rows into dest - a pointer to a slice of structs (or struct pointers).
+// Columns are matched to fields by columns() and columns without a field are ignored. Use
+// pointer fields (*int64, *string, *time.Time...) for nullable columns to keep NULL as nil.
+func scanStruct(rows *sql.Rows, dest interface{}) error {
+	slice := reflect.ValueOf(dest)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("scan destination must be a pointer to a slice - got [%T]", dest)
+	}
+	slice = slice.Elem()
+	elem := slice.Type().Elem()
+	ptr := elem.Kind() == reflect.Ptr
+	if ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return fmt.Errorf("scan destination must be a slice of structs - got [%T]", dest)
+	}
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	fields := columns(elem)
+	for rows.Next() {
+		row := reflect.New(elem)
+		p := make([]interface{}, len(cols))
+		for i, col := range cols {
+			if index, ok := fields[col]; ok {
+				p[i] = row.Elem().FieldByIndex(index).Addr().Interface()
+			} else {
+				p[i] = new(interface{})
+			}
+		}
+		if err := rows.Scan(p...); err != nil {
+			return err
+		}
+		if ptr {
+			slice.Set(reflect.Append(slice, row))
+		} else {
+			slice.Set(reflect.Append(slice, row.Elem()))
+		}
+	}
+	return rows.Err()
+}
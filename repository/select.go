@@ -0,0 +1,345 @@
+package repository
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Default and max number of rows returned by a select
+const (
+	SelectLimit    = 1000
+	SelectMaxLimit = 10000
+)
+
+// Max number of predicates in the filter of a select (bounds the size of the SQL)
+const selectMaxPredicates = 100
+
+// SelectSource is what a select runs against. Like ListSpec it is defined by the caller and
+// never by the request - columns of the request are only accepted if they are in Columns.
+type SelectSource struct {
+	// Table or view to select from (may refer to caller arguments $1..$n)
+	From string
+	// Fixed predicate (e.g. access check) - may refer to caller arguments $1..$n
+	Where string
+	// Columns that may be selected, filtered, grouped and sorted on
+	Columns []string
+	// Column sorted on by default (unless aggregating)
+	Key string
+}
+
+// SelectSpec is an ad-hoc read-only query as posted by clients (JSON), e.g.
+//
+//	{"select": ["customer", "amount"],
+//	 "where": {"and": [{"column": "customer", "op": "eq", "value": 42},
+//	                   {"column": "date", "op": "between", "value": ["2019-01-01", "2019-01-31"]}]},
+//	 "order_by": ["-amount"], "limit": 100}
+//
+// or aggregating
+//
+//	{"group_by": ["customer"], "aggregate": [{"func": "sum", "column": "amount", "as": "total"}]}
+type SelectSpec struct {
+	// Columns returned (default all) - must be grouped on when aggregating
+	Select []string `json:"select"`
+	Where  *Filter  `json:"where"`
+	// Columns grouped on
+	GroupBy   []string    `json:"group_by"`
+	Aggregate []Aggregate `json:"aggregate"`
+	// Columns (or aggregate names) sorted on - prefixed by - for descending
+	OrderBy []string `json:"order_by"`
+	Limit   int      `json:"limit"`
+	Offset  int      `json:"offset"`
+}
+
+// Filter is either a combination of filters (and, or, not) or a predicate on a column. The
+// operators are eq, ne, lt, le, gt, ge, like, in (array value), between (array of two values)
+// and null (true for IS NULL - false for IS NOT NULL).
+type Filter struct {
+	And    []Filter    `json:"and,omitempty"`
+	Or     []Filter    `json:"or,omitempty"`
+	Not    *Filter     `json:"not,omitempty"`
+	Column string      `json:"column,omitempty"`
+	Op     string      `json:"op,omitempty"`
+	Value  interface{} `json:"value,omitempty"`
+}
+
+// Aggregate is an aggregation function (count, count_distinct, sum, avg, min or max) of a
+// column named as As. Count may leave out the column to count rows.
+type Aggregate struct {
+	Func   string `json:"func"`
+	Column string `json:"column"`
+	As     string `json:"as"`
+}
+
+// SelectPage is the envelope returned by a select
+type SelectPage struct {
+	Data   []interface{} `json:"data"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+	// More rows follow (select again with offset + limit)
+	More bool `json:"more"`
+}
+
+var comparisons = map[string]string{"eq": "=", "ne": "<>", "lt": "<", "le": "<=", "gt": ">", "ge": ">=", "like": "LIKE"}
+
+var aggregates = map[string]string{"count": "COUNT(%s)", "count_distinct": "COUNT(DISTINCT %s)", "sum": "SUM(%s)", "avg": "AVG(%s)", "min": "MIN(%s)", "max": "MAX(%s)"}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,127}$`)
+
+// selectQuery holds the state of building the SQL of a select
+type selectQuery struct {
+	src        SelectSource
+	args       []interface{}
+	predicates int
+}
+
+// Query builds the parameterized SQL of the select. The caller arguments (referred to by From
+// and Where) come first and the select arguments are appended. One row more than the limit is
+// fetched to tell if there are more rows.
+func (src SelectSource) Query(s SelectSpec, args ...interface{}) (string, []interface{}, error) {
+	q := &selectQuery{src: src, args: args}
+	if s.Limit == 0 {
+		s.Limit = SelectLimit
+	}
+	if s.Limit < 1 || s.Limit > SelectMaxLimit {
+		return "", nil, fmt.Errorf("limit must be between 1 and %d", SelectMaxLimit)
+	}
+	if s.Offset < 0 {
+		return "", nil, fmt.Errorf("offset must be a positive integer")
+	}
+
+	var fields, group []string
+	names := make(map[string]string) // Names that may be sorted on => SQL
+	if len(s.GroupBy) > 0 || len(s.Aggregate) > 0 {
+		for _, name := range s.GroupBy {
+			col, err := q.column(name)
+			if err != nil {
+				return "", nil, err
+			}
+			fields, group = append(fields, col), append(group, col)
+			names[name] = col
+		}
+		for _, name := range s.Select {
+			if _, ok := names[name]; !ok {
+				return "", nil, fmt.Errorf("column [%s] must be grouped on to be selected", name)
+			}
+		}
+		for _, a := range s.Aggregate {
+			f, ok := aggregates[strings.ToLower(a.Func)]
+			if !ok {
+				return "", nil, fmt.Errorf("aggregate [%s] is not supported - use one of [count,count_distinct,sum,avg,min,max]", a.Func)
+			}
+			if !identifier.MatchString(a.As) {
+				return "", nil, fmt.Errorf("aggregate name [%s] must be an identifier", a.As)
+			}
+			if _, ok := names[a.As]; ok {
+				return "", nil, fmt.Errorf("aggregate name [%s] is already used", a.As)
+			}
+			col := "*"
+			if a.Column != "" || strings.ToLower(a.Func) != "count" {
+				var err error
+				if col, err = q.column(a.Column); err != nil {
+					return "", nil, err
+				}
+			}
+			fields = append(fields, fmt.Sprintf(f, col)+" AS "+quote(a.As))
+			names[a.As] = quote(a.As)
+		}
+	} else {
+		selected := s.Select
+		if len(selected) == 0 {
+			selected = src.Columns
+		}
+		for _, name := range selected {
+			col, err := q.column(name)
+			if err != nil {
+				return "", nil, err
+			}
+			fields = append(fields, col)
+		}
+		for _, name := range src.Columns {
+			names[name] = quote(name)
+		}
+	}
+
+	where := []string{"1 = 1"}
+	if src.Where != "" {
+		where = append(where, "("+src.Where+")")
+	}
+	if s.Where != nil {
+		filter, err := q.filter(*s.Where)
+		if err != nil {
+			return "", nil, err
+		}
+		where = append(where, filter)
+	}
+
+	var order []string
+	sorted := make(map[string]bool) // A column may only be sorted on once
+	sort := func(col, dir string) {
+		if !sorted[col] {
+			order, sorted[col] = append(order, col+dir), true
+		}
+	}
+	for _, o := range s.OrderBy {
+		col, ok := names[strings.TrimPrefix(o, "-")]
+		if !ok {
+			return "", nil, fmt.Errorf("cannot sort on [%s] - it is not selectable", o)
+		}
+		if strings.HasPrefix(o, "-") {
+			sort(col, " DESC")
+		} else {
+			sort(col, "")
+		}
+	}
+	// Paging requires a deterministic order
+	switch {
+	case len(group) > 0:
+		for _, col := range group {
+			sort(col, "")
+		}
+	case len(s.Aggregate) > 0:
+		sort("(SELECT NULL)", "")
+	case src.Key != "":
+		sort(quote(src.Key), "")
+	}
+
+	query := fmt.Sprintf(`
+    SELECT %s
+      FROM %s
+     WHERE %s`, strings.Join(fields, ", "), src.From, strings.Join(where, "\n       AND "))
+	if len(group) > 0 {
+		query += "\n     GROUP BY " + strings.Join(group, ", ")
+	}
+	query += fmt.Sprintf(`
+     ORDER BY %s
+    OFFSET %s ROWS FETCH NEXT %s ROWS ONLY`, strings.Join(order, ", "), q.arg(s.Offset), q.arg(s.Limit+1))
+	return query, q.args, nil
+}
+
+// Page turns the rows of the select query into the envelope
+func (s SelectSpec) Page(rows []interface{}) *SelectPage {
+	page := &SelectPage{Data: rows, Limit: s.Limit, Offset: s.Offset}
+	if page.Limit == 0 {
+		page.Limit = SelectLimit
+	}
+	if page.Data == nil {
+		page.Data = []interface{}{}
+	}
+	if len(page.Data) > page.Limit {
+		// The extra row tells there are more rows
+		page.Data, page.More = page.Data[:page.Limit], true
+	}
+	return page
+}
+
+func (q *selectQuery) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+// column validates the name against the columns of the source
+func (q *selectQuery) column(name string) (string, error) {
+	for _, col := range q.src.Columns {
+		if col == name {
+			return quote(col), nil
+		}
+	}
+	return "", fmt.Errorf("unknown column [%s]", name)
+}
+
+func (q *selectQuery) filter(f Filter) (string, error) {
+	if q.predicates++; q.predicates > selectMaxPredicates {
+		return "", fmt.Errorf("filter has more than %d predicates", selectMaxPredicates)
+	}
+	switch {
+	case len(f.And) > 0:
+		return q.combine(f.And, " AND ")
+	case len(f.Or) > 0:
+		return q.combine(f.Or, " OR ")
+	case f.Not != nil:
+		sql, err := q.filter(*f.Not)
+		return "NOT " + sql, err
+	}
+
+	col, err := q.column(f.Column)
+	if err != nil {
+		return "", err
+	}
+	op := strings.ToLower(f.Op)
+	if cmp, ok := comparisons[op]; ok {
+		v, err := scalar(f.Value)
+		if err != nil {
+			return "", fmt.Errorf("%s of [%s]: %s", op, f.Column, err)
+		}
+		return fmt.Sprintf("%s %s %s", col, cmp, q.arg(v)), nil
+	}
+	switch op {
+	case "null":
+		null, ok := f.Value.(bool)
+		if !ok {
+			return "", fmt.Errorf("null of [%s] must be true or false", f.Column)
+		}
+		if null {
+			return col + " IS NULL", nil
+		}
+		return col + " IS NOT NULL", nil
+	case "in", "between":
+		values, ok := f.Value.([]interface{})
+		if !ok || len(values) == 0 || (op == "between" && len(values) != 2) {
+			return "", fmt.Errorf("%s of [%s] must have an array value (of two values for between)", op, f.Column)
+		}
+		if len(values) > selectMaxPredicates {
+			return "", fmt.Errorf("in of [%s] has more than %d values", f.Column, selectMaxPredicates)
+		}
+		var in []string
+		for _, value := range values {
+			v, err := scalar(value)
+			if err != nil {
+				return "", fmt.Errorf("%s of [%s]: %s", op, f.Column, err)
+			}
+			in = append(in, q.arg(v))
+		}
+		if op == "between" {
+			return fmt.Sprintf("%s BETWEEN %s AND %s", col, in[0], in[1]), nil
+		}
+		return fmt.Sprintf("%s IN (%s)", col, strings.Join(in, ", ")), nil
+	}
+	return "", fmt.Errorf("operator [%s] is not supported - use one of [eq,ne,lt,le,gt,ge,like,in,between,null]", f.Op)
+}
+
+func (q *selectQuery) combine(filters []Filter, op string) (string, error) {
+	var sql []string
+	for _, f := range filters {
+		s, err := q.filter(f)
+		if err != nil {
+			return "", err
+		}
+		sql = append(sql, s)
+	}
+	return "(" + strings.Join(sql, op) + ")", nil
+}
+
+// scalar validates a filter value - JSON numbers without fraction become integers
+func scalar(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case string, bool:
+		return t, nil
+	case float64:
+		if t == math.Trunc(t) && math.Abs(t) < 1<<53 {
+			return int64(t), nil
+		}
+		return t, nil
+	case nil:
+		return nil, fmt.Errorf("value is missing (use the null operator for NULL)")
+	default:
+		return nil, fmt.Errorf("value [%v] must be a string, number or boolean", v)
+	}
+}
+
+// quote makes a column name a delimited identifier
+func quote(name string) string {
+	return "[" + strings.Replace(name, "]", "]]", -1) + "]"
+}
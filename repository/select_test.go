@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testSource = SelectSource{
+	From:    "[repo].[sales]",
+	Columns: []string{"dw_delivery_id", "dw_row_id", "customer", "amount", "date"},
+	Key:     "dw_row_id",
+}
+
+func spec(t *testing.T, js string) SelectSpec {
+	var s SelectSpec
+	if err := json.Unmarshal([]byte(js), &s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSelectQuery(t *testing.T) {
+	q, args, err := testSource.Query(spec(t, `{
+        "select": ["customer", "amount"],
+        "where": {"and": [{"column": "customer", "op": "in", "value": [42, "43"]},
+                          {"or": [{"column": "date", "op": "between", "value": ["2019-01-01", "2019-01-31"]},
+                                  {"not": {"column": "amount", "op": "null", "value": true}}]}]},
+        "order_by": ["-amount", "dw_row_id"], "limit": 10, "offset": 20}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"SELECT [customer], [amount]",
+		"FROM [repo].[sales]",
+		"AND ([customer] IN ($1, $2) AND ([date] BETWEEN $3 AND $4 OR NOT [amount] IS NULL))",
+		"ORDER BY [amount] DESC, [dw_row_id]\n",
+		"OFFSET $5 ROWS FETCH NEXT $6 ROWS ONLY",
+	} {
+		if !strings.Contains(q, s) {
+			t.Errorf("Expected [%s] in query %s", s, q)
+		}
+	}
+	expected := []interface{}{int64(42), "43", "2019-01-01", "2019-01-31", 20, 11}
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("Expected args %v, got %v", expected, args)
+	}
+}
+
+func TestSelectAggregate(t *testing.T) {
+	q, args, err := testSource.Query(spec(t, `{
+        "group_by": ["customer"],
+        "aggregate": [{"func": "sum", "column": "amount", "as": "total"}, {"func": "count", "as": "n"}],
+        "where": {"column": "amount", "op": "gt", "value": 1.5},
+        "order_by": ["-total"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"SELECT [customer], SUM([amount]) AS [total], COUNT(*) AS [n]",
+		"AND [amount] > $1",
+		"GROUP BY [customer]",
+		"ORDER BY [total] DESC, [customer]\n",
+	} {
+		if !strings.Contains(q, s) {
+			t.Errorf("Expected [%s] in query %s", s, q)
+		}
+	}
+	if args[0] != 1.5 || args[2] != SelectLimit+1 {
+		t.Errorf("Unexpected args %v", args)
+	}
+}
+
+func TestSelectWhere(t *testing.T) {
+	src := testSource
+	src.Where = "meta.user_access($1, 42, 'VIEW') > 0"
+	q, args, err := src.Query(spec(t, `{"where": {"column": "customer", "op": "eq", "value": "x"}}`), "system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(q, "(meta.user_access($1, 42, 'VIEW') > 0)\n       AND [customer] = $2") {
+		t.Errorf("Expected fixed predicate before filter in query %s", q)
+	}
+	if len(args) != 4 || args[0] != "system" || args[1] != "x" {
+		t.Errorf("Unexpected args %v", args)
+	}
+}
+
+func TestSelectInvalid(t *testing.T) {
+	for _, js := range []string{
+		`{"select": ["password"]}`,
+		`{"select": ["customer]; DROP TABLE x --"]}`,
+		`{"where": {"column": "customer", "op": "exec", "value": 1}}`,
+		`{"where": {"column": "customer", "op": "eq"}}`,
+		`{"where": {"column": "customer", "op": "eq", "value": {"a": 1}}}`,
+		`{"where": {"column": "customer", "op": "between", "value": [1]}}`,
+		`{"group_by": ["customer"], "select": ["amount"]}`,
+		`{"aggregate": [{"func": "sum", "column": "amount", "as": "x y"}]}`,
+		`{"aggregate": [{"func": "stdev", "column": "amount", "as": "x"}]}`,
+		`{"order_by": ["total"]}`,
+		`{"limit": 100000}`,
+		`{"offset": -1}`,
+	} {
+		if _, _, err := testSource.Query(spec(t, js)); err == nil {
+			t.Errorf("Expected error for %s", js)
+		}
+	}
+	deep := `{"column": "customer", "op": "eq", "value": 1}`
+	for i := 0; i < selectMaxPredicates; i++ {
+		deep = `{"not": ` + deep + `}`
+	}
+	if _, _, err := testSource.Query(spec(t, `{"where": `+deep+`}`)); err == nil {
+		t.Errorf("Expected error for too many predicates")
+	}
+}
+
+func TestSelectPage(t *testing.T) {
+	s := SelectSpec{Limit: 2}
+	page := s.Page([]interface{}{1, 2, 3})
+	if len(page.Data) != 2 || !page.More {
+		t.Errorf("Expected 2 rows and more, got %v", page)
+	}
+	page = s.Page(nil)
+	if len(page.Data) != 0 || page.More {
+		t.Errorf("Expected no rows, got %v", page)
+	}
+}
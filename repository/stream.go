@@ -0,0 +1,387 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rows iterates over a result set without loading it into memory. Values are typed as
+// by Query. Rows must be closed - cancelling the context of the query also ends it.
+type Rows interface {
+	Columns() []string
+	Next() bool
+	Values() []interface{}
+	Err() error
+	Close() error
+}
+
+type dbRows struct {
+	rows    *sql.Rows
+	cols    []string
+	decimal []bool
+	values  []interface{}
+	err     error
+}
+
+func newRows(ctx context.Context, q queryer, retry bool, query string, args ...interface{}) (Rows, error) {
+	rows, err := queryRows(ctx, q, retry, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		rows.Close()
+		log.Printf("Could not get columns from query [%s]: [%s]\n", query, err)
+		return nil, err
+	}
+	return &dbRows{rows: rows, cols: cols, decimal: decimals(rows)}, nil
+}
+
+func (r *dbRows) Columns() []string { return r.cols }
+
+func (r *dbRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+	t := make([]interface{}, len(r.cols))
+	p := make([]interface{}, len(r.cols))
+	for i := range t {
+		p[i] = &t[i]
+	}
+	if r.err = r.rows.Scan(p...); r.err != nil {
+		return false
+	}
+	for i := range t {
+		t[i] = value(t[i], i < len(r.decimal) && r.decimal[i])
+	}
+	r.values = t
+	return true
+}
+
+func (r *dbRows) Values() []interface{} { return r.values }
+
+func (r *dbRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+func (r *dbRows) Close() error { return r.rows.Close() }
+
+// sliceRows iterates over rows as returned by Query (maps of column => value)
+type sliceRows struct {
+	ctx  context.Context
+	rows []interface{}
+	cols []string
+	i    int
+}
+
+// NewSliceRows turns rows as returned by Query into Rows - columns are sorted by name
+func NewSliceRows(ctx context.Context, rows []interface{}) Rows {
+	r := &sliceRows{ctx: ctx, rows: rows, i: -1}
+	if len(rows) > 0 {
+		if m, ok := rows[0].(map[string]interface{}); ok {
+			for col := range m {
+				r.cols = append(r.cols, col)
+			}
+			sort.Strings(r.cols)
+		}
+	}
+	return r
+}
+
+func (r *sliceRows) Columns() []string { return r.cols }
+
+func (r *sliceRows) Next() bool {
+	if r.ctx.Err() != nil || r.i+1 >= len(r.rows) {
+		return false
+	}
+	r.i++
+	return true
+}
+
+func (r *sliceRows) Values() []interface{} {
+	m, _ := r.rows[r.i].(map[string]interface{})
+	values := make([]interface{}, len(r.cols))
+	for i, col := range r.cols {
+		values[i] = m[col]
+	}
+	return values
+}
+
+func (r *sliceRows) Err() error   { return r.ctx.Err() }
+func (r *sliceRows) Close() error { return nil }
+
+// orderedRows puts the columns of rows in order
+type orderedRows struct {
+	Rows
+	cols  []string
+	index []int
+}
+
+// OrderedRows returns rows with the columns listed in order first (in that order) followed by
+// the remaining columns. Columns in order but not in rows are ignored.
+func OrderedRows(rows Rows, order []string) Rows {
+	pos := make(map[string]int)
+	for i, col := range rows.Columns() {
+		pos[col] = i
+	}
+	r := &orderedRows{Rows: rows}
+	for _, col := range order {
+		if i, ok := pos[col]; ok {
+			r.cols = append(r.cols, col)
+			r.index = append(r.index, i)
+			delete(pos, col)
+		}
+	}
+	for i, col := range rows.Columns() {
+		if _, ok := pos[col]; ok {
+			r.cols = append(r.cols, col)
+			r.index = append(r.index, i)
+		}
+	}
+	return r
+}
+
+func (r *orderedRows) Columns() []string { return r.cols }
+
+func (r *orderedRows) Values() []interface{} {
+	values := r.Rows.Values()
+	ordered := make([]interface{}, len(r.index))
+	for i, j := range r.index {
+		ordered[i] = values[j]
+	}
+	return ordered
+}
+
+// Stream formats
+const (
+	FormatJson   = "json"
+	FormatNdjson = "ndjson"
+	FormatCsv    = "csv"
+	FormatXlsx   = "xlsx"
+)
+
+// Content types of the formats (for negotiation)
+var formatTypes = []struct{ format, contentType string }{
+	{FormatJson, "application/json"},
+	{FormatNdjson, "application/x-ndjson"},
+	{FormatCsv, "text/csv"},
+	{FormatXlsx, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+}
+
+// Negotiate picks the format from an Accept header honouring q-values - json if none match
+func Negotiate(accept string) string {
+	format, best := FormatJson, 0.0
+	for _, part := range strings.Split(accept, ",") {
+		fields := strings.Split(part, ";")
+		mime := strings.ToLower(strings.TrimSpace(fields[0]))
+		q := 1.0
+		for _, param := range fields[1:] {
+			if p := strings.TrimSpace(param); strings.HasPrefix(p, "q=") {
+				q, _ = strconv.ParseFloat(p[2:], 64)
+			}
+		}
+		for _, t := range formatTypes {
+			if mime == t.contentType && q > best {
+				format, best = t.format, q
+			}
+		}
+	}
+	return format
+}
+
+// RowWriter writes rows in a format incrementally
+type RowWriter interface {
+	Header(cols []string) error
+	Row(values []interface{}) error
+	// Flush writes buffered rows to the underlying writer
+	Flush() error
+	// Close ends the output (e.g. closing the JSON array)
+	Close() error
+	ContentType() string
+}
+
+// NewRowWriter creates the writer of format: json (array of objects), ndjson (an object per
+// line), csv (header line followed by a line per row separated by delimiter) or xlsx
+// (workbook with a single sheet)
+func NewRowWriter(format string, w io.Writer, delimiter rune) (RowWriter, error) {
+	switch strings.ToLower(format) {
+	case FormatJson, "":
+		return &jsonWriter{w: w}, nil
+	case FormatNdjson:
+		return &jsonWriter{w: w, lines: true}, nil
+	case FormatCsv:
+		c := csv.NewWriter(w)
+		if delimiter != 0 {
+			c.Comma = delimiter
+		}
+		return &csvWriter{w: c, header: true}, nil
+	case FormatXlsx:
+		return newXlsxWriter(w), nil
+	default:
+		return nil, fmt.Errorf("format [%s] is not supported - use one of [json,ndjson,csv,xlsx]", format)
+	}
+}
+
+type jsonWriter struct {
+	w     io.Writer
+	lines bool
+	cols  []string
+	count int
+}
+
+func (j *jsonWriter) ContentType() string {
+	if j.lines {
+		return "application/x-ndjson"
+	}
+	return "application/json"
+}
+
+func (j *jsonWriter) Header(cols []string) error {
+	j.cols = cols
+	if j.lines {
+		return nil
+	}
+	_, err := io.WriteString(j.w, "[")
+	return err
+}
+
+// Row writes the values as an object keeping the column order
+func (j *jsonWriter) Row(values []interface{}) error {
+	var b strings.Builder
+	if !j.lines && j.count > 0 {
+		b.WriteString(",")
+	}
+	b.WriteString("{")
+	for i, col := range j.cols {
+		k, _ := json.Marshal(col)
+		v, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.Write(k)
+		b.WriteString(":")
+		b.Write(v)
+	}
+	b.WriteString("}")
+	if j.lines {
+		b.WriteString("\n")
+	}
+	j.count++
+	_, err := io.WriteString(j.w, b.String())
+	return err
+}
+
+func (j *jsonWriter) Flush() error { return nil }
+
+func (j *jsonWriter) Close() error {
+	if j.lines {
+		return nil
+	}
+	_, err := io.WriteString(j.w, "]")
+	return err
+}
+
+type csvWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+// NoHeader makes a csv writer skip the header line (original files without header)
+func NoHeader(w RowWriter) RowWriter {
+	if c, ok := w.(*csvWriter); ok {
+		c.header = false
+	}
+	return w
+}
+
+func (c *csvWriter) ContentType() string { return "text/csv" }
+
+func (c *csvWriter) Header(cols []string) error {
+	if !c.header {
+		return nil
+	}
+	return c.w.Write(cols)
+}
+
+func (c *csvWriter) Row(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		record[i] = Text(v)
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// Text renders a value as text - NULL is empty and dates are YYYY-MM-DD[ HH:MI:SS]
+func Text(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case time.Time:
+		if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+			return t.Format("2006-01-02")
+		}
+		return t.Format("2006-01-02 15:04:05.999")
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(t), 'f', -1, 32)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Copy writes all rows and calls flush every flushrows rows (and at the end) so the output
+// reaches the client while the query is still running. Returns the number of rows written.
+func Copy(w RowWriter, rows Rows, flush func(), flushrows int) (count int64, err error) {
+	if err = w.Header(rows.Columns()); err != nil {
+		return 0, err
+	}
+	for rows.Next() {
+		if err = w.Row(rows.Values()); err != nil {
+			return count, err
+		}
+		if count++; flush != nil && flushrows > 0 && count%int64(flushrows) == 0 {
+			if err = w.Flush(); err != nil {
+				return count, err
+			}
+			flush()
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return count, err
+	}
+	if err = w.Close(); err != nil {
+		return count, err
+	}
+	if flush != nil {
+		flush()
+	}
+	return count, nil
+}
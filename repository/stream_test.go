@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRows(ctx context.Context) Rows {
+	return NewSliceRows(ctx, []interface{}{
+		map[string]interface{}{"id": int64(1), "name": "a;b", "date": time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), "amount": 1.5},
+		map[string]interface{}{"id": int64(2), "name": nil, "date": time.Date(2019, 3, 2, 10, 30, 0, 0, time.UTC), "amount": nil},
+	})
+}
+
+func TestCopy(t *testing.T) {
+	for format, expected := range map[string]string{
+		FormatJson:   `[{"amount":1.5,"date":"2019-03-01T00:00:00Z","id":1,"name":"a;b"},{"amount":null,"date":"2019-03-02T10:30:00Z","id":2,"name":null}]`,
+		FormatNdjson: "{\"amount\":1.5,\"date\":\"2019-03-01T00:00:00Z\",\"id\":1,\"name\":\"a;b\"}\n{\"amount\":null,\"date\":\"2019-03-02T10:30:00Z\",\"id\":2,\"name\":null}\n",
+		FormatCsv:    "amount;date;id;name\n1.5;2019-03-01;1;\"a;b\"\n;2019-03-02 10:30:00;2;\n",
+	} {
+		var b bytes.Buffer
+		w, err := NewRowWriter(format, &b, ';')
+		if err != nil {
+			t.Fatal(err)
+		}
+		flushes := 0
+		count, err := Copy(w, testRows(context.Background()), func() { flushes++ }, 1)
+		if err != nil || count != 2 || flushes != 3 {
+			t.Errorf("[%s] Expected 2 rows and 3 flushes, got [%d] [%d] [%v]", format, count, flushes, err)
+		}
+		if b.String() != expected {
+			t.Errorf("[%s] Unexpected output [%s]", format, b.String())
+		}
+	}
+	if _, err := NewRowWriter("xml", nil, 0); err == nil {
+		t.Error("Expected unsupported format")
+	}
+}
+
+func TestCopyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w, _ := NewRowWriter(FormatJson, &bytes.Buffer{}, 0)
+	if count, err := Copy(w, testRows(ctx), nil, 0); err != context.Canceled || count != 0 {
+		t.Errorf("Expected cancelled copy, got [%d] [%v]", count, err)
+	}
+}
+
+func TestXlsx(t *testing.T) {
+	var b bytes.Buffer
+	w, _ := NewRowWriter(FormatXlsx, &b, 0)
+	if _, err := Copy(w, OrderedRows(testRows(context.Background()), []string{"id", "name"}), nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	z, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sheet []byte
+	for _, f := range z.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			r, _ := f.Open()
+			sheet, _ = ioutil.ReadAll(r)
+		}
+	}
+	for _, s := range []string{
+		`<row><c t="inlineStr"><is><t xml:space="preserve">id</t></is></c>`,
+		`<row><c><v>1</v></c><c t="inlineStr"><is><t xml:space="preserve">a;b</t></is></c><c><v>1.5</v></c><c s="1"><v>43525</v></c></row>`,
+		`<c s="2"><v>43526.4375</v></c>`,
+	} {
+		if !strings.Contains(string(sheet), s) {
+			t.Errorf("Expected [%s] in sheet [%s]", s, sheet)
+		}
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	for accept, format := range map[string]string{
+		"":                                 FormatJson,
+		"text/html, */*":                   FormatJson,
+		"text/csv":                         FormatCsv,
+		"application/json;q=0.5, text/csv": FormatCsv,
+		"text/csv;q=0.2, application/x-ndjson;q=0.9":                        FormatNdjson,
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": FormatXlsx,
+	} {
+		if got := Negotiate(accept); got != format {
+			t.Errorf("Expected [%s] for [%s], got [%s]", format, accept, got)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Static parts of a workbook with a single sheet. Style 1 formats dates and 2 date/times.
+var xlsxParts = []struct{ name, content string }{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/><Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/></Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+	{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="data" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/></Relationships>`},
+	{"xl/styles.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><fonts count="1"><font/></fonts><fills count="1"><fill/></fills><borders count="1"><border/></borders><cellStyleXfs count="1"><xf/></cellStyleXfs><cellXfs count="3"><xf/><xf numFmtId="14" applyNumberFormat="1"/><xf numFmtId="22" applyNumberFormat="1"/></cellXfs></styleSheet>`},
+}
+
+// xlsxWriter streams the rows into the single sheet of a workbook. Numbers, booleans and
+// dates keep their type - everything else is written as (inline) text.
+type xlsxWriter struct {
+	zip   *zip.Writer
+	sheet *bufio.Writer
+}
+
+func newXlsxWriter(w io.Writer) *xlsxWriter {
+	return &xlsxWriter{zip: zip.NewWriter(w)}
+}
+
+func (x *xlsxWriter) ContentType() string {
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+
+func (x *xlsxWriter) Header(cols []string) error {
+	for _, part := range xlsxParts {
+		f, err := x.zip.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err = io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+	f, err := x.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	x.sheet = bufio.NewWriter(f)
+	x.sheet.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	values := make([]interface{}, len(cols))
+	for i, col := range cols {
+		values[i] = col
+	}
+	return x.Row(values)
+}
+
+func (x *xlsxWriter) Row(values []interface{}) error {
+	x.sheet.WriteString("<row>")
+	for _, v := range values {
+		switch t := v.(type) {
+		case nil:
+			x.sheet.WriteString("<c/>")
+		case int64, int32, int, float64, float32:
+			x.sheet.WriteString("<c><v>" + Text(t) + "</v></c>")
+		case Decimal:
+			if _, err := strconv.ParseFloat(string(t), 64); err == nil {
+				x.sheet.WriteString("<c><v>" + string(t) + "</v></c>")
+			} else {
+				x.text(string(t))
+			}
+		case bool:
+			b := "0"
+			if t {
+				b = "1"
+			}
+			x.sheet.WriteString(`<c t="b"><v>` + b + "</v></c>")
+		case time.Time:
+			style := "2"
+			if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+				style = "1"
+			}
+			x.sheet.WriteString(`<c s="` + style + `"><v>` + strconv.FormatFloat(serial(t), 'f', -1, 64) + "</v></c>")
+		default:
+			x.text(Text(t))
+		}
+	}
+	_, err := x.sheet.WriteString("</row>")
+	return err
+}
+
+func (x *xlsxWriter) text(s string) {
+	x.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+	xml.EscapeText(x.sheet, []byte(s))
+	x.sheet.WriteString("</t></is></c>")
+}
+
+func (x *xlsxWriter) Flush() error {
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.zip.Flush()
+}
+
+func (x *xlsxWriter) Close() error {
+	x.sheet.WriteString("</sheetData></worksheet>")
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.zip.Close()
+}
+
+// serial is the spreadsheet date: days since 1899-12-30 (local time of the value)
+func serial(t time.Time) float64 {
+	epoch := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	local := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	return local.Sub(epoch).Hours() / 24
+}
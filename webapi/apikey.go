@@ -0,0 +1,80 @@
+package webapi
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/kataras/iris"
+)
+
+// API keys look like dw_<prefix>_<secret> - the prefix identifies the key and only the
+// SHA-256 of the whole key is stored
+const (
+	apiKeyScheme       = "dw"
+	apiKeyPrefixBytes  = 6
+	apiKeySecretBytes  = 32
+	apiKeyHeader       = "X-API-Key"
+	apiKeyContextValue = "api_key_id"
+)
+
+// ApiKeyVerifier returns the username and key id of a (prefix, hash) of an API key - an
+// empty username if the key is unknown or expired
+type ApiKeyVerifier func(c iris.Context, prefix string, hash string) (username string, id int64, err error)
+
+var apiKeyVerifier ApiKeyVerifier
+
+// UseApiKeys lets requests authenticate by an API key (X-API-Key header or Authorization:
+// ApiKey <key>) verified by verify - instead of a token
+func UseApiKeys(verify ApiKeyVerifier) {
+	apiKeyVerifier = verify
+}
+
+// NewApiKey generates a key returning the key (shown once), its prefix and hash (stored)
+func NewApiKey() (key string, prefix string, hash string, err error) {
+	b := make([]byte, apiKeyPrefixBytes+apiKeySecretBytes)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	prefix = hex.EncodeToString(b[:apiKeyPrefixBytes])
+	key = apiKeyScheme + "_" + prefix + "_" + base64.RawURLEncoding.EncodeToString(b[apiKeyPrefixBytes:])
+	return key, prefix, HashApiKey(key), nil
+}
+
+// ParseApiKey returns the prefix and hash of a key
+func ParseApiKey(key string) (prefix string, hash string, err error) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyScheme || len(parts[1]) != 2*apiKeyPrefixBytes || parts[2] == "" {
+		return "", "", errors.New("malformed API key")
+	}
+	if _, err := hex.DecodeString(parts[1]); err != nil {
+		return "", "", errors.New("malformed API key")
+	}
+	return parts[1], HashApiKey(key), nil
+}
+
+// HashApiKey returns the SHA-256 (hex) of a key - keys are random, so no salt is needed
+func HashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// apiKey returns the API key of the request (if any)
+func apiKey(c iris.Context) string {
+	if key := c.GetHeader(apiKeyHeader); key != "" {
+		return key
+	}
+	if parts := strings.Fields(c.GetHeader("Authorization")); len(parts) == 2 && strings.ToLower(parts[0]) == "apikey" {
+		return parts[1]
+	}
+	return ""
+}
+
+// ApiKeyId returns the id of the API key the request is authenticated by - 0 if none
+func ApiKeyId(c iris.Context) int64 {
+	id, _ := c.Values().Get(apiKeyContextValue).(int64)
+	return id
+}
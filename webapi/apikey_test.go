@@ -0,0 +1,35 @@
+package webapi
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApiKey(t *testing.T) {
+	key, prefix, hash, err := NewApiKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(key, "dw_"+prefix+"_") || strings.Contains(hash, key) || len(hash) != 64 {
+		t.Errorf("Unexpected key [%s] prefix [%s] hash [%s]", key, prefix, hash)
+	}
+	p, h, err := ParseApiKey(key)
+	if err != nil || p != prefix || h != hash {
+		t.Errorf("Expected [%s] [%s] parsing [%s], got [%s] [%s] %v", prefix, hash, key, p, h, err)
+	}
+	other, _, _, _ := NewApiKey()
+	if other == key {
+		t.Errorf("Expected unique keys")
+	}
+	if _, h, _ := ParseApiKey(key[:len(key)-1] + "x"); h == hash {
+		t.Errorf("Expected other hash of a changed key")
+	}
+	if _, _, err := ParseApiKey("dw_" + prefix + "_a_b-c"); err != nil {
+		t.Errorf("Expected a secret with _ to be valid, got %v", err)
+	}
+	for _, bad := range []string{"", "dw", "dw_" + prefix, "xx_" + prefix + "_abc", "dw_zzzzzzzzzzzz_abc", "dw_" + prefix + "_", "dw_ab_abc"} {
+		if _, _, err := ParseApiKey(bad); err == nil {
+			t.Errorf("Expected [%s] to be malformed", bad)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package webapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"time"
+
+	"github.com/kataras/iris"
+	"github.com/kataras/iris/core/router"
+)
+
+// Bodies larger than this are recorded truncated
+const auditBodyLimit = 64 * 1024
+
+// AuditEntry is an API call as recorded in the audit trail
+type AuditEntry struct {
+	Username string
+	ApiKeyId int64
+	Ip       string
+	Method   string
+	Endpoint string
+	Path     string
+	Params   string
+	Status   int
+	Outcome  string
+	Duration time.Duration
+}
+
+// Auditor records an entry in the audit trail
+type Auditor func(entry AuditEntry)
+
+var auditor Auditor
+
+// Read-only routes recorded in the audit trail anyway (e.g. data downloads) - see Sensitive
+var sensitiveRoutes = make(map[string]bool)
+
+// Sensitive marks a read-only route as recorded in the audit trail
+func Sensitive(route *router.Route) *router.Route {
+	sensitiveRoutes[route.Method+" "+route.Path] = true
+	return route
+}
+
+// UseAudit records every call changing anything and every call of a sensitive route by
+// record - including calls denied by Auth or Identity
+func UseAudit(record Auditor) {
+	auditor = record
+}
+
+// Audit records the call in the audit trail (see UseAudit) once handled
+func Audit(c iris.Context) {
+	if auditor == nil || !audited(c) {
+		c.Next()
+		return
+	}
+	start := time.Now()
+	body, truncated := auditBody(c)
+	// Handlers abort the connection by panic(http.ErrAbortHandler) once the status is sent -
+	// the call is recorded as failed before the panic goes on
+	aborted := true
+	defer func() {
+		if aborted {
+			auditRecord(c, start, body, truncated, true)
+		}
+	}()
+	c.Next()
+	aborted = false
+	auditRecord(c, start, body, truncated, false)
+}
+
+// auditRecord passes the call to the auditor
+func auditRecord(c iris.Context, start time.Time, body []byte, truncated bool, aborted bool) {
+	entry := AuditEntry{
+		Username: Username(c),
+		ApiKeyId: ApiKeyId(c),
+		Ip:       c.RemoteAddr(),
+		Method:   c.Method(),
+		Path:     c.Path(),
+		Params:   auditParams(c.Request().URL.Query(), body, truncated),
+		Status:   c.GetStatusCode(),
+		Duration: time.Since(start),
+	}
+	if route := c.GetCurrentRoute(); route != nil {
+		entry.Endpoint = route.Path()
+	}
+	entry.Outcome = auditOutcome(entry.Status)
+	if aborted {
+		entry.Outcome = "failed"
+	}
+	auditor(entry)
+}
+
+// audited tells if the call changes anything or the route is sensitive
+func audited(c iris.Context) bool {
+	route := c.GetCurrentRoute()
+	return !readOnly(c) || (route != nil && sensitiveRoutes[route.Method()+" "+route.Path()])
+}
+
+// auditBody returns the body (at most auditBodyLimit) leaving it unread for the handlers
+func auditBody(c iris.Context) (body []byte, truncated bool) {
+	r := c.Request()
+	if r.Body == nil {
+		return nil, false
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, auditBodyLimit+1))
+	r.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+	if err != nil {
+		return nil, false
+	}
+	if len(body) > auditBodyLimit {
+		return body[:auditBodyLimit], true
+	}
+	return body, false
+}
+
+// auditParams returns the query parameters and body as JSON - a body not being JSON (or
+// truncated) is kept as a string
+func auditParams(query url.Values, body []byte, truncated bool) string {
+	params := make(map[string]interface{})
+	if len(query) > 0 {
+		params["query"] = query
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if !truncated && json.Valid(body) {
+			params["body"] = json.RawMessage(body)
+		} else {
+			params["body"] = string(body)
+		}
+	}
+	if truncated {
+		params["truncated"] = true
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	res, err := json.Marshal(params)
+	if err != nil {
+		return ""
+	}
+	return string(res)
+}
+
+// auditOutcome classifies the status of a call as success, denied or failed
+func auditOutcome(status int) string {
+	switch {
+	case status == iris.StatusUnauthorized || status == iris.StatusForbidden:
+		return "denied"
+	case status < 400:
+		return "success"
+	}
+	return "failed"
+}
@@ -0,0 +1,40 @@
+package webapi
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestAuditOutcome(t *testing.T) {
+	for status, outcome := range map[int]string{200: "success", 204: "success", 302: "success", 400: "failed", 401: "denied", 403: "denied", 404: "failed", 500: "failed"} {
+		if got := auditOutcome(status); got != outcome {
+			t.Errorf("Expected [%s] of status [%d], got [%s]", outcome, status, got)
+		}
+	}
+}
+
+func TestAuditParams(t *testing.T) {
+	query := url.Values{"grace": []string{"24"}}
+	tests := []struct {
+		body      string
+		truncated bool
+		expected  string
+	}{
+		{``, false, `{"query":{"grace":["24"]}}`},
+		{`{ "name": "READERS" }`, false, `{"body":{"name":"READERS"},"query":{"grace":["24"]}}`},
+		{`a;b`, false, `{"body":"a;b","query":{"grace":["24"]}}`},
+		{`{"name":`, true, `{"body":"{\"name\":","query":{"grace":["24"]},"truncated":true}`},
+	}
+	for _, test := range tests {
+		if got := auditParams(query, []byte(test.body), test.truncated); got != test.expected {
+			t.Errorf("Expected [%s] of [%s], got [%s]", test.expected, test.body, got)
+		}
+	}
+	if got := auditParams(nil, nil, false); got != "" {
+		t.Errorf("Expected no params, got [%s]", got)
+	}
+	if got := auditParams(nil, []byte(strings.Repeat(" ", 10)), false); got != "" {
+		t.Errorf("Expected no params of a blank body, got [%s]", got)
+	}
+}
@@ -1,127 +1,93 @@
 package webapi
 
 import (
-	"crypto/x509"
-	"encoding/json"
-	"encoding/pem"
-	"io/ioutil"
+	"errors"
 	"log"
-	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/gobuffalo/envy"
-	jwtmiddleware "github.com/iris-contrib/middleware/jwt"
+	"github.com/kataras/iris"
 )
 
-// Cache of key id --> decoded public keys
-var cached_public_key = make(map[string]interface{})
-
-// AzureAuth is a JWT handler for authenticating with Azure AD
-// (see https://github.com/iris-contrib/middleware/blob/master/jwt/_example/main.go)
-var AzureAuth = jwtmiddleware.New(jwtmiddleware.Config{
-
-	// Func for retrieving and caching keys (involves 2 roundtrips)
-	ValidationKeyGetter: GetValidationKeyFromAzure,
-
-	// Func for veryfying using signing method
-	SigningMethod: jwt.SigningMethodRS256,
-}).Serve
-
-func GetValidationKeyFromAzure(token *jwt.Token) (interface{}, error) {
-
-	// Check if key id present
-	if token.Header["kid"] == nil {
-		// Authenticate using own secret (test only)
-		secret := envy.Get("JWTSECRET", "")
-		if secret != "" {
-			return []byte(secret), nil
-		}
-		// Bail out - no key available
-		log.Println("No key id in token header")
-		return nil, nil
-	}
-
-	// Get the key id from header
-	kid := token.Header["kid"].(string)
-
-	// Check if key is cached
-	// TODO: Need mechanism for refresh cache (timeout)
-	if cached_public_key[kid] != nil {
-		return cached_public_key[kid], nil
+// Auth returns the handler authenticating requests by the bearer token of the Authorization
+// header. Tokens are verified by the OIDC provider of OIDC_DISCOVERY (default Azure AD of
+// TENANTID) - or by the shared JWTSECRET (HS256 without key id - test only). The verified
+// token is kept in the context values as jwt (see Identity). Requests with an API key are
+// left to Identity.
+func Auth() iris.Handler {
+	discovery := envy.Get("OIDC_DISCOVERY", "")
+	if tenant := envy.Get("TENANTID", ""); discovery == "" && tenant != "" {
+		discovery = "https://login.microsoftonline.com/" + tenant + "/.well-known/openid-configuration"
 	}
-
-	// Init URL with authorization links         <------- Tenant ------->
-	url := "https://login.microsoftonline.com/" + envy.Get("USEAUTH", "") + "/.well-known/openid-configuration"
-
-	// Python inspired method
-	// -----------------------------
-	// Retrieve redirect uri to jwks
-	jwk_uri, err := getKeyValues(url)
-	if err != nil {
-		return nil, err
+	secret := envy.Get("JWTSECRET", "")
+	if discovery == "" && secret == "" {
+		log.Fatal("Authentication requires OIDC_DISCOVERY, TENANTID or JWTSECRET")
 	}
 
-	// Retrieve the jwk keys in redirect uri
-	jwk_keys, err := getKeyValues(jwk_uri["jwks_uri"].(string))
-	if err != nil {
-		return nil, err
-	}
-	// Find the token key id (kid) in the jwk keys and set x5c accordingly
-	x5c := ""
-	for _, hash := range jwk_keys["keys"].([]interface{}) {
-		key := hash.(map[string]interface{})
-		if key["kid"].(string) == kid {
-			vals := key["x5c"].([]interface{})
-			x5c = vals[0].(string)
+	var verifier *OIDCVerifier
+	if discovery != "" {
+		cfg := OIDCConfig{Discovery: discovery, Issuer: envy.Get("OIDC_ISSUER", "")}
+		for _, aud := range strings.Split(envy.Get("OIDC_AUDIENCE", ""), ",") {
+			if aud = strings.TrimSpace(aud); aud != "" {
+				cfg.Audience = append(cfg.Audience, aud)
+			}
 		}
+		if len(cfg.Audience) == 0 {
+			// Tokens issued by the provider to any other application would be accepted
+			log.Fatal("Authentication by OIDC requires OIDC_AUDIENCE")
+		}
+		ttl, _ := strconv.Atoi(envy.Get("JWKS_TTL", "3600"))
+		cfg.TTL = time.Duration(ttl) * time.Second
+		log.Printf("Use OIDC provider [%s]\n", discovery)
+		verifier = NewOIDCVerifier(cfg)
 	}
 
-	// Create PEM structure for decoding
-	cert := "-----BEGIN CERTIFICATE-----\n" + x5c + "\n-----END CERTIFICATE-----\n"
-	//log.Println(cert)
-
-	// Decode the PEM structure into x509 encoded certificate
-	block, _ := pem.Decode([]byte(cert))
-	if block == nil {
-		return "Panic - could not pem.Decore certificate", nil
-	}
-
-	// Parse the x509 encoded certificate
-	cert_parsed, err := x509.ParseCertificate(block.Bytes)
-	if err != nil {
-		log.Println(err)
-		return "", err
+	return func(c iris.Context) {
+		if apiKey(c) != "" {
+			// Verified by Identity
+			c.Next()
+			return
+		}
+		raw, err := bearer(c.GetHeader("Authorization"))
+		var token *jwt.Token
+		if err == nil {
+			token, err = verify(raw, verifier, secret)
+		}
+		if err != nil {
+			log.Printf("Authentication failed: [%s]\n", err)
+			c.StopExecution()
+			c.StatusCode(iris.StatusUnauthorized)
+			return
+		}
+		c.Values().Set("jwt", token)
+		c.Next()
 	}
-
-	// Return public key part of the certificate for auth handler
-	public_key := cert_parsed.PublicKey
-	cached_public_key[kid] = public_key
-
-	log.Println(public_key)
-	return public_key, nil
 }
 
-func getKeyValues(url string) (map[string]interface{}, error) {
-	var netClient = &http.Client{
-		Timeout: time.Second * 10,
+// bearer returns the token of an Authorization header
+func bearer(header string) (string, error) {
+	parts := strings.Fields(header)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return "", errors.New("no bearer token in Authorization header")
 	}
+	return parts[1], nil
+}
 
-	log.Printf("Contacting [%s]...\n", url)
-	res, err := netClient.Get(url)
+// verify verifies tokens with a key id by the OIDC provider and tokens without by the secret
+func verify(raw string, verifier *OIDCVerifier, secret string) (*jwt.Token, error) {
+	token, _, err := new(jwt.Parser).ParseUnverified(raw, jwt.MapClaims{})
 	if err != nil {
 		return nil, err
 	}
-	jsondata, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	keys := make(map[string]interface{})
-	err = json.Unmarshal(jsondata, &keys)
-	if err != nil {
-		return nil, err
+	if _, ok := token.Header["kid"]; ok || secret == "" {
+		if verifier == nil {
+			return nil, errors.New("no OIDC provider for tokens with key id")
+		}
+		return verifier.Verify(raw)
 	}
-
-	return keys, nil
+	parser := jwt.Parser{ValidMethods: []string{jwt.SigningMethodHS256.Alg()}}
+	return parser.Parse(raw, func(*jwt.Token) (interface{}, error) { return []byte(secret), nil })
 }
@@ -7,9 +7,11 @@ import (
 )
 
 var AzureCORS = cors.New(cors.Options{
-	AllowedOrigins:   []string{envy.Get("USECORS", "")},
-	AllowedMethods:   []string{iris.MethodGet, iris.MethodPost, iris.MethodPut, iris.MethodPatch, iris.MethodDelete},
-	AllowedHeaders:   []string{"*"},
+	AllowedOrigins: []string{envy.Get("USECORS", "")},
+	AllowedMethods: []string{iris.MethodGet, iris.MethodPost, iris.MethodPut, iris.MethodPatch, iris.MethodDelete},
+	AllowedHeaders: []string{"*"},
+	// Downloads describe the data returned in headers
+	ExposedHeaders:   []string{"Content-Disposition", "X-Delivery-Id", "X-Delivery-Name", "X-Delivery-Createdtm", "X-Delivery-Status-Date", "X-Max-Age-Days"},
 	AllowCredentials: true,
 	//Debug:            true,
 })
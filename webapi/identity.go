@@ -0,0 +1,126 @@
+package webapi
+
+import (
+	"log"
+	"strings"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gobuffalo/envy"
+	"github.com/kataras/iris"
+	"github.com/kataras/iris/core/router"
+)
+
+// Keys of the identity in the context values
+const (
+	usernameKey = "username"
+	claimsKey   = "claims"
+)
+
+// Claims holding the username (in order of preference) - USERCLAIM overrides
+var usernameClaims = []string{"unique_name", "preferred_username", "upn", "email", "sub"}
+
+// Routes not changing anything though not GET (e.g. POST of a query) - see Safe
+var safeRoutes = make(map[string]bool)
+
+// Safe marks a route as read-only, so it is allowed to anonymous users
+func Safe(route *router.Route) *router.Route {
+	safeRoutes[route.Method+" "+route.Path] = true
+	return route
+}
+
+// Identity resolves the user of a request: the service account of the API key (see
+// UseApiKeys), the principal of the token (see Auth) or - without authentication - the
+// ANONYMOUS user. Anonymous users are read-only (only GET and safe routes) unless
+// ANONYMOUS_READONLY=no. Requests without any of them are unauthorized.
+func Identity(c iris.Context) {
+	if key := apiKey(c); key != "" {
+		identifyApiKey(c, key)
+		return
+	}
+	if token, ok := c.Values().Get("jwt").(*jwt.Token); ok && token != nil {
+		claims, _ := token.Claims.(jwt.MapClaims)
+		username := claimString(claims, usernameClaims...)
+		if claim := envy.Get("USERCLAIM", ""); claim != "" {
+			username = claimString(claims, claim)
+		}
+		if username == "" {
+			log.Printf("No username claim in token [%v]\n", claims)
+			c.StopExecution()
+			c.StatusCode(iris.StatusUnauthorized)
+			return
+		}
+		c.Values().Set(usernameKey, username)
+		c.Values().Set(claimsKey, claims)
+		c.Next()
+		return
+	}
+
+	username := envy.Get("ANONYMOUS", "")
+	if username == "" {
+		c.StopExecution()
+		c.StatusCode(iris.StatusUnauthorized)
+		return
+	}
+	if !readOnly(c) && envy.Get("ANONYMOUS_READONLY", "yes") != "no" {
+		c.StopExecution()
+		c.StatusCode(iris.StatusForbidden)
+		c.WriteString("Anonymous access is read-only")
+		return
+	}
+	c.Values().Set(usernameKey, username)
+	c.Next()
+}
+
+// identifyApiKey resolves the service account of an API key
+func identifyApiKey(c iris.Context, key string) {
+	prefix, hash, err := ParseApiKey(key)
+	var username string
+	var id int64
+	if err == nil && apiKeyVerifier != nil {
+		username, id, err = apiKeyVerifier(c, prefix, hash)
+		if err != nil {
+			log.Printf("API key [%s] verification failed: [%s]\n", prefix, err)
+			c.StopExecution()
+			c.StatusCode(iris.StatusInternalServerError)
+			return
+		}
+	}
+	if username == "" {
+		c.StopExecution()
+		c.StatusCode(iris.StatusUnauthorized)
+		return
+	}
+	c.Values().Set(usernameKey, username)
+	c.Values().Set(apiKeyContextValue, id)
+	c.Next()
+}
+
+func readOnly(c iris.Context) bool {
+	switch c.Method() {
+	case iris.MethodGet, iris.MethodHead, iris.MethodOptions:
+		return true
+	}
+	route := c.GetCurrentRoute()
+	return route != nil && safeRoutes[route.Method()+" "+route.Path()]
+}
+
+// Username of the request (see Identity)
+func Username(c iris.Context) string {
+	return c.Values().GetString(usernameKey)
+}
+
+// Claims of the token of the request - nil if anonymous
+func Claims(c iris.Context) jwt.MapClaims {
+	claims, _ := c.Values().Get(claimsKey).(jwt.MapClaims)
+	return claims
+}
+
+// claimString returns the first of the claims which is a non-empty string
+func claimString(claims jwt.MapClaims, names ...string) string {
+	for _, name := range names {
+		if s, ok := claims[name].(string); ok && strings.TrimSpace(s) != "" {
+			return s
+		}
+	}
+	return ""
+}
@@ -38,6 +38,9 @@ func ApiParty(app *iris.Application) (api router.Party) {
 	// All endpoints return application/json
 	api.Use(func(c iris.Context) { c.ContentType("application/json"); c.Next() })
 
+	// Record changes and sensitive reads - also when denied by authentication
+	api.Use(Audit)
+
 	if cors_value := envy.Get("USECORS", ""); cors_value != "" {
 		log.Printf("Use CORS (%s)\n", cors_value)
 		api.Use(AzureCORS)
@@ -47,8 +50,15 @@ func ApiParty(app *iris.Application) (api router.Party) {
 	if envy.Get("USEAUTH", "") != "" {
 		// Register api prefix using authentication
 		log.Println("Use Authentication")
-		api.Use(AzureAuth)
+		api.Use(Auth())
+	} else if username := envy.Get("ANONYMOUS", ""); username != "" {
+		log.Printf("Use anonymous access as [%s]\n", username)
+	} else {
+		log.Fatal("Neither USEAUTH nor ANONYMOUS set - set ANONYMOUS to run without authentication")
 	}
 
+	// Resolve the user of every request
+	api.Use(Identity)
+
 	return api
 }
@@ -0,0 +1,306 @@
+package webapi
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// Signing methods accepted by the verifier
+var oidcMethods = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}
+
+// OIDCConfig configures an OIDC verifier
+type OIDCConfig struct {
+	// URL of the provider's .well-known/openid-configuration
+	Discovery string
+	// Expected issuer (iss) - the issuer of the discovery document if empty
+	Issuer string
+	// Accepted audiences (aud) - any audience if empty
+	Audience []string
+	// Time keys are cached before they are fetched again (default 1 hour)
+	TTL time.Duration
+	// Minimum time between fetches - of expired keys or for unknown key ids (default 1 minute)
+	MinRefresh time.Duration
+	// Client used to contact the provider (default 10 seconds timeout)
+	Client *http.Client
+}
+
+// OIDCVerifier verifies tokens issued by an OpenID Connect provider. The signing keys (JWKS)
+// are cached for the TTL and fetched again when a token refers to an unknown key id (key
+// rotation). It is safe for concurrent use.
+type OIDCVerifier struct {
+	cfg        OIDCConfig
+	mu         sync.Mutex
+	issuer     string
+	keys       map[string]interface{}
+	fetched    time.Time
+	tried      time.Time
+	failed     error
+	refreshing chan struct{}
+}
+
+// NewOIDCVerifier creates a verifier - the provider is contacted on the first verification
+func NewOIDCVerifier(cfg OIDCConfig) *OIDCVerifier {
+	if cfg.TTL <= 0 {
+		cfg.TTL = time.Hour
+	}
+	if cfg.MinRefresh <= 0 {
+		cfg.MinRefresh = time.Minute
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &OIDCVerifier{cfg: cfg}
+}
+
+// Verify parses the raw token checking signature (RS256 or ES256), expiry, issuer and audience
+func (v *OIDCVerifier) Verify(raw string) (*jwt.Token, error) {
+	parser := jwt.Parser{ValidMethods: oidcMethods}
+	token, err := parser.Parse(raw, v.keyfunc)
+	if err != nil {
+		return nil, err
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("token has no expiry")
+	}
+	v.mu.Lock()
+	issuer := v.issuer
+	v.mu.Unlock()
+	if !claims.VerifyIssuer(issuer, true) {
+		return nil, fmt.Errorf("invalid issuer [%v]", claims["iss"])
+	}
+	if !audience(claims["aud"], v.cfg.Audience) {
+		return nil, fmt.Errorf("invalid audience [%v]", claims["aud"])
+	}
+	return token, nil
+}
+
+// keyfunc returns the key of the token's key id
+func (v *OIDCVerifier) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("no key id in token header")
+	}
+	key, err := v.key(kid)
+	if err != nil {
+		return nil, err
+	}
+	// The key must fit the method (no RSA key used as HMAC secret etc.)
+	switch key.(type) {
+	case *rsa.PublicKey:
+		if token.Method != jwt.SigningMethodRS256 {
+			return nil, fmt.Errorf("method [%s] does not fit RSA key [%s]", token.Method.Alg(), kid)
+		}
+	case *ecdsa.PublicKey:
+		if token.Method != jwt.SigningMethodES256 {
+			return nil, fmt.Errorf("method [%s] does not fit EC key [%s]", token.Method.Alg(), kid)
+		}
+	}
+	return key, nil
+}
+
+// key returns the cached key - the keys are fetched when expired or when the key id is unknown,
+// at most every MinRefresh. Only one fetch runs at a time (outside the lock) and the others
+// wait for it. Known keys are kept while the provider is unavailable.
+func (v *OIDCVerifier) key(kid string) (interface{}, error) {
+	v.mu.Lock()
+	key, ok := v.keys[kid]
+	now := time.Now()
+	if ok && now.Sub(v.fetched) <= v.cfg.TTL {
+		v.mu.Unlock()
+		return key, nil
+	}
+	done := v.refreshing
+	if done == nil {
+		if now.Sub(v.tried) <= v.cfg.MinRefresh {
+			failed := v.failed
+			v.mu.Unlock()
+			if ok {
+				return key, nil
+			}
+			if failed != nil {
+				return nil, failed
+			}
+			return nil, fmt.Errorf("unknown key id [%s]", kid)
+		}
+		done = make(chan struct{})
+		v.refreshing = done
+		v.tried = now
+		v.mu.Unlock()
+		v.refresh(done)
+	} else {
+		v.mu.Unlock()
+		<-done
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if fresh, found := v.keys[kid]; found {
+		return fresh, nil
+	}
+	if v.failed != nil {
+		if ok {
+			return key, nil
+		}
+		return nil, v.failed
+	}
+	return nil, fmt.Errorf("unknown key id [%s]", kid)
+}
+
+// refresh fetches the keys and releases the verifications waiting for done
+func (v *OIDCVerifier) refresh(done chan struct{}) {
+	issuer, keys, err := v.fetch()
+	v.mu.Lock()
+	if err == nil {
+		v.issuer, v.keys, v.fetched = issuer, keys, time.Now()
+	}
+	v.failed = err
+	v.refreshing = nil
+	v.mu.Unlock()
+	close(done)
+}
+
+// fetch reads the discovery document and the keys of the provider
+func (v *OIDCVerifier) fetch() (string, map[string]interface{}, error) {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JwksURI string `json:"jwks_uri"`
+	}
+	if err := v.get(v.cfg.Discovery, &discovery); err != nil {
+		return "", nil, err
+	}
+	if discovery.JwksURI == "" {
+		return "", nil, fmt.Errorf("no jwks_uri in [%s]", v.cfg.Discovery)
+	}
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := v.get(discovery.JwksURI, &jwks); err != nil {
+		return "", nil, err
+	}
+	keys := make(map[string]interface{})
+	for _, k := range jwks.Keys {
+		if key, err := k.publicKey(); err == nil && k.Kid != "" && (k.Use == "" || k.Use == "sig") {
+			keys[k.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return "", nil, fmt.Errorf("no usable signing keys in [%s]", discovery.JwksURI)
+	}
+	issuer := v.cfg.Issuer
+	if issuer == "" {
+		issuer = discovery.Issuer
+	}
+	return issuer, keys, nil
+}
+
+func (v *OIDCVerifier) get(url string, dest interface{}) error {
+	res, err := v.cfg.Client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("[%s] returned [%s]", url, res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(dest)
+}
+
+// jwk is a JSON web key (RSA, EC P-256 or an X.509 certificate chain)
+type jwk struct {
+	Kid string   `json:"kid"`
+	Kty string   `json:"kty"`
+	Use string   `json:"use"`
+	N   string   `json:"n"`
+	E   string   `json:"e"`
+	Crv string   `json:"crv"`
+	X   string   `json:"x"`
+	Y   string   `json:"y"`
+	X5c []string `json:"x5c"`
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	switch {
+	case k.Kty == "RSA" && k.N != "" && k.E != "":
+		n, err := base64Int(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64Int(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case k.Kty == "EC" && k.Crv == "P-256":
+		x, err := base64Int(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64Int(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return nil, fmt.Errorf("key [%s] is not on curve P-256", k.Kid)
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	case len(k.X5c) > 0:
+		der, err := base64.StdEncoding.DecodeString(k.X5c[0])
+		if err != nil {
+			return nil, err
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	}
+	return nil, fmt.Errorf("key [%s] of type [%s] is not supported", k.Kid, k.Kty)
+}
+
+func base64Int(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// audience checks the aud claim (string or array) against the accepted audiences
+func audience(aud interface{}, accepted []string) bool {
+	if len(accepted) == 0 {
+		return true
+	}
+	var auds []string
+	switch a := aud.(type) {
+	case string:
+		auds = []string{a}
+	case []interface{}:
+		for _, e := range a {
+			if s, ok := e.(string); ok {
+				auds = append(auds, s)
+			}
+		}
+	}
+	for _, a := range auds {
+		for _, b := range accepted {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
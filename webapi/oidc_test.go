@@ -0,0 +1,299 @@
+package webapi
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// stubIdP serves a discovery document and the public keys of its signing keys
+type stubIdP struct {
+	*httptest.Server
+	mu      sync.Mutex
+	rsa     map[string]*rsa.PrivateKey
+	ec      map[string]*ecdsa.PrivateKey
+	fetches int32
+}
+
+func newStubIdP(t *testing.T) *stubIdP {
+	idp := &stubIdP{rsa: make(map[string]*rsa.PrivateKey), ec: make(map[string]*ecdsa.PrivateKey)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"issuer": idp.URL, "jwks_uri": idp.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&idp.fetches, 1)
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		b64 := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+		var keys []map[string]string
+		for kid, k := range idp.rsa {
+			keys = append(keys, map[string]string{"kid": kid, "kty": "RSA", "use": "sig",
+				"n": b64(k.N), "e": b64(big.NewInt(int64(k.E)))})
+		}
+		for kid, k := range idp.ec {
+			keys = append(keys, map[string]string{"kid": kid, "kty": "EC", "use": "sig", "crv": "P-256",
+				"x": b64(k.X), "y": b64(k.Y)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	})
+	idp.Server = httptest.NewServer(mux)
+	return idp
+}
+
+func (idp *stubIdP) addRSA(t *testing.T, kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp.mu.Lock()
+	idp.rsa[kid] = key
+	idp.mu.Unlock()
+}
+
+func (idp *stubIdP) addEC(t *testing.T, kid string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp.mu.Lock()
+	idp.ec[kid] = key
+	idp.mu.Unlock()
+}
+
+// claims returns valid claims of the IdP which may be changed
+func (idp *stubIdP) claims() jwt.MapClaims {
+	return jwt.MapClaims{"iss": idp.URL, "aud": "dw", "sub": "someone", "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+func (idp *stubIdP) sign(t *testing.T, kid string, claims jwt.MapClaims) string {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	var token *jwt.Token
+	var key interface{}
+	if k, ok := idp.rsa[kid]; ok {
+		token, key = jwt.NewWithClaims(jwt.SigningMethodRS256, claims), k
+	} else {
+		token, key = jwt.NewWithClaims(jwt.SigningMethodES256, claims), idp.ec[kid]
+	}
+	token.Header["kid"] = kid
+	raw, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func (idp *stubIdP) verifier() *OIDCVerifier {
+	return NewOIDCVerifier(OIDCConfig{
+		Discovery:  idp.URL + "/.well-known/openid-configuration",
+		Audience:   []string{"dw"},
+		MinRefresh: time.Millisecond,
+	})
+}
+
+func TestOIDCVerify(t *testing.T) {
+	idp := newStubIdP(t)
+	defer idp.Close()
+	idp.addRSA(t, "rsa1")
+	idp.addEC(t, "ec1")
+	v := idp.verifier()
+
+	for _, kid := range []string{"rsa1", "ec1"} {
+		token, err := v.Verify(idp.sign(t, kid, idp.claims()))
+		if err != nil {
+			t.Fatalf("Expected %s token to verify: %s", kid, err)
+		}
+		if token.Claims.(jwt.MapClaims)["sub"] != "someone" {
+			t.Errorf("Unexpected claims %v", token.Claims)
+		}
+	}
+	claims := idp.claims()
+	claims["aud"] = []interface{}{"other", "dw"}
+	if _, err := v.Verify(idp.sign(t, "rsa1", claims)); err != nil {
+		t.Errorf("Expected audience in array to verify: %s", err)
+	}
+	if n := atomic.LoadInt32(&idp.fetches); n != 1 {
+		t.Errorf("Expected keys to be cached, fetched %d times", n)
+	}
+}
+
+func TestOIDCInvalid(t *testing.T) {
+	idp := newStubIdP(t)
+	defer idp.Close()
+	idp.addRSA(t, "rsa1")
+	v := idp.verifier()
+
+	invalid := map[string]func(jwt.MapClaims){
+		"audience": func(c jwt.MapClaims) { c["aud"] = "other" },
+		"issuer":   func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" },
+		"expired":  func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"no exp":   func(c jwt.MapClaims) { delete(c, "exp") },
+	}
+	for name, change := range invalid {
+		claims := idp.claims()
+		change(claims)
+		if _, err := v.Verify(idp.sign(t, "rsa1", claims)); err == nil {
+			t.Errorf("Expected %s to fail", name)
+		}
+	}
+
+	// Tampered payload
+	parts := strings.Split(idp.sign(t, "rsa1", idp.claims()), ".")
+	other := strings.Split(idp.sign(t, "rsa1", jwt.MapClaims{"iss": idp.URL, "aud": "dw", "sub": "admin",
+		"exp": time.Now().Add(time.Hour).Unix()}), ".")
+	if _, err := v.Verify(parts[0] + "." + other[1] + "." + parts[2]); err == nil {
+		t.Errorf("Expected tampered token to fail")
+	}
+
+	// No key id, unknown key id and HMAC signed by the public key (algorithm confusion)
+	noKid := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.claims())
+	raw, _ := noKid.SignedString(idp.rsa["rsa1"])
+	if _, err := v.Verify(raw); err == nil || !strings.Contains(err.Error(), "no key id") {
+		t.Errorf("Expected no key id to fail, got %v", err)
+	}
+	unknown := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.claims())
+	unknown.Header["kid"] = "nope"
+	raw, _ = unknown.SignedString(idp.rsa["rsa1"])
+	if _, err := v.Verify(raw); err == nil || !strings.Contains(err.Error(), "unknown key id") {
+		t.Errorf("Expected unknown key id to fail, got %v", err)
+	}
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, idp.claims())
+	hmac.Header["kid"] = "rsa1"
+	raw, _ = hmac.SignedString(idp.rsa["rsa1"].PublicKey.N.Bytes())
+	if _, err := v.Verify(raw); err == nil {
+		t.Errorf("Expected HS256 to fail")
+	}
+}
+
+func TestOIDCRotation(t *testing.T) {
+	idp := newStubIdP(t)
+	defer idp.Close()
+	idp.addRSA(t, "rsa1")
+	v := idp.verifier()
+	if _, err := v.Verify(idp.sign(t, "rsa1", idp.claims())); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new key is fetched when a token refers to it (after MinRefresh)
+	idp.addEC(t, "ec2")
+	time.Sleep(2 * time.Millisecond)
+	if _, err := v.Verify(idp.sign(t, "ec2", idp.claims())); err != nil {
+		t.Fatalf("Expected rotated key to verify: %s", err)
+	}
+	if n := atomic.LoadInt32(&idp.fetches); n != 2 {
+		t.Errorf("Expected keys fetched twice, got %d", n)
+	}
+
+	// Unknown key ids do not refetch more often than MinRefresh
+	v.cfg.MinRefresh = time.Hour
+	unknown := idp.claims()
+	for i := 0; i < 3; i++ {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, unknown)
+		token.Header["kid"] = "nope"
+		raw, _ := token.SignedString(idp.rsa["rsa1"])
+		v.Verify(raw)
+	}
+	if n := atomic.LoadInt32(&idp.fetches); n != 2 {
+		t.Errorf("Expected no refetch for unknown keys within MinRefresh, got %d fetches", n)
+	}
+
+	// Expired keys are fetched again - removed keys are no longer accepted
+	idp.mu.Lock()
+	old := idp.rsa["rsa1"]
+	delete(idp.rsa, "rsa1")
+	idp.mu.Unlock()
+	v.mu.Lock()
+	v.fetched = time.Now().Add(-2 * time.Hour)
+	v.tried = v.fetched
+	v.mu.Unlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.claims())
+	token.Header["kid"] = "rsa1"
+	raw, _ := token.SignedString(old)
+	if _, err := v.Verify(raw); err == nil {
+		t.Errorf("Expected removed key to fail after TTL")
+	}
+}
+
+func TestOIDCConcurrent(t *testing.T) {
+	idp := newStubIdP(t)
+	defer idp.Close()
+	idp.addRSA(t, "rsa1")
+	idp.addEC(t, "ec1")
+	v := idp.verifier()
+	tokens := []string{idp.sign(t, "rsa1", idp.claims()), idp.sign(t, "ec1", idp.claims())}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(raw string) {
+			defer wg.Done()
+			if _, err := v.Verify(raw); err != nil {
+				errs <- err
+			}
+		}(tokens[i%2])
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+	if n := atomic.LoadInt32(&idp.fetches); n != 1 {
+		t.Errorf("Expected keys fetched once, got %d", n)
+	}
+}
+
+func TestOIDCUnavailable(t *testing.T) {
+	idp := newStubIdP(t)
+	defer idp.Close()
+	idp.addRSA(t, "rsa1")
+	v := idp.verifier()
+	v.cfg.MinRefresh = time.Hour
+	raw := idp.sign(t, "rsa1", idp.claims())
+	if _, err := v.Verify(raw); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expired keys are kept while the provider is down - and fetched at most every MinRefresh
+	idp.Close()
+	v.mu.Lock()
+	v.fetched = time.Now().Add(-2 * time.Hour)
+	v.tried = v.fetched
+	v.mu.Unlock()
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := v.Verify(raw); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("Expected cached key while provider is down: %s", err)
+	}
+	v.mu.Lock()
+	tried, failed := v.tried, v.failed
+	v.mu.Unlock()
+	if failed == nil || time.Since(tried) > time.Minute {
+		t.Errorf("Expected a failed fetch, got [%v] tried %s ago", failed, time.Since(tried))
+	}
+}